@@ -0,0 +1,116 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package merkletree
+
+import (
+	"bytes"
+	"hash"
+	"slices"
+)
+
+// VerifyMultiProof returns true if the leaves of proof are the leaves at
+// proof.Indices of the Merkle tree of numLeaves leaves whose root is merkleRoot.
+// opts must be the options with which the tree was built.
+//
+// The caller must check that proof.Indices and proof.Leaves are the indices and
+// the data it expects.
+func VerifyMultiProof(h hash.Hash, merkleRoot []byte, numLeaves uint64, proof MultiProof, opts ...Option) bool {
+	config, err := treeOptions(opts)
+	if err != nil {
+		return false
+	}
+	if merkleRoot == nil || numLeaves == 0 {
+		return false
+	}
+	if len(proof.Indices) == 0 || len(proof.Indices) != len(proof.Leaves) {
+		return false
+	}
+
+	// sort the leaves by index, and remove the duplicates, which must have the
+	// same data.
+	order := make([]int, len(proof.Indices))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		if proof.Indices[a] < proof.Indices[b] {
+			return -1
+		} else if proof.Indices[a] > proof.Indices[b] {
+			return 1
+		}
+		return 0
+	})
+	positions := make([]uint64, 0, len(order))
+	nodes := make([][]byte, 0, len(order))
+	for k, i := range order {
+		if proof.Indices[i] >= numLeaves {
+			return false
+		}
+		if k > 0 && proof.Indices[i] == proof.Indices[order[k-1]] {
+			if !bytes.Equal(proof.Leaves[i], proof.Leaves[order[k-1]]) {
+				return false
+			}
+			continue
+		}
+		positions = append(positions, proof.Indices[i])
+		if config.hashLeaves {
+			nodes = append(nodes, leafSum(h, proof.Leaves[i]))
+		} else {
+			nodes = append(nodes, proof.Leaves[i])
+		}
+	}
+
+	// recompute the known nodes level by level, consuming the siblings in the
+	// order in which ProveMulti appended them.
+	arity := uint64(config.arity)
+	siblings := proof.Siblings
+	children := make([][]byte, 0, arity)
+	for n := numLeaves; n > 1; n = (n + arity - 1) / arity {
+		parents := positions[:0]
+		parentNodes := nodes[:0]
+		for i := 0; i < len(positions); {
+			parent := positions[i] / arity
+			end := min((parent+1)*arity, n)
+			children = children[:0]
+			for j := parent * arity; j < end; j++ {
+				if i < len(positions) && positions[i] == j {
+					children = append(children, nodes[i])
+					i++
+					continue
+				}
+				if len(siblings) == 0 {
+					return false
+				}
+				children = append(children, siblings[0])
+				siblings = siblings[1:]
+			}
+			parents = append(parents, parent)
+			parentNodes = append(parentNodes, parentSum(h, children))
+		}
+		positions, nodes = parents, parentNodes
+	}
+
+	// all the siblings must have been used.
+	if len(siblings) != 0 {
+		return false
+	}
+
+	return bytes.Equal(nodes[0], merkleRoot)
+}
+
+// LeavesFromRows returns the leaves whose i-th one is the concatenation of the
+// encodings (by Marshal) of the field elements of rows[i]. With the default
+// options, the tree then hashes each row into a leaf.
+func LeavesFromRows[E any, PE interface {
+	*E
+	Marshal() []byte
+}](rows [][]E) [][]byte {
+	leaves := make([][]byte, len(rows))
+	for i := range rows {
+		for j := range rows[i] {
+			leaves[i] = append(leaves[i], PE(&rows[i][j]).Marshal()...)
+		}
+	}
+	return leaves
+}
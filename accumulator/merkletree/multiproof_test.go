@@ -0,0 +1,316 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package merkletree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+func randomLeaves(rng *rand.Rand, n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = make([]byte, 1+rng.IntN(40))
+		for j := range leaves[i] {
+			leaves[i][j] = byte(rng.Uint32())
+		}
+	}
+	return leaves
+}
+
+func TestRetainedTreeMatchesTree(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	h := sha256.New()
+	for n := 1; n <= 70; n++ {
+		leaves := randomLeaves(rng, n)
+		stream := New(h)
+		for i := range leaves {
+			stream.Push(leaves[i])
+		}
+		tree, err := NewRetainedTree(h, leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tree.Root(), stream.Root()) {
+			t.Fatalf("%d leaves: roots differ", n)
+		}
+	}
+}
+
+func TestMultiProof(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	h := sha256.New()
+	for _, arity := range []int{2, 4, 8} {
+		for _, prehashed := range []bool{false, true} {
+			opts := []Option{WithArity(arity)}
+			if prehashed {
+				opts = append(opts, WithPrehashedLeaves())
+			}
+			for _, n := range []int{1, 2, 3, 7, 8, 9, 64, 100, 513} {
+				t.Run(fmt.Sprintf("arity=%d/prehashed=%v/n=%d", arity, prehashed, n), func(t *testing.T) {
+					leaves := randomLeaves(rng, n)
+					tree, err := NewRetainedTree(h, leaves, opts...)
+					if err != nil {
+						t.Fatal(err)
+					}
+					root := tree.Root()
+					for _, nbQueries := range []int{1, 2, 5, 40} {
+						indices := make([]uint64, nbQueries)
+						for i := range indices {
+							indices[i] = rng.Uint64N(uint64(n))
+						}
+						proof, err := tree.ProveMulti(indices)
+						if err != nil {
+							t.Fatal(err)
+						}
+						for i := range indices {
+							if proof.Indices[i] != indices[i] || !bytes.Equal(proof.Leaves[i], leaves[indices[i]]) {
+								t.Fatal("wrong opened leaf")
+							}
+						}
+						if !VerifyMultiProof(h, root, uint64(n), proof, opts...) {
+							t.Fatal("valid proof rejected")
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestMultiProofDeduplication(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	h := sha256.New()
+	const n = 64
+	leaves := randomLeaves(rng, n)
+
+	tree, err := NewRetainedTree(h, leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// single leaf: one sibling per level
+	proof, err := tree.ProveMulti([]uint64{5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Siblings) != 6 {
+		t.Fatalf("expected 6 siblings, got %d", len(proof.Siblings))
+	}
+
+	// two siblings leaves: they share their authentication paths
+	proof, err = tree.ProveMulti([]uint64{5, 4, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Siblings) != 5 {
+		t.Fatalf("expected 5 siblings, got %d", len(proof.Siblings))
+	}
+
+	// all the leaves: no sibling
+	indices := make([]uint64, n)
+	for i := range indices {
+		indices[i] = uint64(n - 1 - i)
+	}
+	proof, err = tree.ProveMulti(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Siblings) != 0 {
+		t.Fatalf("expected no sibling, got %d", len(proof.Siblings))
+	}
+	if !VerifyMultiProof(h, tree.Root(), n, proof) {
+		t.Fatal("valid proof rejected")
+	}
+
+	// arity 8: a leaf has 7 siblings per level
+	tree, err = NewRetainedTree(h, leaves, WithArity(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err = tree.ProveMulti([]uint64{63})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Siblings) != 14 {
+		t.Fatalf("expected 14 siblings, got %d", len(proof.Siblings))
+	}
+}
+
+func TestMultiProofTampered(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	h := sha256.New()
+	const n = 100
+	leaves := randomLeaves(rng, n)
+	opts := []Option{WithArity(4)}
+
+	tree, err := NewRetainedTree(h, leaves, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := tree.Root()
+
+	indices := []uint64{3, 17, 99, 17, 42}
+	newProof := func() MultiProof {
+		proof, err := tree.ProveMulti(indices)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return proof
+	}
+	if !VerifyMultiProof(h, root, n, newProof(), opts...) {
+		t.Fatal("valid proof rejected")
+	}
+
+	tamper := func(name string, f func(p *MultiProof) (uint64, []Option)) {
+		t.Run(name, func(t *testing.T) {
+			proof := newProof()
+			numLeaves, opts := f(&proof)
+			if VerifyMultiProof(h, root, numLeaves, proof, opts...) {
+				t.Fatal("tampered proof accepted")
+			}
+		})
+	}
+	tamper("leaf", func(p *MultiProof) (uint64, []Option) {
+		p.Leaves[2] = append([]byte{}, p.Leaves[2]...)
+		p.Leaves[2][0] ^= 1
+		return n, opts
+	})
+	tamper("duplicate", func(p *MultiProof) (uint64, []Option) {
+		p.Leaves[3] = p.Leaves[0]
+		return n, opts
+	})
+	tamper("index", func(p *MultiProof) (uint64, []Option) {
+		p.Indices[0] = 2
+		return n, opts
+	})
+	tamper("outOfRange", func(p *MultiProof) (uint64, []Option) {
+		p.Indices[2] = n
+		return n, opts
+	})
+	tamper("sibling", func(p *MultiProof) (uint64, []Option) {
+		p.Siblings[1] = p.Siblings[0]
+		return n, opts
+	})
+	tamper("missingSibling", func(p *MultiProof) (uint64, []Option) {
+		p.Siblings = p.Siblings[:len(p.Siblings)-1]
+		return n, opts
+	})
+	tamper("extraSibling", func(p *MultiProof) (uint64, []Option) {
+		p.Siblings = append(p.Siblings, p.Siblings[0])
+		return n, opts
+	})
+	tamper("numLeaves", func(p *MultiProof) (uint64, []Option) {
+		return n + 1, opts
+	})
+	tamper("arity", func(p *MultiProof) (uint64, []Option) {
+		return n, []Option{WithArity(8)}
+	})
+	tamper("prehashed", func(p *MultiProof) (uint64, []Option) {
+		return n, append(opts, WithPrehashedLeaves())
+	})
+	tamper("empty", func(p *MultiProof) (uint64, []Option) {
+		p.Indices, p.Leaves = nil, nil
+		return n, opts
+	})
+}
+
+func TestRetainedTreeErrors(t *testing.T) {
+	h := sha256.New()
+	leaves := randomLeaves(rand.New(rand.NewPCG(9, 10)), 10)
+
+	if _, err := NewRetainedTree(h, leaves, WithArity(3)); !errors.Is(err, ErrArity) {
+		t.Fatalf("expected ErrArity, got %v", err)
+	}
+	if _, err := NewRetainedTree(h, nil); !errors.Is(err, ErrNoLeaves) {
+		t.Fatalf("expected ErrNoLeaves, got %v", err)
+	}
+
+	tree, err := NewRetainedTree(h, leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tree.ProveMulti(nil); !errors.Is(err, ErrNoIndices) {
+		t.Fatalf("expected ErrNoIndices, got %v", err)
+	}
+	if _, err := tree.ProveMulti([]uint64{1, 10}); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("expected ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestLeavesFromRows(t *testing.T) {
+	const nbRows, nbColumns = 32, 5
+	rows := make([][]goldilocks.Element, nbRows)
+	for i := range rows {
+		rows[i] = make([]goldilocks.Element, nbColumns)
+		for j := range rows[i] {
+			rows[i][j].SetUint64(uint64(i*nbColumns + j))
+		}
+	}
+
+	leaves := LeavesFromRows(rows)
+	for i := range leaves {
+		if len(leaves[i]) != nbColumns*goldilocks.Bytes {
+			t.Fatal("wrong leaf size")
+		}
+		for j := range rows[i] {
+			var e goldilocks.Element
+			if err := e.SetBytesCanonical(leaves[i][j*goldilocks.Bytes : (j+1)*goldilocks.Bytes]); err != nil {
+				t.Fatal(err)
+			}
+			if !e.Equal(&rows[i][j]) {
+				t.Fatal("wrong leaf encoding")
+			}
+		}
+	}
+
+	h := sha256.New()
+	tree, err := NewRetainedTree(h, leaves, WithArity(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := tree.ProveMulti([]uint64{0, 31, 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyMultiProof(h, tree.Root(), nbRows, proof, WithArity(4)) {
+		t.Fatal("valid proof rejected")
+	}
+}
+
+func BenchmarkMultiProof(b *testing.B) {
+	rng := rand.New(rand.NewPCG(11, 12))
+	h := sha256.New()
+	const n = 1 << 16
+	leaves := randomLeaves(rng, n)
+	indices := make([]uint64, 128)
+	for i := range indices {
+		indices[i] = rng.Uint64N(n)
+	}
+
+	for _, arity := range []int{2, 4, 8} {
+		tree, err := NewRetainedTree(h, leaves, WithArity(arity))
+		if err != nil {
+			b.Fatal(err)
+		}
+		root := tree.Root()
+		b.Run(fmt.Sprintf("prove/arity=%d", arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = tree.ProveMulti(indices)
+			}
+		})
+		proof, _ := tree.ProveMulti(indices)
+		b.Run(fmt.Sprintf("verify/arity=%d", arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				VerifyMultiProof(h, root, n, proof, WithArity(arity))
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package merkletree
+
+import (
+	"errors"
+	"hash"
+	"slices"
+)
+
+var (
+	ErrArity           = errors.New("arity must be 2, 4 or 8")
+	ErrNoLeaves        = errors.New("can't build a Merkle tree without leaves")
+	ErrNoIndices       = errors.New("no leaf to prove")
+	ErrIndexOutOfRange = errors.New("leaf index out of range")
+)
+
+// Option defines option for altering the shape of a RetainedTree and of its
+// multi-proofs. The same options must be given to NewRetainedTree and to
+// VerifyMultiProof.
+type Option func(treeConfig) treeConfig
+
+type treeConfig struct {
+	arity      int
+	hashLeaves bool
+}
+
+// WithArity sets the number of children of the inner nodes, 2 (default), 4 or 8.
+func WithArity(arity int) Option {
+	return func(opt treeConfig) treeConfig {
+		opt.arity = arity
+		return opt
+	}
+}
+
+// WithPrehashedLeaves if provided, the leaves are used as is as the bottom nodes
+// of the tree, instead of being hashed first. It is useful when the leaves are
+// already digests, for instance of rows of field elements hashed with an
+// algebraic hash.
+func WithPrehashedLeaves() Option {
+	return func(opt treeConfig) treeConfig {
+		opt.hashLeaves = false
+		return opt
+	}
+}
+
+// default options
+func treeOptions(opts []Option) (treeConfig, error) {
+	opt := treeConfig{
+		arity:      2,
+		hashLeaves: true,
+	}
+	for _, option := range opts {
+		opt = option(opt)
+	}
+	if opt.arity != 2 && opt.arity != 4 && opt.arity != 8 {
+		return opt, ErrArity
+	}
+	return opt, nil
+}
+
+// RetainedTree is a Merkle tree built at once from all its leaves and keeping
+// all its nodes, so that it can prove any set of leaves.
+//
+// The inner nodes hash the concatenation of up to arity consecutive nodes of the
+// level below. When the number of nodes of a level is not a multiple of the
+// arity, the last node has fewer children, and is promoted as is when it has a
+// single child. With arity 2 and hashed leaves the root is then the same as the
+// one of Tree.
+type RetainedTree struct {
+	hash   hash.Hash
+	config treeConfig
+
+	// leaves data of the leaves, not hashed
+	leaves [][]byte
+
+	// levels[0] are the bottom nodes, levels[len(levels)-1] contains the root.
+	levels [][][]byte
+}
+
+// MultiProof is a proof that several leaves belong to a RetainedTree.
+type MultiProof struct {
+	// Indices of the proven leaves, in the order in which they were requested.
+	// They may be unsorted and contain duplicates.
+	Indices []uint64
+
+	// Leaves data of the proven leaves, Leaves[i] is the leaf at Indices[i].
+	Leaves [][]byte
+
+	// Siblings nodes needed to recompute the root that can't be computed from
+	// the proven leaves; each node appears once, level by level from the
+	// leaves, and from left to right within a level.
+	Siblings [][]byte
+}
+
+// NewRetainedTree builds the Merkle tree of leaves, hashed with h.
+func NewRetainedTree(h hash.Hash, leaves [][]byte, opts ...Option) (*RetainedTree, error) {
+	config, err := treeOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+
+	t := &RetainedTree{
+		hash:   h,
+		config: config,
+		leaves: leaves,
+	}
+
+	level := make([][]byte, len(leaves))
+	for i := range leaves {
+		if config.hashLeaves {
+			level[i] = leafSum(h, leaves[i])
+		} else {
+			level[i] = leaves[i]
+		}
+	}
+	t.levels = append(t.levels, level)
+
+	for len(level) > 1 {
+		next := make([][]byte, (len(level)+config.arity-1)/config.arity)
+		for i := range next {
+			start := i * config.arity
+			end := min(start+config.arity, len(level))
+			next[i] = parentSum(h, level[start:end])
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+
+	return t, nil
+}
+
+// Root returns the Merkle root of the tree.
+func (t *RetainedTree) Root() []byte {
+	root := t.levels[len(t.levels)-1][0]
+	// Return a copy to prevent leaking a pointer to internal data.
+	return append(root[:0:0], root...)
+}
+
+// NumLeaves returns the number of leaves of the tree.
+func (t *RetainedTree) NumLeaves() uint64 {
+	return uint64(len(t.leaves))
+}
+
+// ProveMulti returns a proof that the leaves at indices belong to the tree. The
+// nodes shared by the authentication paths of several leaves, or computable
+// from the proven leaves, are not included in the proof.
+func (t *RetainedTree) ProveMulti(indices []uint64) (MultiProof, error) {
+	if len(indices) == 0 {
+		return MultiProof{}, ErrNoIndices
+	}
+	proof := MultiProof{
+		Indices: slices.Clone(indices),
+		Leaves:  make([][]byte, len(indices)),
+	}
+	for i, index := range indices {
+		if index >= t.NumLeaves() {
+			return MultiProof{}, ErrIndexOutOfRange
+		}
+		proof.Leaves[i] = t.leaves[index]
+	}
+
+	positions := slices.Clone(indices)
+	slices.Sort(positions)
+	positions = slices.Compact(positions)
+
+	arity := uint64(t.config.arity)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		n := uint64(len(level))
+		parents := positions[:0]
+		for i := 0; i < len(positions); {
+			parent := positions[i] / arity
+			end := min((parent+1)*arity, n)
+			for j := parent * arity; j < end; j++ {
+				if i < len(positions) && positions[i] == j {
+					i++
+					continue
+				}
+				proof.Siblings = append(proof.Siblings, level[j])
+			}
+			parents = append(parents, parent)
+		}
+		positions = parents
+	}
+
+	return proof, nil
+}
+
+// parentSum returns the node whose children are the given nodes. A single child
+// is promoted as is.
+func parentSum(h hash.Hash, children [][]byte) []byte {
+	if len(children) == 1 {
+		return children[0]
+	}
+	return sum(h, children...)
+}
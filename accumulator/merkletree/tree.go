@@ -20,6 +20,9 @@
 // Package merkletree provides Merkle tree and proof following RFC 6962.
 //
 // From https://gitlab.com/NebulousLabs/merkletree
+//
+// RetainedTree is a variant keeping all its nodes, of arity 2, 4 or 8, which
+// proves several leaves at once with a MultiProof.
 package merkletree
 
 import (
@@ -0,0 +1,408 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/signature"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	sizeFr = fr.Bytes
+	sizeG1 = bls12377.SizeOfG1AffineCompressed
+	sizeG2 = bls12377.SizeOfG2AffineCompressed
+
+	// minIKMSize is the minimum size in bytes of the input keying material of KeyGen.
+	minIKMSize = 32
+	// sizeOKM is L = ceil((3 * ceil(log2(r))) / 16), the size of the HKDF output in KeyGen.
+	sizeOKM = (3*fr.Bits + 15) / 16
+)
+
+var (
+	ErrInvalidPublicKey     = errors.New("invalid public key")
+	ErrInvalidSignature     = errors.New("invalid signature")
+	ErrInvalidPrivateKey    = errors.New("invalid private key")
+	ErrShortIKM             = errors.New("input keying material must be at least 32 bytes")
+	ErrNotProofOfPossession = errors.New("ciphersuite does not implement the proof-of-possession scheme")
+	ErrCiphersuiteMismatch  = errors.New("keys belong to different ciphersuites")
+	ErrDuplicateMessages    = errors.New("messages must be distinct in the basic scheme")
+	ErrInvalidNbMessages    = errors.New("number of messages and public keys mismatch")
+	ErrEmptyAggregate       = errors.New("nothing to aggregate")
+)
+
+// Variant selects the groups in which public keys and signatures live.
+type Variant uint8
+
+const (
+	// MinPk minimizes the public key size: public keys are in G1 and
+	// signatures in G2.
+	MinPk Variant = iota
+	// MinSig minimizes the signature size: public keys are in G2 and
+	// signatures in G1.
+	MinSig
+)
+
+// Scheme selects the protection against rogue key attacks.
+type Scheme uint8
+
+const (
+	// Basic requires the messages of an aggregate signature to be distinct.
+	Basic Scheme = iota
+	// MessageAugmentation prepends the signer's public key to the message.
+	MessageAugmentation
+	// ProofOfPossession requires each public key to come with a proof that
+	// its owner knows the secret key, and enables FastAggregateVerify.
+	ProofOfPossession
+)
+
+// Ciphersuite is a BLS signature ciphersuite: a variant, a scheme and the
+// domain separation tags used to hash messages (and proofs of possession) to
+// the signature group.
+type Ciphersuite struct {
+	variant Variant
+	scheme  Scheme
+	dst     []byte
+	popDST  []byte
+}
+
+var (
+	// MinPkBasic is BLS_SIG_BLS12377G2_XMD:SHA-256_SSWU_RO_NUL_
+	MinPkBasic = newCiphersuite(MinPk, Basic)
+	// MinPkAug is BLS_SIG_BLS12377G2_XMD:SHA-256_SSWU_RO_AUG_
+	MinPkAug = newCiphersuite(MinPk, MessageAugmentation)
+	// MinPkPop is BLS_SIG_BLS12377G2_XMD:SHA-256_SSWU_RO_POP_
+	MinPkPop = newCiphersuite(MinPk, ProofOfPossession)
+	// MinSigBasic is BLS_SIG_BLS12377G1_XMD:SHA-256_SSWU_RO_NUL_
+	MinSigBasic = newCiphersuite(MinSig, Basic)
+	// MinSigAug is BLS_SIG_BLS12377G1_XMD:SHA-256_SSWU_RO_AUG_
+	MinSigAug = newCiphersuite(MinSig, MessageAugmentation)
+	// MinSigPop is BLS_SIG_BLS12377G1_XMD:SHA-256_SSWU_RO_POP_
+	MinSigPop = newCiphersuite(MinSig, ProofOfPossession)
+)
+
+func newCiphersuite(variant Variant, scheme Scheme) *Ciphersuite {
+	h2c := "BLS12377G2_XMD:SHA-256_SSWU_RO_"
+	if variant == MinSig {
+		h2c = "BLS12377G1_XMD:SHA-256_SSWU_RO_"
+	}
+	tag := [...]string{"NUL_", "AUG_", "POP_"}[scheme]
+	cs := &Ciphersuite{
+		variant: variant,
+		scheme:  scheme,
+		dst:     []byte("BLS_SIG_" + h2c + tag),
+	}
+	if scheme == ProofOfPossession {
+		cs.popDST = []byte("BLS_POP_" + h2c + tag)
+	}
+	return cs
+}
+
+// ID returns the ciphersuite identifier, which is also the domain separation
+// tag used to hash messages.
+func (cs *Ciphersuite) ID() string {
+	return string(cs.dst)
+}
+
+// Variant returns the variant of the ciphersuite.
+func (cs *Ciphersuite) Variant() Variant {
+	return cs.variant
+}
+
+// Scheme returns the scheme of the ciphersuite.
+func (cs *Ciphersuite) Scheme() Scheme {
+	return cs.scheme
+}
+
+// PublicKey represents a BLS public key, a point of G1 (MinPk) or G2 (MinSig).
+type PublicKey struct {
+	suite *Ciphersuite
+	g1    bls12377.G1Affine // MinPk
+	g2    bls12377.G2Affine // MinSig
+}
+
+// PrivateKey represents a BLS private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+var (
+	g1Gen, g1GenNeg bls12377.G1Affine
+	g2Gen, g2GenNeg bls12377.G2Affine
+)
+
+func init() {
+	_, _, g1Gen, g2Gen = bls12377.Generators()
+	g1GenNeg.Neg(&g1Gen)
+	g2GenNeg.Neg(&g2Gen)
+}
+
+// ciphersuite returns the ciphersuite the key is bound to, MinPkPop if unset.
+func (pk *PublicKey) ciphersuite() *Ciphersuite {
+	if pk.suite == nil {
+		return MinPkPop
+	}
+	return pk.suite
+}
+
+// Ciphersuite returns the ciphersuite the key is bound to.
+func (pk *PublicKey) Ciphersuite() *Ciphersuite {
+	return pk.ciphersuite()
+}
+
+// NewPublicKey returns an empty public key bound to the ciphersuite, to be
+// set with SetBytes.
+func (cs *Ciphersuite) NewPublicKey() *PublicKey {
+	return &PublicKey{suite: cs}
+}
+
+// GenerateKey generates a MinPkPop key pair using rand as the source of input
+// keying material.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	return MinPkPop.GenerateKey(rand)
+}
+
+// GenerateKey generates a key pair using rand as the source of input keying
+// material.
+func (cs *Ciphersuite) GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	ikm := make([]byte, minIKMSize)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, err
+	}
+	return cs.KeyGen(ikm, nil)
+}
+
+// KeyGen deterministically derives a key pair from the input keying material
+// ikm (at least 32 bytes) and the optional keyInfo.
+//
+//	salt = "BLS-SIG-KEYGEN-SALT-"
+//	while SK == 0:
+//	    salt = SHA-256(salt)
+//	    PRK = HKDF-Extract(salt, IKM || I2OSP(0, 1))
+//	    OKM = HKDF-Expand(PRK, key_info || I2OSP(L, 2), L)
+//	    SK = OS2IP(OKM) mod r
+//
+// draft-irtf-cfrg-bls-signature-05, Section 2.3
+func (cs *Ciphersuite) KeyGen(ikm, keyInfo []byte) (*PrivateKey, error) {
+	if len(ikm) < minIKMSize {
+		return nil, ErrShortIKM
+	}
+
+	secret := make([]byte, len(ikm)+1)
+	copy(secret, ikm)
+	info := make([]byte, len(keyInfo)+2)
+	copy(info, keyInfo)
+	info[len(keyInfo)] = byte(sizeOKM >> 8)
+	info[len(keyInfo)+1] = byte(sizeOKM)
+
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	okm := make([]byte, sizeOKM)
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk := hkdf.Extract(sha256.New, secret, salt)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), okm); err != nil {
+			return nil, err
+		}
+		sk.SetBytes(okm).Mod(sk, fr.Modulus())
+	}
+
+	privateKey := &PrivateKey{}
+	sk.FillBytes(privateKey.scalar[:])
+	privateKey.PublicKey.suite = cs
+	privateKey.PublicKey.fromScalar(sk)
+	return privateKey, nil
+}
+
+// NewPrivateKey returns the key pair of the secret scalar given in big endian.
+// The scalar must be in [1, r-1].
+func (cs *Ciphersuite) NewPrivateKey(scalar []byte) (*PrivateKey, error) {
+	if len(scalar) != sizeFr {
+		return nil, ErrInvalidPrivateKey
+	}
+	sk := new(big.Int).SetBytes(scalar)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return nil, ErrInvalidPrivateKey
+	}
+	privateKey := &PrivateKey{}
+	copy(privateKey.scalar[:], scalar)
+	privateKey.PublicKey.suite = cs
+	privateKey.PublicKey.fromScalar(sk)
+	return privateKey, nil
+}
+
+// fromScalar sets pk to [sk]g in the public key group.
+func (pk *PublicKey) fromScalar(sk *big.Int) {
+	if pk.ciphersuite().variant == MinPk {
+		pk.g1.ScalarMultiplicationBase(sk)
+	} else {
+		pk.g2.ScalarMultiplicationBase(sk)
+	}
+}
+
+// validate implements KeyValidate: the public key must not be the identity.
+// Subgroup membership is checked when the key is deserialized.
+func (pk *PublicKey) validate() error {
+	if pk.ciphersuite().variant == MinPk {
+		if pk.g1.IsInfinity() {
+			return ErrInvalidPublicKey
+		}
+	} else if pk.g2.IsInfinity() {
+		return ErrInvalidPublicKey
+	}
+	return nil
+}
+
+// Equal compares 2 public keys
+func (pk *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	if pk.ciphersuite() != xx.ciphersuite() {
+		return false
+	}
+	bpk := pk.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	pub := privKey.PublicKey
+	return &pub
+}
+
+// prepareMessage pre-hashes the message with hFunc if provided, and prepends
+// the public key in the message augmentation scheme.
+func (pk *PublicKey) prepareMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc != nil {
+		hFunc.Reset()
+		if _, err := hFunc.Write(message); err != nil {
+			return nil, err
+		}
+		message = hFunc.Sum(nil)
+	}
+	if pk.ciphersuite().scheme == MessageAugmentation {
+		return append(pk.Bytes(), message...), nil
+	}
+	return message, nil
+}
+
+// Sign performs the BLS signature
+//
+// Q = hash_to_point(m)
+// signature = [sk]Q
+//
+// In the message augmentation scheme, m is prefixed with the public key. If
+// hFunc is provided, the message is first hashed with hFunc.
+//
+// draft-irtf-cfrg-bls-signature-05, Sections 2.6, 3.1 and 3.2
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	msg, err := privKey.PublicKey.prepareMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.coreSign(msg, privKey.PublicKey.ciphersuite().dst)
+}
+
+func (privKey *PrivateKey) coreSign(message, dst []byte) ([]byte, error) {
+	sk := new(big.Int).SetBytes(privKey.scalar[:])
+	if privKey.PublicKey.ciphersuite().variant == MinPk {
+		Q, err := bls12377.HashToG2(message, dst)
+		if err != nil {
+			return nil, err
+		}
+		Q.ScalarMultiplication(&Q, sk)
+		sig := Q.Bytes()
+		return sig[:], nil
+	}
+	Q, err := bls12377.HashToG1(message, dst)
+	if err != nil {
+		return nil, err
+	}
+	Q.ScalarMultiplication(&Q, sk)
+	sig := Q.Bytes()
+	return sig[:], nil
+}
+
+// Verify validates the BLS signature
+//
+// e(pk, hash_to_point(m)) ?= e(g, signature)
+//
+// draft-irtf-cfrg-bls-signature-05, Sections 2.7, 3.1 and 3.2
+func (pk *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	msg, err := pk.prepareMessage(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	return pk.coreVerify(sigBin, msg, pk.ciphersuite().dst)
+}
+
+func (pk *PublicKey) coreVerify(sigBin, message, dst []byte) (bool, error) {
+	if err := pk.validate(); err != nil {
+		return false, err
+	}
+	if pk.ciphersuite().variant == MinPk {
+		sig, err := signatureG2(sigBin)
+		if err != nil {
+			return false, err
+		}
+		Q, err := bls12377.HashToG2(message, dst)
+		if err != nil {
+			return false, err
+		}
+		return bls12377.PairingCheck(
+			[]bls12377.G1Affine{pk.g1, g1GenNeg},
+			[]bls12377.G2Affine{Q, sig},
+		)
+	}
+	sig, err := signatureG1(sigBin)
+	if err != nil {
+		return false, err
+	}
+	Q, err := bls12377.HashToG1(message, dst)
+	if err != nil {
+		return false, err
+	}
+	return bls12377.PairingCheck(
+		[]bls12377.G1Affine{Q, sig},
+		[]bls12377.G2Affine{pk.g2, g2GenNeg},
+	)
+}
+
+// PopProve returns a proof of possession of the private key, that is a
+// signature of the public key under the proof-of-possession tag.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.2
+func (privKey *PrivateKey) PopProve() ([]byte, error) {
+	cs := privKey.PublicKey.ciphersuite()
+	if cs.scheme != ProofOfPossession {
+		return nil, ErrNotProofOfPossession
+	}
+	return privKey.coreSign(privKey.PublicKey.Bytes(), cs.popDST)
+}
+
+// PopVerify checks a proof of possession of the private key associated to pk.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.3
+func (pk *PublicKey) PopVerify(proof []byte) (bool, error) {
+	cs := pk.ciphersuite()
+	if cs.scheme != ProofOfPossession {
+		return false, ErrNotProofOfPossession
+	}
+	return pk.coreVerify(proof, pk.Bytes(), cs.popDST)
+}
@@ -0,0 +1,29 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package shamir implements Shamir secret sharing of bls12-377 scalar field
+// elements, and the Feldman and Pedersen verifiable secret sharing schemes.
+//
+// A dealer splits a secret s into n shares (i, f(i)) of a random polynomial f of
+// degree t-1 with f(0) = s. Any t shares reconstruct s by Lagrange interpolation
+// at 0, while t-1 shares reveal nothing about s.
+//
+// In the verifiable variants, the dealer also publishes commitments to the
+// coefficients of f in G1, so that each participant can check its share
+// against them:
+//   - Feldman: Cⱼ = [aⱼ]G. The commitments are binding but only computationally
+//     hiding; in particular C₀ = [s]G is the public key of the shared secret.
+//   - Pedersen: Cⱼ = [aⱼ]G + [bⱼ]H where H has an unknown discrete logarithm
+//     in base G. The commitments are perfectly hiding and each share comes with
+//     a blinding share g(i).
+//
+// The Lagrange coefficients are exposed so that threshold protocols (e.g. BLS
+// or FROST signing) can combine partial results in the exponent.
+//
+// See:
+//   - A. Shamir, How to share a secret (1979)
+//   - P. Feldman, A practical scheme for non-interactive verifiable secret sharing (1987)
+//   - T. P. Pedersen, Non-interactive and information-theoretic secure verifiable secret sharing (1991)
+package shamir
@@ -0,0 +1,43 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shamir
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// This example demonstrates how a dealer shares a secret among 5 participants
+// with the Feldman verifiable secret sharing, such that any 3 of them can
+// reconstruct it.
+func Example_feldman() {
+	const threshold, nbShares = 3, 5
+
+	var secret fr.Element
+	secret.SetRandom()
+
+	// the dealer sends shares[i] privately to participant i+1, and broadcasts the commitment
+	shares, commitment, err := SplitFeldman(secret, threshold, nbShares)
+	if err != nil {
+		panic(err)
+	}
+
+	// each participant checks its share against the commitment
+	for _, share := range shares {
+		if err := commitment.Verify(share); err != nil {
+			panic(err)
+		}
+	}
+
+	// participants 1, 3 and 5 reconstruct the secret
+	reconstructed, err := Reconstruct([]Share{shares[0], shares[2], shares[4]})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("secret reconstructed:", reconstructed.Equal(&secret))
+	// Output: secret reconstructed: true
+}
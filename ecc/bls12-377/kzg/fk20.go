@@ -0,0 +1,205 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidDomainSize = errors.New("domain size must be a power of 2")
+	ErrInvalidCosetSize  = errors.New("coset size must be a power of 2 dividing the domain size")
+)
+
+// FK20 computes the opening proofs of a polynomial on all the cosets of size k
+// of a domain of size n in O(n log n) group operations, instead of one MSM of
+// size n per coset, following Feist and Khovratovich, "Fast amortized KZG
+// proofs" (https://eprint.iacr.org/2023/033).
+//
+// The domain is the one of [fft.NewDomain](n), with generator ω. The coset i,
+// for i < n/k, is ωⁱ⟨ωⁿᐟᵏ⟩, the set of roots of Xᵏ - ωⁱᵏ. When k = 1, the
+// cosets are the points of the domain.
+//
+// The quotient of p by Xᵏ - c is ∑ⱼ cʲhⱼ(X) with hⱼ(X) = ∑ₜ pₜ₊₍ⱼ₊₁₎ₖXᵗ, so that
+// the proofs are the Fourier transform in G₁ of the [hⱼ(τ)]G₁, which are
+// Toeplitz matrix-vector products computed with Fourier transforms of size 2n/k.
+type FK20 struct {
+	domainSize, cosetSize uint64
+	maxPolynomialSize     int
+
+	// domainExt is the domain of size 2n/k of the circulant matrices
+	// embedding the Toeplitz matrices
+	domainExt *fft.Domain
+
+	// srsFFT[x][r] is the x-th coefficient (in bit-reversed order) of the
+	// Fourier transform of the r-th column of SRS points
+	srsFFT [][]curve.G1Affine
+
+	twiddles       []*big.Int // twiddles of the domain of size n/k
+	twiddlesExtInv []*big.Int // inverse twiddles of domainExt
+}
+
+// NewFK20 precomputes the Fourier transforms of the SRS needed to open the
+// polynomials of size at most min(domainSize, len(pk.G1)) on the cosets of
+// size cosetSize of the domain of size domainSize.
+func NewFK20(pk ProvingKey, domainSize, cosetSize uint64) (*FK20, error) {
+	if domainSize == 0 || bits.OnesCount64(domainSize) != 1 {
+		return nil, ErrInvalidDomainSize
+	}
+	if cosetSize == 0 || bits.OnesCount64(cosetSize) != 1 || cosetSize > domainSize {
+		return nil, ErrInvalidCosetSize
+	}
+
+	k := int(cosetSize)
+	m := int(domainSize / cosetSize)
+
+	f := &FK20{
+		domainSize:        domainSize,
+		cosetSize:         cosetSize,
+		maxPolynomialSize: len(pk.G1),
+		domainExt:         fft.NewDomain(uint64(2 * m)),
+		srsFFT:            make([][]curve.G1Affine, 2*m),
+	}
+	var err error
+	if f.twiddles, err = computeTwiddles(m, false); err != nil {
+		return nil, err
+	}
+	if f.twiddlesExtInv, err = computeTwiddles(2*m, true); err != nil {
+		return nil, err
+	}
+	twiddlesExt, err := computeTwiddles(2*m, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for x := range f.srsFFT {
+		f.srsFFT[x] = make([]curve.G1Affine, k)
+	}
+	maxSplits := fftMaxSplits()
+	column := make([]curve.G1Jac, 2*m)
+	var infinity curve.G1Affine
+	for r := 0; r < k; r++ {
+		// column[j] = [τ^{(m-2-j)k+r}]G₁ for j ≤ m-2, infinity otherwise
+		for j := range column {
+			column[j].FromAffine(&infinity)
+			if j > m-2 {
+				continue
+			}
+			if idx := (m-2-j)*k + r; idx < len(pk.G1) {
+				column[j].FromAffine(&pk.G1[idx])
+			}
+		}
+		difFFTG1(column, twiddlesExt, 0, maxSplits, nil)
+		columnAff := curve.BatchJacobianToAffineG1(column)
+		for x := range columnAff {
+			f.srsFFT[x][r] = columnAff[x]
+		}
+	}
+
+	return f, nil
+}
+
+// Open returns the opening proofs of p on the n/k cosets of size k of the
+// domain: the i-th proof is the commitment to the quotient of p by Xᵏ - ωⁱᵏ.
+//
+// For k = 1, the i-th proof is the H of the [OpeningProof] of p at ωⁱ.
+func (f *FK20) Open(p []fr.Element) ([]curve.G1Affine, error) {
+	if len(p) == 0 || len(p) > f.maxPolynomialSize || uint64(len(p)) > f.domainSize {
+		return nil, ErrInvalidPolynomialSize
+	}
+	k := int(f.cosetSize)
+	m := int(f.domainSize / f.cosetSize)
+
+	// Fourier transforms of the first columns of the circulant matrices of
+	// the coefficients, scaled by 1/2m to spare the scaling of the inverse
+	// transform in G₁.
+	coeffsFFT := make([][]fr.Element, k)
+	parallel.Execute(k, func(start, end int) {
+		for r := start; r < end; r++ {
+			coeffsFFT[r] = make([]fr.Element, 2*m)
+			// coeffsFFT[r][-j mod 2m] = p_{(m-1-j)k+r} for j < m
+			for j := 0; j < m; j++ {
+				if idx := (m-1-j)*k + r; idx < len(p) {
+					coeffsFFT[r][(2*m-j)%(2*m)] = p[idx]
+				}
+			}
+			f.domainExt.FFT(coeffsFFT[r], fft.DIF)
+			for x := range coeffsFFT[r] {
+				coeffsFFT[r][x].Mul(&coeffsFFT[r][x], &f.domainExt.CardinalityInv)
+			}
+		}
+	})
+
+	// pointwise products, summed over the columns
+	h := make([]curve.G1Jac, 2*m)
+	parallel.Execute(2*m, func(start, end int) {
+		var tmp curve.G1Jac
+		var s big.Int
+		for x := start; x < end; x++ {
+			h[x].FromAffine(&f.srsFFT[x][0])
+			h[x].ScalarMultiplication(&h[x], coeffsFFT[0][x].BigInt(&s))
+			for r := 1; r < k; r++ {
+				tmp.FromAffine(&f.srsFFT[x][r])
+				tmp.ScalarMultiplication(&tmp, coeffsFFT[r][x].BigInt(&s))
+				h[x].AddAssign(&tmp)
+			}
+		}
+	})
+
+	// inverse transform; the first m entries are the [hⱼ(τ)]G₁
+	maxSplits := fftMaxSplits()
+	bitReverse(h)
+	difFFTG1(h, f.twiddlesExtInv, 0, maxSplits, nil)
+	bitReverse(h)
+	h = h[:m]
+
+	// proofs
+	difFFTG1(h, f.twiddles, 0, maxSplits, nil)
+	bitReverse(h)
+
+	return curve.BatchJacobianToAffineG1(h), nil
+}
+
+// OpenAll returns the opening proofs of p at all the points ωⁱ of domain,
+// using [FK20].
+func OpenAll(p []fr.Element, domain *fft.Domain, pk ProvingKey) ([]OpeningProof, error) {
+	f, err := NewFK20(pk, domain.Cardinality, 1)
+	if err != nil {
+		return nil, err
+	}
+	hs, err := f.Open(p)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations := make([]fr.Element, domain.Cardinality)
+	copy(evaluations, p)
+	domain.FFT(evaluations, fft.DIF)
+	fft.BitReverse(evaluations)
+
+	res := make([]OpeningProof, len(hs))
+	for i := range res {
+		res[i].H = hs[i]
+		res[i].ClaimedValue = evaluations[i]
+	}
+	return res, nil
+}
+
+// fftMaxSplits returns the recursion depth up to which difFFTG1 runs the
+// sub-transforms concurrently.
+func fftMaxSplits() int {
+	numCPU := uint64(runtime.NumCPU())
+	return bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU)) << 1
+}
@@ -0,0 +1,126 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestBatchIsInSubGroupG2(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{1, 5, 300}
+	if !testing.Short() {
+		sizes = append(sizes, 5000)
+	}
+	for _, n := range sizes {
+		points := randomG2SubGroupPoints(n)
+		if !BatchIsInSubGroupG2(points) {
+			t.Fatalf("n=%d: points in the subgroup rejected", n)
+		}
+
+		// a point is not on the curve
+		i := rand.IntN(n) //#nosec G404 weak rng is fine here
+		p := points[i]
+		points[i].Y.Double(&points[i].Y)
+		if BatchIsInSubGroupG2(points) {
+			t.Fatalf("n=%d: point not on the curve accepted", n)
+		}
+		points[i] = p
+
+		// a point is not in the subgroup
+		points[i] = randomG2PointNotInSubGroup()
+		if BatchIsInSubGroupG2(points) {
+			t.Fatalf("n=%d: point not in the subgroup accepted", n)
+		}
+
+		// a point of the subgroup plus a torsion point
+		var q G2Jac
+		q.FromAffine(&points[i])
+		q.mulWindowed(&q, fr.Modulus())
+		if !q.Z.IsZero() {
+			points[i].FromJacobian(&q)
+			points[i].Add(&points[i], &p)
+			if BatchIsInSubGroupG2(points) {
+				t.Fatalf("n=%d: point with a torsion component accepted", n)
+			}
+		}
+	}
+}
+
+func TestG2BatchSubGroupParameters(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 100, 1 << 10, 1 << 20, 1 << 28} {
+		c, nbRounds := g2BatchSubGroupParameters(n)
+		if c < 2 || c > 16 {
+			t.Fatalf("n=%d: unexpected coefficient size %d", n, c)
+		}
+
+		// probability of failure ⌈2ᶜ/ℓ⌉/2ᶜ per round
+		var numerator, denominator, bound big.Int
+		numerator.SetUint64(((uint64(1) << c) + g2CofactorBound - 1) / g2CofactorBound)
+		numerator.Exp(&numerator, big.NewInt(int64(nbRounds)), nil)
+		denominator.Lsh(big.NewInt(1), uint(c*nbRounds))
+		bound.Lsh(&numerator, g2BatchSubGroupSecurity)
+		if bound.Cmp(&denominator) > 0 {
+			t.Fatalf("n=%d: %d rounds of %d bits are not enough", n, nbRounds, c)
+		}
+	}
+}
+
+func BenchmarkBatchIsInSubGroupG2(b *testing.B) {
+	points := randomG2SubGroupPoints(1 << 15)
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchIsInSubGroupG2(points)
+		}
+	})
+	b.Run("individual", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range points {
+				points[j].IsInSubGroup()
+			}
+		}
+	})
+}
+
+// randomG2SubGroupPoints returns n random points of the subgroup.
+func randomG2SubGroupPoints(n int) []G2Affine {
+	points := make([]G2Affine, n)
+	var s fr.Element
+	var sInt big.Int
+	for i := range points {
+		if _, err := s.SetRandom(); err != nil {
+			panic(err)
+		}
+		points[i].ScalarMultiplication(&g2GenAff, s.BigInt(&sInt))
+	}
+	return points
+}
+
+// randomG2PointNotInSubGroup returns a random point on the curve, outside the
+// subgroup.
+func randomG2PointNotInSubGroup() G2Affine {
+	for {
+		var p G2Affine
+		var rhs, y fptower.E2
+		if _, err := p.X.SetRandom(); err != nil {
+			panic(err)
+		}
+		rhs.Square(&p.X).Mul(&rhs, &p.X)
+		rhs.Add(&rhs, &bTwistCurveCoeff)
+		p.Y.Sqrt(&rhs)
+		if y.Square(&p.Y); y.Equal(&rhs) && !p.IsInSubGroup() {
+			return p
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+)
+
+// WriteTo writes binary encoding of the VerifyingKey
+func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of VerifyingKey to w without point compression
+func (vk *VerifyingKey) WriteRawTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w, bls12377.RawEncoding())
+}
+
+func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	var n int64
+	var err error
+	if len(options) == 0 {
+		n, err = vk.VerifyingKey.WriteTo(w)
+	} else {
+		n, err = vk.VerifyingKey.WriteRawTo(w)
+	}
+	if err != nil {
+		return n, err
+	}
+	if err = binary.Write(w, binary.BigEndian, uint64(vk.NbVars)); err != nil {
+		return n, err
+	}
+	n += 8
+
+	enc := bls12377.NewEncoder(w, options...)
+	err = enc.Encode(&vk.G2Shift)
+	return n + enc.BytesWritten(), err
+}
+
+// ReadFrom decodes VerifyingKey data from reader.
+func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	n, err := vk.VerifyingKey.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	var nbVars uint64
+	if err = binary.Read(r, binary.BigEndian, &nbVars); err != nil {
+		return n, err
+	}
+	n += 8
+	vk.NbVars = int(nbVars)
+
+	dec := bls12377.NewDecoder(r)
+	err = dec.Decode(&vk.G2Shift)
+	return n + dec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of the entire SRS
+func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
+	pn, err := srs.Pk.WriteTo(w)
+	if err != nil {
+		return pn, err
+	}
+	vn, err := srs.Vk.WriteTo(w)
+	return pn + vn, err
+}
+
+// ReadFrom decodes SRS data from reader.
+func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
+	pn, err := srs.Pk.ReadFrom(r)
+	if err != nil {
+		return pn, err
+	}
+	vn, err := srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// ReadFrom decodes OpeningProof data from reader.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.Quotients,
+		&proof.BatchedQuotient,
+		&proof.H,
+		&proof.ClaimedValue,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a OpeningProof
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.Quotients,
+		&proof.BatchedQuotient,
+		&proof.H,
+		&proof.ClaimedValue,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes BatchOpeningProof data from reader.
+func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.Proof.Quotients,
+		&proof.Proof.BatchedQuotient,
+		&proof.Proof.H,
+		&proof.Proof.ClaimedValue,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a BatchOpeningProof
+func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.Proof.Quotients,
+		&proof.Proof.BatchedQuotient,
+		&proof.Proof.H,
+		&proof.Proof.ClaimedValue,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
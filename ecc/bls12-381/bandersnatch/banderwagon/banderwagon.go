@@ -0,0 +1,165 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package banderwagon
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var curveParams = bandersnatch.GetEdwardsCurve()
+
+// Element is an element of the Banderwagon group, represented by any of the
+// two Bandersnatch points of its class.
+// The zero value is not a valid element, use Identity or SetIdentity.
+type Element struct {
+	inner bandersnatch.PointExtended
+}
+
+// Identity returns the identity element of the group.
+func Identity() Element {
+	var res Element
+	res.SetIdentity()
+	return res
+}
+
+// Generator returns the generator of the group, the class of the Bandersnatch base point.
+func Generator() Element {
+	var res Element
+	res.inner.FromAffine(&curveParams.Base)
+	return res
+}
+
+// SetIdentity sets p to the identity element and returns it.
+func (p *Element) SetIdentity() *Element {
+	var identity bandersnatch.PointAffine
+	identity.Y.SetOne()
+	p.inner.FromAffine(&identity)
+	return p
+}
+
+// Set sets p to p1 and returns it.
+func (p *Element) Set(p1 *Element) *Element {
+	p.inner.Set(&p1.inner)
+	return p
+}
+
+// Equal returns true if p and p1 are the same element of the group,
+// that is if the points they represent are equal up to (x, y) ~ (-x, -y).
+func (p *Element) Equal(p1 *Element) bool {
+	// x₁/z₁ ⋅ y₂/z₂ = x₂/z₂ ⋅ y₁/z₁
+	var a, b fp.Element
+	a.Mul(&p.inner.X, &p1.inner.Y)
+	b.Mul(&p1.inner.X, &p.inner.Y)
+	return a.Equal(&b)
+}
+
+// IsIdentity returns true if p is the identity element.
+func (p *Element) IsIdentity() bool {
+	return p.inner.X.IsZero()
+}
+
+// Add sets p = p1 + p2 and returns it.
+func (p *Element) Add(p1, p2 *Element) *Element {
+	p.inner.Add(&p1.inner, &p2.inner)
+	return p
+}
+
+// Sub sets p = p1 - p2 and returns it.
+func (p *Element) Sub(p1, p2 *Element) *Element {
+	var neg bandersnatch.PointExtended
+	neg.Neg(&p2.inner)
+	p.inner.Add(&p1.inner, &neg)
+	return p
+}
+
+// Neg sets p = -p1 and returns it.
+func (p *Element) Neg(p1 *Element) *Element {
+	p.inner.Neg(&p1.inner)
+	return p
+}
+
+// Double sets p = 2⋅p1 and returns it.
+func (p *Element) Double(p1 *Element) *Element {
+	p.inner.Double(&p1.inner)
+	return p
+}
+
+// ScalarMultiplication sets p = s⋅p1 and returns it.
+func (p *Element) ScalarMultiplication(p1 *Element, s *fr.Element) *Element {
+	var bs big.Int
+	p.inner.ScalarMultiplication(&p1.inner, s.BigInt(&bs))
+	return p
+}
+
+// MultiExp sets p = ∑ scalars[i]⋅points[i] and returns it.
+//
+// This call returns an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *Element) MultiExp(points []Element, scalars []fr.Element, config ecc.MultiExpConfig) (*Element, error) {
+	// the scalars are smaller than the modulus of the base field, and the
+	// Bandersnatch multi-exponentiation works with base field elements.
+	affine := BatchToAffine(points)
+	_scalars := make([]fp.Element, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := scalars[i].Bytes()
+			_scalars[i].SetBytes(b[:])
+		}
+	})
+	if _, err := p.inner.MultiExp(affine, _scalars, config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// MapToScalarField sets res to x/y, interpreted as an element of the scalar field.
+// It is well defined on the group since (-x)/(-y) = x/y, and is used to commit to
+// a commitment in Verkle trees.
+func (p *Element) MapToScalarField(res *fr.Element) *fr.Element {
+	var xOverY fp.Element
+	xOverY.Div(&p.inner.X, &p.inner.Y)
+	var b big.Int
+	return res.SetBigInt(xOverY.BigInt(&b))
+}
+
+// BatchMapToScalarField sets res[i] to points[i].MapToScalarField, using a single
+// field inversion.
+func BatchMapToScalarField(res []fr.Element, points []Element) {
+	ys := make([]fp.Element, len(points))
+	for i := range points {
+		ys[i].Set(&points[i].inner.Y)
+	}
+	yInvs := fp.BatchInvert(ys)
+	parallel.Execute(len(points), func(start, end int) {
+		var xOverY fp.Element
+		var b big.Int
+		for i := start; i < end; i++ {
+			xOverY.Mul(&points[i].inner.X, &yInvs[i])
+			res[i].SetBigInt(xOverY.BigInt(&b))
+		}
+	})
+}
+
+// BatchToAffine returns the Bandersnatch points in affine coordinates
+// representing the elements, using a single field inversion.
+func BatchToAffine(points []Element) []bandersnatch.PointAffine {
+	zs := make([]fp.Element, len(points))
+	for i := range points {
+		zs[i].Set(&points[i].inner.Z)
+	}
+	zInvs := fp.BatchInvert(zs)
+	res := make([]bandersnatch.PointAffine, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].X.Mul(&points[i].inner.X, &zInvs[i])
+			res[i].Y.Mul(&points[i].inner.Y, &zInvs[i])
+		}
+	})
+	return res
+}
@@ -0,0 +1,202 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package banderwagon
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func randomElement() Element {
+	var s fr.Element
+	s.SetRandom()
+	g := Generator()
+	var res Element
+	res.ScalarMultiplication(&g, &s)
+	return res
+}
+
+func TestSerializationVectors(t *testing.T) {
+	t.Parallel()
+
+	// serializations of the successive doublings 2ⁱ⋅G of the generator, from the
+	// Verkle trie specification https://github.com/crate-crypto/banderwagon
+	expected := []string{
+		"4a2c7486fd924882bf02c6908de395122843e3e05264d7991e18e7985dad51e9",
+		"43aa74ef706605705989e8fd38df46873b7eae5921fbed115ac9d937399ce4d5",
+		"5e5f550494159f38aa54d2ed7f11a7e93e4968617990445cc93ac8e59808c126",
+		"0e7e3748db7c5c999a7bcd93d71d671f1f40090423792266f94cb27ca43fce5c",
+		"14ddaa48820cb6523b9ae5fe9fe257cbbd1f3d598a28e670a40da5d1159d864a",
+		"6989d1c82b2d05c74b62fb0fbdf8843adae62ff720d370e209a7b84e14548a7d",
+		"26b8df6fa414bf348a3dc780ea53b70303ce49f3369212dec6fbe4b349b832bf",
+		"37e46072db18f038f2cc7d3d5b5d1374c0eb86ca46f869d6a95fc2fb092c0d35",
+		"2c1ce64f26e1c772282a6633fac7ca73067ae820637ce348bb2c8477d228dc7d",
+		"297ab0f5a8336a7a4e2657ad7a33a66e360fb6e50812d4be3326fab73d6cee07",
+		"5b285811efa7a965bd6ef5632151ebf399115fcc8f5b9b8083415ce533cc39ce",
+		"1f939fa2fd457b3effb82b25d3fe8ab965f54015f108f8c09d67e696294ab626",
+		"3088dcb4d3f4bacd706487648b239e0be3072ed2059d981fe04ce6525af6f1b8",
+		"35fbc386a16d0227ff8673bc3760ad6b11009f749bb82d4facaea67f58fc60ed",
+		"00f29b4f3255e318438f0a31e058e4c081085426adb0479f14c64985d0b956e0",
+		"3fa4384b2fa0ecc3c0582223602921daaa893a97b64bdf94dcaa504e8b7b9e5f",
+	}
+
+	acc := Generator()
+	for i, e := range expected {
+		b := acc.Bytes()
+		if hex.EncodeToString(b[:]) != e {
+			t.Fatalf("serialization of 2^%d⋅G does not match test vector", i)
+		}
+		var p Element
+		if err := p.SetBytes(b[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&acc) {
+			t.Fatalf("deserialization of 2^%d⋅G does not round trip", i)
+		}
+		acc.Double(&acc)
+	}
+}
+
+func TestElement(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 5
+	} else {
+		parameters.MinSuccessfulTests = 50
+	}
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("(x, y) and (-x, -y) should be the same element", prop.ForAll(
+		func() bool {
+			p := randomElement()
+			var q Element
+			q.inner.X.Neg(&p.inner.X)
+			q.inner.Y.Neg(&p.inner.Y)
+			q.inner.Z.Set(&p.inner.Z)
+			q.inner.T.Set(&p.inner.T)
+			var s1, s2 fr.Element
+			p.MapToScalarField(&s1)
+			q.MapToScalarField(&s2)
+			return p.Equal(&q) && p.Bytes() == q.Bytes() && s1.Equal(&s2)
+		},
+	))
+
+	properties.Property("SetBytes(Bytes()) should round trip", prop.ForAll(
+		func() bool {
+			p := randomElement()
+			b := p.Bytes()
+			var q Element
+			if err := q.SetBytes(b[:]); err != nil {
+				return false
+			}
+			return p.Equal(&q)
+		},
+	))
+
+	properties.Property("p - p should be the identity", prop.ForAll(
+		func() bool {
+			p := randomElement()
+			var q Element
+			q.Sub(&p, &p)
+			return q.IsIdentity() && !p.IsIdentity()
+		},
+	))
+
+	properties.Property("the scalar field should be the order of the group", prop.ForAll(
+		func() bool {
+			p := randomElement()
+			var s, sMinusOne fr.Element
+			s.SetRandom()
+			sMinusOne.SetOne()
+			sMinusOne.Sub(&s, &sMinusOne)
+			var q, r Element
+			q.ScalarMultiplication(&p, &s)
+			r.ScalarMultiplication(&p, &sMinusOne).Add(&r, &p)
+			return q.Equal(&r)
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestSetBytesInvalid(t *testing.T) {
+	t.Parallel()
+
+	var p Element
+	if err := p.SetBytes(make([]byte, SizeElement-1)); err != ErrInvalidEncoding {
+		t.Fatal("short buffer should be rejected")
+	}
+
+	// non canonical encoding
+	buf := make([]byte, SizeElement)
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	if err := p.SetBytes(buf); err != ErrInvalidEncoding {
+		t.Fatal("non canonical encoding should be rejected")
+	}
+
+	// about half of the x coordinates are not in the subgroup
+	nbRejected := 0
+	for i := 0; i < 64; i++ {
+		buf := make([]byte, SizeElement)
+		buf[SizeElement-1] = byte(i)
+		if err := p.SetBytes(buf); err != nil {
+			nbRejected++
+		}
+	}
+	if nbRejected == 0 || nbRejected == 64 {
+		t.Fatal("SetBytes should reject points outside the subgroup")
+	}
+}
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+
+	const nbPoints = 300
+	points := make([]Element, nbPoints)
+	scalars := make([]fr.Element, nbPoints)
+	expected := Identity()
+	for i := range points {
+		points[i] = randomElement()
+		scalars[i].SetRandom()
+		var tmp Element
+		tmp.ScalarMultiplication(&points[i], &scalars[i])
+		expected.Add(&expected, &tmp)
+	}
+
+	var res Element
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(&expected) {
+		t.Fatal("MultiExp does not match the sum of scalar multiplications")
+	}
+
+	mapped := make([]fr.Element, nbPoints)
+	BatchMapToScalarField(mapped, points)
+	serialized := BatchBytes(points)
+	for i := range points {
+		var s fr.Element
+		points[i].MapToScalarField(&s)
+		if !s.Equal(&mapped[i]) || serialized[i] != points[i].Bytes() {
+			t.Fatal("batch and single conversions differ")
+		}
+	}
+}
+
+func BenchmarkSetBytes(b *testing.B) {
+	p := randomElement()
+	buf := p.Bytes()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.SetBytes(buf[:])
+	}
+}
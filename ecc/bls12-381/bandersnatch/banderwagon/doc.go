@@ -0,0 +1,16 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package banderwagon implements the Banderwagon prime order group, the quotient of
+// the Bandersnatch curve used by Ethereum Verkle trees.
+//
+// Banderwagon is the subgroup 2⋅E of the Bandersnatch curve E, quotiented by the
+// 2-torsion point (0, -1): the points (x, y) and (-x, -y) are identified. It has
+// prime order r = |E|/4, and its scalar field is bandersnatch/fr.
+//
+// Elements are serialized on 32 bytes as the big-endian encoding of x⋅sign(y), where
+// sign(y) is 1 if y is lexicographically largest and -1 otherwise. Decoding checks
+// that the encoding is canonical and that the point is in the subgroup 2⋅E.
+//
+// See https://hackmd.io/@6iQDuIePQjyYBqDChYw_jg/BJBNcv9fq
+package banderwagon
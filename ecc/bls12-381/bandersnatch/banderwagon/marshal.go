@@ -0,0 +1,96 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package banderwagon
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// SizeElement is the size in bytes of a serialized element.
+const SizeElement = fp.Bytes
+
+var (
+	ErrInvalidEncoding = errors.New("invalid banderwagon encoding")
+	ErrNotInSubgroup   = errors.New("point is not in the banderwagon subgroup")
+)
+
+// Bytes returns the serialization of p, the big-endian encoding of x⋅sign(y),
+// where sign(y) = 1 if y is lexicographically largest and -1 otherwise.
+func (p *Element) Bytes() [SizeElement]byte {
+	var affine bandersnatch.PointAffine
+	affine.FromExtended(&p.inner)
+	return serialize(&affine)
+}
+
+// Marshal returns the serialization of p as a byte slice.
+func (p *Element) Marshal() []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+// SetBytes sets p from its serialization, checking that the encoding is canonical
+// and that the point is in the banderwagon subgroup.
+func (p *Element) SetBytes(buf []byte) error {
+	if len(buf) != SizeElement {
+		return ErrInvalidEncoding
+	}
+	var x fp.Element
+	if err := x.SetBytesCanonical(buf); err != nil {
+		return ErrInvalidEncoding
+	}
+
+	// the point is in 2⋅E iff 1 - a⋅x² is a square
+	var one, ax2, num, den, y fp.Element
+	one.SetOne()
+	ax2.Square(&x).Mul(&ax2, &curveParams.A)
+	num.Sub(&one, &ax2)
+	if num.Legendre() != 1 {
+		return ErrNotInSubgroup
+	}
+
+	// y² = (1 - a⋅x²) / (1 - d⋅x²)
+	den.Square(&x).Mul(&den, &curveParams.D)
+	den.Sub(&one, &den)
+	if den.IsZero() {
+		return ErrInvalidEncoding
+	}
+	y.Div(&num, &den)
+	if y.Sqrt(&y) == nil {
+		return ErrInvalidEncoding
+	}
+	if !y.LexicographicallyLargest() {
+		y.Neg(&y)
+	}
+
+	affine := bandersnatch.NewPointAffine(x, y)
+	p.inner.FromAffine(&affine)
+	return nil
+}
+
+// Unmarshal is an alias to SetBytes.
+func (p *Element) Unmarshal(buf []byte) error {
+	return p.SetBytes(buf)
+}
+
+// BatchBytes returns the serializations of the elements, using a single field inversion.
+func BatchBytes(points []Element) [][SizeElement]byte {
+	affine := BatchToAffine(points)
+	res := make([][SizeElement]byte, len(points))
+	for i := range affine {
+		res[i] = serialize(&affine[i])
+	}
+	return res
+}
+
+func serialize(p *bandersnatch.PointAffine) [SizeElement]byte {
+	var x fp.Element
+	x.Set(&p.X)
+	if !p.Y.LexicographicallyLargest() {
+		x.Neg(&x)
+	}
+	return x.Bytes()
+}
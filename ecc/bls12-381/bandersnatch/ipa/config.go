@@ -0,0 +1,176 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// DomainSize is the size of the domain used by Verkle trees.
+const DomainSize = 256
+
+// basisSeed is the seed of the hash to curve deriving the commitment basis.
+const basisSeed = "eth_verkle_oct_2021"
+
+var ErrInvalidDomainSize = errors.New("domain size should be a power of two")
+
+// Config holds the public parameters of the commitment scheme for polynomials
+// in Lagrange form over the domain {0, 1, …, n-1}.
+type Config struct {
+	// Basis of the Pedersen vector commitment, of size n
+	Basis []banderwagon.Element
+
+	// Q is the point used to bind the inner product in the opening proofs
+	Q banderwagon.Element
+
+	// aPrime[i] = A'(i) and weights[i] = 1/A'(i), where A(X) = ∏ⱼ(X-j) is the
+	// vanishing polynomial of the domain
+	aPrime, weights []fr.Element
+
+	// inverses[i] = 1/i for 0 < i < n
+	inverses []fr.Element
+}
+
+// NewConfig returns the parameters for polynomials over a domain of size
+// domainSize, which should be a power of two.
+func NewConfig(domainSize int) (Config, error) {
+	if domainSize < 2 || bits.OnesCount(uint(domainSize)) != 1 {
+		return Config{}, ErrInvalidDomainSize
+	}
+
+	cfg := Config{
+		Basis:    GenerateBasis(domainSize),
+		Q:        banderwagon.Generator(),
+		aPrime:   make([]fr.Element, domainSize),
+		inverses: make([]fr.Element, domainSize),
+	}
+
+	// A'(i) = ∏_{j≠i}(i-j)
+	var tmp fr.Element
+	for i := 0; i < domainSize; i++ {
+		cfg.aPrime[i].SetOne()
+		for j := 0; j < domainSize; j++ {
+			if j != i {
+				tmp.SetInt64(int64(i - j))
+				cfg.aPrime[i].Mul(&cfg.aPrime[i], &tmp)
+			}
+		}
+	}
+	cfg.weights = fr.BatchInvert(cfg.aPrime)
+
+	for i := 1; i < domainSize; i++ {
+		cfg.inverses[i].SetUint64(uint64(i))
+	}
+	cfg.inverses = fr.BatchInvert(cfg.inverses)
+
+	return cfg, nil
+}
+
+// GenerateBasis returns n points of the Banderwagon group with unknown discrete
+// logarithms relative to each other.
+//
+// The i-th candidate is the x coordinate sha256(seed || i), where i is encoded as
+// a 64-bit big-endian integer and the digest is reduced modulo the base field; it
+// is kept if it is the serialization of an element of the group.
+func GenerateBasis(n int) []banderwagon.Element {
+	res := make([]banderwagon.Element, 0, n)
+	var counter [8]byte
+	var x fp.Element
+	for i := uint64(0); len(res) < n; i++ {
+		h := sha256.New()
+		h.Write([]byte(basisSeed))
+		binary.BigEndian.PutUint64(counter[:], i)
+		h.Write(counter[:])
+		x.SetBytes(h.Sum(nil))
+		xBytes := x.Bytes()
+
+		var p banderwagon.Element
+		if err := p.SetBytes(xBytes[:]); err != nil {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// DomainSize returns the size of the evaluation domain.
+func (cfg *Config) DomainSize() int {
+	return len(cfg.Basis)
+}
+
+// Evaluate returns the evaluation at z of the polynomial in Lagrange form.
+func (cfg *Config) Evaluate(polynomial []fr.Element, z fr.Element) fr.Element {
+	return innerProduct(polynomial, cfg.lagrangeBasisAt(z))
+}
+
+// lagrangeBasisAt returns the evaluations Lᵢ(z) of the Lagrange polynomials of the domain,
+// using the barycentric formula Lᵢ(z) = A(z) / (A'(i)⋅(z-i)).
+func (cfg *Config) lagrangeBasisAt(z fr.Element) []fr.Element {
+	n := cfg.DomainSize()
+	res := make([]fr.Element, n)
+
+	// z in the domain
+	if z.IsUint64() && z.Uint64() < uint64(n) {
+		res[z.Uint64()].SetOne()
+		return res
+	}
+
+	// A(z) = ∏ᵢ(z-i)
+	var az, zMinusI, one fr.Element
+	az.SetOne()
+	one.SetOne()
+	zMinusI.Set(&z)
+	for i := 0; i < n; i++ {
+		res[i].Set(&zMinusI)
+		az.Mul(&az, &zMinusI)
+		zMinusI.Sub(&zMinusI, &one)
+	}
+
+	res = fr.BatchInvert(res)
+	for i := 0; i < n; i++ {
+		res[i].Mul(&res[i], &cfg.weights[i]).Mul(&res[i], &az)
+	}
+	return res
+}
+
+// divideOnDomain returns the Lagrange form of (f(X) - f(m)) / (X - m) for m in the domain.
+//
+// For i ≠ m, the quotient is qᵢ = (fᵢ - fₘ)/(i - m), and qₘ = f'(m) = -∑_{i≠m} A'(m)/A'(i)⋅qᵢ.
+func (cfg *Config) divideOnDomain(f []fr.Element, m int) []fr.Element {
+	q := make([]fr.Element, len(f))
+	var ratio, tmp fr.Element
+	for i := range f {
+		if i == m {
+			continue
+		}
+		q[i].Sub(&f[i], &f[m])
+		if i > m {
+			q[i].Mul(&q[i], &cfg.inverses[i-m])
+		} else {
+			q[i].Mul(&q[i], &cfg.inverses[m-i]).Neg(&q[i])
+		}
+
+		ratio.Mul(&cfg.aPrime[m], &cfg.weights[i])
+		tmp.Mul(&ratio, &q[i])
+		q[m].Sub(&q[m], &tmp)
+	}
+	return q
+}
+
+// innerProduct returns ∑ᵢ a[i]⋅b[i].
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	for i := 0; i < len(a) && i < len(b); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
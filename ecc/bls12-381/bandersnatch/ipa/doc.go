@@ -0,0 +1,25 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package ipa implements the polynomial commitment scheme used by Ethereum Verkle
+// trees: Pedersen vector commitments over the Banderwagon group, opened with an
+// inner product argument (IPA).
+//
+// Polynomials are given in Lagrange form over the domain {0, 1, …, n-1}, where the
+// domain size n is a power of two (256 for Verkle trees): the i-th coefficient is
+// the evaluation of the polynomial at i. The commitment to f is ∑ᵢ f(i)⋅Gᵢ, where
+// the basis (Gᵢ) is derived by hashing to the curve, so that no trusted setup is
+// needed.
+//
+// Open and Verify prove and check the evaluation of a committed polynomial at an
+// arbitrary point, in O(log n) group elements. BatchOpen and BatchVerify aggregate
+// the openings of many polynomials at points of the domain into a single proof
+// (the Verkle multiproof), see https://dankradfeist.de/ethereum/2021/06/18/pcs-multiproofs.html.
+//
+// The challenges are derived with fiat-shamir.Transcript, so proofs are not
+// interchangeable with implementations using a different transcript.
+//
+// Documentation:
+//   - inner product argument: https://eprint.iacr.org/2019/1021.pdf, section 3
+//   - Verkle trees: https://notes.ethereum.org/@vbuterin/verkle_tree_eip
+package ipa
@@ -0,0 +1,271 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"errors"
+	"hash"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidPolynomialSize  = errors.New("invalid polynomial size (larger than the domain or empty)")
+	ErrInvalidNumberOfDigests = errors.New("number of digests should be equal to the number of polynomials")
+	ErrInvalidNumberOfPoints  = errors.New("number of points should be equal to the number of polynomials")
+	ErrInvalidPoint           = errors.New("point is not in the domain")
+	ErrInvalidProof           = errors.New("number of rounds of the proof does not match the domain size")
+	ErrVerifyOpeningProof     = errors.New("can't verify opening proof")
+)
+
+// Digest commitment of a polynomial.
+type Digest = banderwagon.Element
+
+// InnerProductProof proves that the inner product of the vector committed in C
+// with a public vector b is v. At each round, the vectors are folded in half.
+type InnerProductProof struct {
+	// L[j], R[j] commit to the cross terms of the j-th round
+	L, R []banderwagon.Element
+
+	// A is the vector committed in C, folded down to a single scalar
+	A fr.Element
+}
+
+// OpeningProof IPA proof for a single polynomial and a single point.
+type OpeningProof struct {
+	// IPA proves that ⟨f, (Lᵢ(z))ᵢ⟩ = f(z)
+	IPA InnerProductProof
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// Commit commits to a polynomial in Lagrange form, of size at most the domain size,
+// as ∑ᵢ f(i)⋅Gᵢ.
+func Commit(polynomial []fr.Element, cfg Config) (Digest, error) {
+	var res Digest
+	if len(polynomial) == 0 || len(polynomial) > cfg.DomainSize() {
+		return res, ErrInvalidPolynomialSize
+	}
+	if _, err := res.MultiExp(cfg.Basis[:len(polynomial)], polynomial, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// Open computes an opening proof of the polynomial in Lagrange form, committed in digest, at point.
+// dataTranscript is some extra data that might be needed for Fiat Shamir, and is bound to
+// the first challenge.
+func Open(polynomial []fr.Element, digest Digest, point fr.Element, hf hash.Hash, cfg Config, dataTranscript ...[]byte) (OpeningProof, error) {
+	var res OpeningProof
+	if len(polynomial) == 0 || len(polynomial) > cfg.DomainSize() {
+		return res, ErrInvalidPolynomialSize
+	}
+	a := make([]fr.Element, cfg.DomainSize())
+	copy(a, polynomial)
+	b := cfg.lagrangeBasisAt(point)
+	res.ClaimedValue = innerProduct(a, b)
+
+	fs := fiatshamir.NewTranscript(hf, challengeIDs(cfg.DomainSize())...)
+	var err error
+	res.IPA, err = proveInnerProduct(fs, cfg, &digest, a, b, point, res.ClaimedValue, dataTranscript...)
+	return res, err
+}
+
+// Verify checks that the polynomial committed in digest evaluates to proof.ClaimedValue at point.
+func Verify(digest Digest, proof OpeningProof, point fr.Element, hf hash.Hash, cfg Config, dataTranscript ...[]byte) error {
+	fs := fiatshamir.NewTranscript(hf, challengeIDs(cfg.DomainSize())...)
+	b := cfg.lagrangeBasisAt(point)
+	return verifyInnerProduct(fs, cfg, &digest, &proof.IPA, b, point, proof.ClaimedValue, dataTranscript...)
+}
+
+// challengeIDs returns the names of the challenges of the inner product argument:
+// w to bind the inner product, and xⱼ for the j-th round.
+func challengeIDs(domainSize int) []string {
+	nbRounds := bits.TrailingZeros(uint(domainSize))
+	res := make([]string, nbRounds+1)
+	res[0] = "w"
+	for j := 0; j < nbRounds; j++ {
+		res[j+1] = "x" + strconv.Itoa(j)
+	}
+	return res
+}
+
+// proveInnerProduct proves that ⟨a, b⟩ = value, where a is committed in commitment
+// and b is the Lagrange basis at point.
+func proveInnerProduct(fs *fiatshamir.Transcript, cfg Config, commitment *banderwagon.Element, a, b []fr.Element, point, value fr.Element, dataTranscript ...[]byte) (InnerProductProof, error) {
+	var res InnerProductProof
+
+	// q = w⋅Q binds the inner product to the commitment
+	w, err := deriveChallenge(fs, "w", []banderwagon.Element{*commitment}, []fr.Element{point, value}, dataTranscript...)
+	if err != nil {
+		return res, err
+	}
+	var q banderwagon.Element
+	q.ScalarMultiplication(&cfg.Q, &w)
+
+	g := make([]banderwagon.Element, len(cfg.Basis))
+	copy(g, cfg.Basis)
+	a = append([]fr.Element(nil), a...)
+	b = append([]fr.Element(nil), b...)
+
+	nbRounds := bits.TrailingZeros(uint(len(g)))
+	res.L = make([]banderwagon.Element, nbRounds)
+	res.R = make([]banderwagon.Element, nbRounds)
+	points := make([]banderwagon.Element, 0, len(g)/2+1)
+	scalars := make([]fr.Element, 0, len(g)/2+1)
+	for j := 0; j < nbRounds; j++ {
+		m := len(a) / 2
+		aL, aR := a[:m], a[m:]
+		bL, bR := b[:m], b[m:]
+		gL, gR := g[:m], g[m:]
+
+		// L = ⟨a_R, G_L⟩ + ⟨a_R, b_L⟩⋅q
+		points = append(append(points[:0], gL...), q)
+		scalars = append(append(scalars[:0], aR...), innerProduct(aR, bL))
+		if _, err := res.L[j].MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+			return res, err
+		}
+
+		// R = ⟨a_L, G_R⟩ + ⟨a_L, b_R⟩⋅q
+		points = append(append(points[:0], gR...), q)
+		scalars = append(append(scalars[:0], aL...), innerProduct(aL, bR))
+		if _, err := res.R[j].MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+			return res, err
+		}
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), []banderwagon.Element{res.L[j], res.R[j]}, nil)
+		if err != nil {
+			return res, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		// a ← a_L + x⋅a_R, b ← b_L + x⁻¹⋅b_R, G ← G_L + x⁻¹⋅G_R
+		parallel.Execute(m, func(start, end int) {
+			var tmp fr.Element
+			var tmpG banderwagon.Element
+			for i := start; i < end; i++ {
+				tmp.Mul(&x, &aR[i])
+				aL[i].Add(&aL[i], &tmp)
+				tmp.Mul(&xInv, &bR[i])
+				bL[i].Add(&bL[i], &tmp)
+				tmpG.ScalarMultiplication(&gR[i], &xInv)
+				gL[i].Add(&gL[i], &tmpG)
+			}
+		})
+		a, b, g = aL, bL, gL
+	}
+	res.A.Set(&a[0])
+
+	return res, nil
+}
+
+// verifyInnerProduct checks that ⟨a, b⟩ = value, where a is committed in commitment.
+//
+// With the challenges xⱼ, the folded basis is G₀ = ∑ᵢsᵢGᵢ and the folded b is b₀ = ⟨s, b⟩,
+// where sᵢ is the product of the xⱼ⁻¹ for the rounds j in which i was in the right half.
+// The proof is valid iff
+//
+//	C + value⋅q + ∑ⱼ(xⱼ⋅Lⱼ + xⱼ⁻¹⋅Rⱼ) = A⋅G₀ + (A⋅b₀)⋅q
+func verifyInnerProduct(fs *fiatshamir.Transcript, cfg Config, commitment *banderwagon.Element, proof *InnerProductProof, b []fr.Element, point, value fr.Element, dataTranscript ...[]byte) error {
+	n := cfg.DomainSize()
+	nbRounds := bits.TrailingZeros(uint(n))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidProof
+	}
+
+	w, err := deriveChallenge(fs, "w", []banderwagon.Element{*commitment}, []fr.Element{point, value}, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	x := make([]fr.Element, nbRounds)
+	for j := 0; j < nbRounds; j++ {
+		x[j], err = deriveChallenge(fs, "x"+strconv.Itoa(j), []banderwagon.Element{proof.L[j], proof.R[j]}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	xInv := fr.BatchInvert(x)
+
+	// the bits of i, from the most significant, tell in which half i was at each round
+	s := make([]fr.Element, 1, n)
+	s[0].SetOne()
+	for j := 0; j < nbRounds; j++ {
+		s = s[:2*len(s)]
+		for i := len(s)/2 - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &xInv[j])
+			s[2*i].Set(&s[i])
+		}
+	}
+	b0 := innerProduct(s, b)
+
+	// A⋅G₀ + (A⋅b₀ - value)⋅q - ∑ⱼ(xⱼ⋅Lⱼ + xⱼ⁻¹⋅Rⱼ) - C = 0, with q = w⋅Q
+	points := make([]banderwagon.Element, 0, n+2*nbRounds+2)
+	scalars := make([]fr.Element, 0, n+2*nbRounds+2)
+	points = append(points, cfg.Basis...)
+	for i := range s {
+		s[i].Mul(&s[i], &proof.A)
+	}
+	scalars = append(scalars, s...)
+
+	var qScalar fr.Element
+	qScalar.Mul(&proof.A, &b0).Sub(&qScalar, &value).Mul(&qScalar, &w)
+	points = append(points, cfg.Q)
+	scalars = append(scalars, qScalar)
+
+	var minusOne, minusX, minusXInv fr.Element
+	minusOne.SetOne().Neg(&minusOne)
+	for j := 0; j < nbRounds; j++ {
+		minusX.Neg(&x[j])
+		minusXInv.Neg(&xInv[j])
+		points = append(points, proof.L[j], proof.R[j])
+		scalars = append(scalars, minusX, minusXInv)
+	}
+	points = append(points, *commitment)
+	scalars = append(scalars, minusOne)
+
+	var check banderwagon.Element
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.IsIdentity() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// deriveChallenge binds the points, the scalars and dataTranscript, in this order, to the
+// challenge name and returns it.
+func deriveChallenge(fs *fiatshamir.Transcript, name string, points []banderwagon.Element, scalars []fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	for i := range points {
+		if err := fs.Bind(name, points[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range scalars {
+		if err := fs.Bind(name, scalars[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind(name, dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var challenge fr.Element
+	challenge.SetBytes(b)
+	return challenge, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+)
+
+var testConfig Config
+
+func init() {
+	var err error
+	testConfig, err = NewConfig(DomainSize)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomPolynomial(size int) []fr.Element {
+	f := make([]fr.Element, size)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	return f
+}
+
+func TestGenerateBasis(t *testing.T) {
+	t.Parallel()
+
+	// https://github.com/crate-crypto/go-ipa, test vectors of the Verkle trie specification
+	first := testConfig.Basis[0].Bytes()
+	if hex.EncodeToString(first[:]) != "01587ad1336675eb912550ec2a28eb8923b824b490dd2ba82e48f14590a298a0" {
+		t.Fatal("first point of the basis does not match test vector")
+	}
+	h := sha256.New()
+	for i := range testConfig.Basis {
+		b := testConfig.Basis[i].Bytes()
+		h.Write(b[:])
+	}
+	if hex.EncodeToString(h.Sum(nil)) != "1fcaea10bf24f750200e06fa473c76ff0468007291fa548e2d99f09ba9256fdb" {
+		t.Fatal("hash of the basis does not match test vector")
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 3, 100} {
+		if _, err := NewConfig(n); err != ErrInvalidDomainSize {
+			t.Fatalf("domain size %d should be rejected", n)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	// f(X) = 3X² + X + 7 in Lagrange form
+	f := make([]fr.Element, DomainSize)
+	poly := func(x fr.Element) fr.Element {
+		var res, tmp fr.Element
+		res.Square(&x)
+		tmp.SetUint64(3)
+		res.Mul(&res, &tmp).Add(&res, &x)
+		tmp.SetUint64(7)
+		res.Add(&res, &tmp)
+		return res
+	}
+	for i := range f {
+		var x fr.Element
+		x.SetUint64(uint64(i))
+		f[i] = poly(x)
+	}
+
+	var z fr.Element
+	z.SetRandom()
+	expected := poly(z)
+	if got := testConfig.Evaluate(f, z); !got.Equal(&expected) {
+		t.Fatal("barycentric evaluation outside the domain is wrong")
+	}
+	z.SetUint64(42)
+	if got := testConfig.Evaluate(f, z); !got.Equal(&f[42]) {
+		t.Fatal("evaluation in the domain is wrong")
+	}
+
+	// (f(X) - f(5)) / (X - 5) = 3X + 16
+	q := testConfig.divideOnDomain(f, 5)
+	for i := range q {
+		var expected fr.Element
+		expected.SetUint64(uint64(3*i + 16))
+		if !q[i].Equal(&expected) {
+			t.Fatalf("quotient is wrong at %d", i)
+		}
+	}
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	f := randomPolynomial(DomainSize)
+	digest, err := Commit(f, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(f, digest, point, sha256.New(), testConfig, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := testConfig.Evaluate(f, point); !proof.ClaimedValue.Equal(&expected) {
+		t.Fatal("wrong claimed value")
+	}
+	if err := Verify(digest, proof, point, sha256.New(), testConfig, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded OpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	if err != nil || read != written {
+		t.Fatal("can't decode the proof", err)
+	}
+	if err := Verify(digest, decoded, point, sha256.New(), testConfig, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong value
+	wrong := proof
+	wrong.ClaimedValue.SetRandom()
+	if err := Verify(digest, wrong, point, sha256.New(), testConfig, []byte("data")); err != ErrVerifyOpeningProof {
+		t.Fatal("wrong claimed value should not verify")
+	}
+
+	// wrong point
+	var other fr.Element
+	other.SetRandom()
+	if err := Verify(digest, proof, other, sha256.New(), testConfig, []byte("data")); err != ErrVerifyOpeningProof {
+		t.Fatal("wrong point should not verify")
+	}
+
+	// wrong transcript data
+	if err := Verify(digest, proof, point, sha256.New(), testConfig); err != ErrVerifyOpeningProof {
+		t.Fatal("wrong transcript data should not verify")
+	}
+
+	// truncated proof
+	wrong = proof
+	wrong.IPA.L = wrong.IPA.L[1:]
+	if err := Verify(digest, wrong, point, sha256.New(), testConfig, []byte("data")); err != ErrInvalidProof {
+		t.Fatal("truncated proof should be rejected")
+	}
+}
+
+func TestOpenInDomain(t *testing.T) {
+	t.Parallel()
+
+	// short polynomials are padded with zeros
+	f := randomPolynomial(DomainSize / 2)
+	digest, err := Commit(f, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var point fr.Element
+	point.SetUint64(3)
+	proof, err := Open(f, digest, point, sha256.New(), testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.ClaimedValue.Equal(&f[3]) {
+		t.Fatal("wrong claimed value")
+	}
+	if err := Verify(digest, proof, point, sha256.New(), testConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Commit(randomPolynomial(DomainSize+1), testConfig); err != ErrInvalidPolynomialSize {
+		t.Fatal("polynomial larger than the domain should be rejected")
+	}
+}
+
+func BenchmarkCommit(b *testing.B) {
+	f := randomPolynomial(DomainSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Commit(f, testConfig)
+	}
+}
+
+func BenchmarkOpen(b *testing.B) {
+	f := randomPolynomial(DomainSize)
+	digest, _ := Commit(f, testConfig)
+	var point fr.Element
+	point.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Open(f, digest, point, sha256.New(), testConfig)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	f := randomPolynomial(DomainSize)
+	digest, _ := Commit(f, testConfig)
+	var point fr.Element
+	point.SetRandom()
+	proof, _ := Open(f, digest, point, sha256.New(), testConfig)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(digest, proof, point, sha256.New(), testConfig)
+	}
+}
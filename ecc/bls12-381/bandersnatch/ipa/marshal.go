@@ -0,0 +1,192 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+)
+
+// maxNbRounds bounds the number of rounds read from a proof (domains of size up to 2³²).
+const maxNbRounds = 32
+
+// WriteTo writes binary encoding of an InnerProductProof:
+// the number of rounds as a big-endian uint32, then L, R and A.
+func (proof *InnerProductProof) WriteTo(w io.Writer) (int64, error) {
+	enc := encoder{w: w}
+	enc.writeLength(len(proof.L))
+	enc.writePoints(proof.L)
+	enc.writePoints(proof.R)
+	enc.writeScalars([]fr.Element{proof.A})
+	return enc.n, enc.err
+}
+
+// ReadFrom reads binary encoding of an InnerProductProof.
+func (proof *InnerProductProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	nbRounds := dec.readLength(maxNbRounds)
+	proof.L = dec.readPoints(nbRounds)
+	proof.R = dec.readPoints(nbRounds)
+	if a := dec.readScalars(1); len(a) == 1 {
+		proof.A = a[0]
+	}
+	return dec.n, dec.err
+}
+
+// WriteTo writes binary encoding of an OpeningProof.
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	n, err := proof.IPA.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	enc := encoder{w: w, n: n}
+	enc.writeScalars([]fr.Element{proof.ClaimedValue})
+	return enc.n, enc.err
+}
+
+// ReadFrom reads binary encoding of an OpeningProof.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := proof.IPA.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	dec := decoder{r: r, n: n}
+	if v := dec.readScalars(1); len(v) == 1 {
+		proof.ClaimedValue = v[0]
+	}
+	return dec.n, dec.err
+}
+
+// WriteTo writes binary encoding of a BatchOpeningProof:
+// D, the inner product proof, then the number of claimed values as a big-endian
+// uint32 followed by the claimed values.
+func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := encoder{w: w}
+	enc.writePoints([]banderwagon.Element{proof.D})
+	if enc.err != nil {
+		return enc.n, enc.err
+	}
+	n, err := proof.IPA.WriteTo(w)
+	enc.n += n
+	if err != nil {
+		return enc.n, err
+	}
+	enc.writeLength(len(proof.ClaimedValues))
+	enc.writeScalars(proof.ClaimedValues)
+	return enc.n, enc.err
+}
+
+// ReadFrom reads binary encoding of a BatchOpeningProof.
+func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	if d := dec.readPoints(1); len(d) == 1 {
+		proof.D = d[0]
+	}
+	if dec.err != nil {
+		return dec.n, dec.err
+	}
+	n, err := proof.IPA.ReadFrom(r)
+	dec.n += n
+	if err != nil {
+		return dec.n, err
+	}
+	nbValues := dec.readLength(1 << 24)
+	proof.ClaimedValues = dec.readScalars(nbValues)
+	return dec.n, dec.err
+}
+
+type encoder struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (enc *encoder) write(b []byte) {
+	if enc.err != nil {
+		return
+	}
+	n, err := enc.w.Write(b)
+	enc.n += int64(n)
+	enc.err = err
+}
+
+func (enc *encoder) writeLength(l int) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(l))
+	enc.write(b[:])
+}
+
+func (enc *encoder) writePoints(points []banderwagon.Element) {
+	for _, b := range banderwagon.BatchBytes(points) {
+		enc.write(b[:])
+	}
+}
+
+func (enc *encoder) writeScalars(scalars []fr.Element) {
+	for i := range scalars {
+		b := scalars[i].Bytes()
+		enc.write(b[:])
+	}
+}
+
+var errTooLarge = errors.New("length prefix is too large")
+
+type decoder struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (dec *decoder) read(b []byte) {
+	if dec.err != nil {
+		return
+	}
+	n, err := io.ReadFull(dec.r, b)
+	dec.n += int64(n)
+	dec.err = err
+}
+
+func (dec *decoder) readLength(maxLength int) int {
+	var b [4]byte
+	dec.read(b[:])
+	if dec.err != nil {
+		return 0
+	}
+	l := binary.BigEndian.Uint32(b[:])
+	if l > uint32(maxLength) {
+		dec.err = errTooLarge
+		return 0
+	}
+	return int(l)
+}
+
+func (dec *decoder) readPoints(n int) []banderwagon.Element {
+	res := make([]banderwagon.Element, n)
+	var b [banderwagon.SizeElement]byte
+	for i := range res {
+		dec.read(b[:])
+		if dec.err != nil {
+			return nil
+		}
+		dec.err = res[i].SetBytes(b[:])
+	}
+	return res
+}
+
+func (dec *decoder) readScalars(n int) []fr.Element {
+	res := make([]fr.Element, n)
+	var b [fr.Bytes]byte
+	for i := range res {
+		dec.read(b[:])
+		if dec.err != nil {
+			return nil
+		}
+		dec.err = res[i].SetBytesCanonical(b[:])
+	}
+	return res
+}
@@ -0,0 +1,207 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// BatchOpeningProof proof that the polynomials fᵢ, committed in Cᵢ, evaluate to yᵢ at
+// the points zᵢ of the domain.
+//
+// With the challenges r and t, the prover commits in D to g(X) = ∑ᵢ rⁱ(fᵢ(X) - yᵢ)/(X - zᵢ),
+// and proves with an inner product argument that h - g, where h(X) = ∑ᵢ rⁱfᵢ(X)/(t - zᵢ),
+// evaluates to ∑ᵢ rⁱyᵢ/(t - zᵢ) at t. The verifier computes the commitment E to h as
+// ∑ᵢ rⁱ/(t - zᵢ)⋅Cᵢ.
+type BatchOpeningProof struct {
+	// D commitment to g
+	D banderwagon.Element
+
+	// IPA opening of h - g at t
+	IPA InnerProductProof
+
+	// ClaimedValues yᵢ = fᵢ(zᵢ)
+	ClaimedValues []fr.Element
+}
+
+// BatchOpen computes a proof that the polynomials in Lagrange form, committed in digests,
+// evaluate to polynomials[i][points[i]] at the points of the domain points[i].
+// The same polynomial may be opened at several points by repeating it.
+// dataTranscript is some extra data that might be needed for Fiat Shamir, and is bound to
+// the first challenge.
+func BatchOpen(polynomials [][]fr.Element, digests []Digest, points []int, hf hash.Hash, cfg Config, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	var res BatchOpeningProof
+	if len(polynomials) != len(digests) {
+		return res, ErrInvalidNumberOfDigests
+	}
+	if len(polynomials) != len(points) {
+		return res, ErrInvalidNumberOfPoints
+	}
+	n := cfg.DomainSize()
+	for i := range polynomials {
+		if len(polynomials[i]) == 0 || len(polynomials[i]) > n {
+			return res, ErrInvalidPolynomialSize
+		}
+		if points[i] < 0 || points[i] >= n {
+			return res, ErrInvalidPoint
+		}
+	}
+
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	for i := range polynomials {
+		if points[i] < len(polynomials[i]) {
+			res.ClaimedValues[i].Set(&polynomials[i][points[i]])
+		}
+	}
+
+	fs := fiatshamir.NewTranscript(hf, append([]string{"r", "t"}, challengeIDs(n)...)...)
+	r, err := deriveBatchChallenge(fs, digests, points, res.ClaimedValues, dataTranscript...)
+	if err != nil {
+		return res, err
+	}
+
+	// aggregated[z] = ∑_{i, zᵢ = z} rⁱfᵢ
+	aggregated := make(map[int][]fr.Element)
+	var rPower, tmp fr.Element
+	rPower.SetOne()
+	for i := range polynomials {
+		agg, ok := aggregated[points[i]]
+		if !ok {
+			agg = make([]fr.Element, n)
+			aggregated[points[i]] = agg
+		}
+		for j := range polynomials[i] {
+			tmp.Mul(&rPower, &polynomials[i][j])
+			agg[j].Add(&agg[j], &tmp)
+		}
+		rPower.Mul(&rPower, &r)
+	}
+
+	// g = ∑_z (aggregated[z](X) - aggregated[z](z)) / (X - z)
+	g := make([]fr.Element, n)
+	for z, agg := range aggregated {
+		q := cfg.divideOnDomain(agg, z)
+		for j := range g {
+			g[j].Add(&g[j], &q[j])
+		}
+	}
+	if res.D, err = Commit(g, cfg); err != nil {
+		return res, err
+	}
+
+	t, err := deriveChallenge(fs, "t", []banderwagon.Element{res.D}, nil)
+	if err != nil {
+		return res, err
+	}
+
+	// h = ∑_z aggregated[z] / (t - z)
+	zs := make([]int, 0, len(aggregated))
+	den := make([]fr.Element, 0, len(aggregated))
+	for z := range aggregated {
+		zs = append(zs, z)
+		tmp.SetUint64(uint64(z))
+		den = append(den, *tmp.Sub(&t, &tmp))
+	}
+	den = fr.BatchInvert(den)
+	h := make([]fr.Element, n)
+	for k, z := range zs {
+		agg := aggregated[z]
+		for j := range h {
+			tmp.Mul(&agg[j], &den[k])
+			h[j].Add(&h[j], &tmp)
+		}
+	}
+	e, err := Commit(h, cfg)
+	if err != nil {
+		return res, err
+	}
+
+	// open h - g at t
+	for j := range h {
+		h[j].Sub(&h[j], &g[j])
+	}
+	var eMinusD banderwagon.Element
+	eMinusD.Sub(&e, &res.D)
+	b := cfg.lagrangeBasisAt(t)
+	res.IPA, err = proveInnerProduct(fs, cfg, &eMinusD, h, b, t, innerProduct(h, b))
+
+	return res, err
+}
+
+// BatchVerify checks that the polynomials committed in digests evaluate to
+// proof.ClaimedValues at the points of the domain points.
+func BatchVerify(proof BatchOpeningProof, digests []Digest, points []int, hf hash.Hash, cfg Config, dataTranscript ...[]byte) error {
+	if len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNumberOfDigests
+	}
+	if len(digests) != len(points) {
+		return ErrInvalidNumberOfPoints
+	}
+	n := cfg.DomainSize()
+	for i := range points {
+		if points[i] < 0 || points[i] >= n {
+			return ErrInvalidPoint
+		}
+	}
+
+	fs := fiatshamir.NewTranscript(hf, append([]string{"r", "t"}, challengeIDs(n)...)...)
+	r, err := deriveBatchChallenge(fs, digests, points, proof.ClaimedValues, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	t, err := deriveChallenge(fs, "t", []banderwagon.Element{proof.D}, nil)
+	if err != nil {
+		return err
+	}
+
+	// coefficients[i] = rⁱ/(t - zᵢ)
+	coefficients := make([]fr.Element, len(points))
+	for i := range points {
+		coefficients[i].SetUint64(uint64(points[i]))
+		coefficients[i].Sub(&t, &coefficients[i])
+	}
+	coefficients = fr.BatchInvert(coefficients)
+	var rPower, value, tmp fr.Element
+	rPower.SetOne()
+	for i := range coefficients {
+		coefficients[i].Mul(&coefficients[i], &rPower)
+		tmp.Mul(&coefficients[i], &proof.ClaimedValues[i])
+		value.Add(&value, &tmp)
+		rPower.Mul(&rPower, &r)
+	}
+
+	// E = ∑ᵢ rⁱ/(t - zᵢ)⋅Cᵢ
+	var e banderwagon.Element
+	if _, err := e.MultiExp(digests, coefficients, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	e.Sub(&e, &proof.D)
+
+	b := cfg.lagrangeBasisAt(t)
+	return verifyInnerProduct(fs, cfg, &e, &proof.IPA, b, t, value)
+}
+
+// deriveBatchChallenge derives the challenge r, bound to the digests, the points, the
+// claimed values and dataTranscript.
+func deriveBatchChallenge(fs *fiatshamir.Transcript, digests []Digest, points []int, claimedValues []fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	var z fr.Element
+	for i := range digests {
+		if err := fs.Bind("r", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+		z.SetUint64(uint64(points[i]))
+		if err := fs.Bind("r", z.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+		if err := fs.Bind("r", claimedValues[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return deriveChallenge(fs, "r", nil, nil, dataTranscript...)
+}
@@ -0,0 +1,123 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ipa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+)
+
+func TestBatchOpen(t *testing.T) {
+	t.Parallel()
+
+	// the first polynomial is opened twice, and two polynomials share the point 7
+	const nbPolynomials = 5
+	polynomials := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	for i := 0; i < nbPolynomials-1; i++ {
+		polynomials[i] = randomPolynomial(DomainSize)
+		var err error
+		if digests[i], err = Commit(polynomials[i], testConfig); err != nil {
+			t.Fatal(err)
+		}
+	}
+	polynomials[nbPolynomials-1] = polynomials[0]
+	digests[nbPolynomials-1] = digests[0]
+	points := []int{7, 7, 0, 255, 128}
+
+	proof, err := BatchOpen(polynomials, digests, points, sha256.New(), testConfig, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range points {
+		if !proof.ClaimedValues[i].Equal(&polynomials[i][points[i]]) {
+			t.Fatal("wrong claimed value")
+		}
+	}
+	if err := BatchVerify(proof, digests, points, sha256.New(), testConfig, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded BatchOpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	if err != nil || read != written {
+		t.Fatal("can't decode the proof", err)
+	}
+	if err := BatchVerify(decoded, digests, points, sha256.New(), testConfig, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong claimed value
+	wrong := proof
+	wrong.ClaimedValues = append([]fr.Element(nil), proof.ClaimedValues...)
+	wrong.ClaimedValues[2].SetRandom()
+	if err := BatchVerify(wrong, digests, points, sha256.New(), testConfig, []byte("data")); err != ErrVerifyOpeningProof {
+		t.Fatal("wrong claimed value should not verify")
+	}
+
+	// wrong point
+	wrongPoints := []int{7, 7, 1, 255, 128}
+	if err := BatchVerify(proof, digests, wrongPoints, sha256.New(), testConfig, []byte("data")); err != ErrVerifyOpeningProof {
+		t.Fatal("wrong point should not verify")
+	}
+
+	// swapped digests
+	swapped := []Digest{digests[1], digests[0], digests[2], digests[3], digests[4]}
+	if err := BatchVerify(proof, swapped, points, sha256.New(), testConfig, []byte("data")); err != ErrVerifyOpeningProof {
+		t.Fatal("swapped digests should not verify")
+	}
+
+	// invalid inputs
+	if _, err := BatchOpen(polynomials, digests, []int{7, 7, 0, 256, 128}, sha256.New(), testConfig); err != ErrInvalidPoint {
+		t.Fatal("point outside the domain should be rejected")
+	}
+	if _, err := BatchOpen(polynomials, digests[:4], points, sha256.New(), testConfig); err != ErrInvalidNumberOfDigests {
+		t.Fatal("wrong number of digests should be rejected")
+	}
+	if err := BatchVerify(proof, digests, points[:4], sha256.New(), testConfig); err != ErrInvalidNumberOfPoints {
+		t.Fatal("wrong number of points should be rejected")
+	}
+}
+
+func BenchmarkBatchOpen(b *testing.B) {
+	const nbPolynomials = 128
+	polynomials := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	points := make([]int, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(DomainSize)
+		digests[i], _ = Commit(polynomials[i], testConfig)
+		points[i] = (i * 37) % DomainSize
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchOpen(polynomials, digests, points, sha256.New(), testConfig)
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	const nbPolynomials = 128
+	polynomials := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	points := make([]int, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(DomainSize)
+		digests[i], _ = Commit(polynomials[i], testConfig)
+		points[i] = (i * 37) % DomainSize
+	}
+	proof, _ := BatchOpen(polynomials, digests, points, sha256.New(), testConfig)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchVerify(proof, digests, points, sha256.New(), testConfig)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// randomPoints returns n random multiples of the base point
+func randomPoints(n int) []PointAffine {
+	params := GetEdwardsCurve()
+	points := make([]PointAffine, n)
+	var s fr.Element
+	var bs big.Int
+	for i := range points {
+		s.SetRandom()
+		points[i].ScalarMultiplication(&params.Base, s.BigInt(&bs))
+	}
+	return points
+}
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 2
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	for _, nbPoints := range []int{1, 7, 73, 1100} {
+		nbPoints := nbPoints
+		properties.Property(fmt.Sprintf("MultiExp of %d points should match the sum of scalar multiplications", nbPoints), prop.ForAll(
+			func() bool {
+				points := randomPoints(nbPoints)
+				scalars := make([]fr.Element, nbPoints)
+				for i := range scalars {
+					scalars[i].SetRandom()
+				}
+				// sprinkle some small and zero scalars
+				scalars[0].SetZero()
+				scalars[nbPoints/2].SetUint64(3)
+
+				var expected, tmp PointAffine
+				expected.setInfinity()
+				var bs big.Int
+				for i := range points {
+					tmp.ScalarMultiplication(&points[i], scalars[i].BigInt(&bs))
+					expected.Add(&expected, &tmp)
+				}
+
+				var res PointAffine
+				res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+				var resSingleTask PointAffine
+				resSingleTask.MultiExp(points, scalars, ecc.MultiExpConfig{NbTasks: 1})
+				var resManyTasks PointAffine
+				resManyTasks.MultiExp(points, scalars, ecc.MultiExpConfig{NbTasks: 128})
+
+				return res.Equal(&expected) && resSingleTask.Equal(&expected) && resManyTasks.Equal(&expected)
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMultiExpEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	var res PointExtended
+	if _, err := res.MultiExp(nil, nil, ecc.MultiExpConfig{}); err != nil || !res.IsZero() {
+		t.Fatal("empty MultiExp should be the identity")
+	}
+
+	points := randomPoints(4)
+	scalars := make([]fr.Element, 4)
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil || !res.IsZero() {
+		t.Fatal("MultiExp with zero scalars should be the identity")
+	}
+	if _, err := res.MultiExp(points, scalars[:3], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExp with mismatched lengths should fail")
+	}
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{NbTasks: 1025}); err == nil {
+		t.Fatal("MultiExp with too many tasks should fail")
+	}
+}
+
+func TestMultiExpLargeWindow(t *testing.T) {
+	t.Parallel()
+
+	// with c = 16, a scalar of 16k-1 bits all set to 1 carries into a top
+	// window that already holds 15 bits, so its digit is 2^15.
+	const c = 16
+	for _, nbBits := range []int{127, c*(fr.Bits/c) - 1} {
+		const nbPoints = 8
+		points := randomPoints(nbPoints)
+		_points := make([]PointExtended, nbPoints)
+		_scalars := make([][fr.Limbs]uint64, nbPoints)
+		s := make([]big.Int, nbPoints)
+		for i := range points {
+			_points[i].FromAffine(&points[i])
+			s[i].Lsh(big.NewInt(1), uint(nbBits)).Sub(&s[i], big.NewInt(int64(i+1)))
+			var e fr.Element
+			_scalars[i] = e.SetBigInt(&s[i]).Bits()
+		}
+
+		var expected, tmp PointAffine
+		expected.setInfinity()
+		for i := range points {
+			tmp.ScalarMultiplication(&points[i], &s[i])
+			expected.Add(&expected, &tmp)
+		}
+
+		var res PointExtended
+		var resAffine PointAffine
+		resAffine.FromExtended(res.multiExpC(_points, _scalars, c, nbBits, ecc.MultiExpConfig{NbTasks: 4}))
+		if !resAffine.Equal(&expected) {
+			t.Fatalf("MultiExp with c = %d and %d-bit scalars doesn't match the sum of scalar multiplications", c, nbBits)
+		}
+	}
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	const nbPoints = 1 << 14
+	points := randomPoints(nbPoints)
+	scalars := make([]fr.Element, nbPoints)
+	for i := range scalars {
+		scalars[i].SetRandom()
+	}
+
+	var res PointExtended
+	for n := 1 << 8; n <= nbPoints; n <<= 3 {
+		b.Run(fmt.Sprintf("%d points", n), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				res.MultiExp(points[:n], scalars[:n], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// Aggregate aggregates signatures of the ciphersuite into a single signature.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 2.8
+func (cs *Ciphersuite) Aggregate(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrEmptyAggregate
+	}
+	if cs.variant == MinPk {
+		var acc bls12381.G2Jac
+		for i := range sigs {
+			sig, err := signatureG2(sigs[i])
+			if err != nil {
+				return nil, err
+			}
+			acc.AddMixed(&sig)
+		}
+		var res bls12381.G2Affine
+		res.FromJacobian(&acc)
+		resBin := res.Bytes()
+		return resBin[:], nil
+	}
+	var acc bls12381.G1Jac
+	for i := range sigs {
+		sig, err := signatureG1(sigs[i])
+		if err != nil {
+			return nil, err
+		}
+		acc.AddMixed(&sig)
+	}
+	var res bls12381.G1Affine
+	res.FromJacobian(&acc)
+	resBin := res.Bytes()
+	return resBin[:], nil
+}
+
+// AggregatePublicKeys aggregates public keys of the ciphersuite into a single
+// public key.
+func (cs *Ciphersuite) AggregatePublicKeys(pks []PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, ErrEmptyAggregate
+	}
+	res := cs.NewPublicKey()
+	if cs.variant == MinPk {
+		var acc bls12381.G1Jac
+		for i := range pks {
+			if pks[i].ciphersuite() != cs {
+				return nil, ErrCiphersuiteMismatch
+			}
+			acc.AddMixed(&pks[i].g1)
+		}
+		res.g1.FromJacobian(&acc)
+		return res, nil
+	}
+	var acc bls12381.G2Jac
+	for i := range pks {
+		if pks[i].ciphersuite() != cs {
+			return nil, ErrCiphersuiteMismatch
+		}
+		acc.AddMixed(&pks[i].g2)
+	}
+	res.g2.FromJacobian(&acc)
+	return res, nil
+}
+
+// AggregateVerify checks an aggregate signature of messages[i] by pks[i].
+//
+// ∏ e(pk_i, hash_to_point(m_i)) ?= e(g, signature)
+//
+// In the basic scheme the messages must be distinct, in the message
+// augmentation scheme each message is prefixed with its public key. If hFunc
+// is provided, messages are first hashed with hFunc.
+//
+// draft-irtf-cfrg-bls-signature-05, Sections 2.9, 3.1.1 and 3.2.2
+func (cs *Ciphersuite) AggregateVerify(pks []PublicKey, messages [][]byte, sigBin []byte, hFunc hash.Hash) (bool, error) {
+	if len(pks) == 0 {
+		return false, ErrEmptyAggregate
+	}
+	if len(pks) != len(messages) {
+		return false, ErrInvalidNbMessages
+	}
+
+	msgs := make([][]byte, len(messages))
+	for i := range pks {
+		if pks[i].ciphersuite() != cs {
+			return false, ErrCiphersuiteMismatch
+		}
+		if err := pks[i].validate(); err != nil {
+			return false, err
+		}
+		var err error
+		if msgs[i], err = pks[i].prepareMessage(messages[i], hFunc); err != nil {
+			return false, err
+		}
+	}
+
+	if cs.scheme == Basic {
+		seen := make(map[string]struct{}, len(msgs))
+		for i := range msgs {
+			if _, ok := seen[string(msgs[i])]; ok {
+				return false, ErrDuplicateMessages
+			}
+			seen[string(msgs[i])] = struct{}{}
+		}
+	}
+
+	n := len(pks)
+	P := make([]bls12381.G1Affine, n+1)
+	Q := make([]bls12381.G2Affine, n+1)
+	errs := make([]error, n)
+
+	if cs.variant == MinPk {
+		sig, err := signatureG2(sigBin)
+		if err != nil {
+			return false, err
+		}
+		parallel.Execute(n, func(start, end int) {
+			for i := start; i < end; i++ {
+				P[i].Set(&pks[i].g1)
+				Q[i], errs[i] = bls12381.HashToG2(msgs[i], cs.dst)
+			}
+		})
+		P[n].Set(&g1GenNeg)
+		Q[n].Set(&sig)
+	} else {
+		sig, err := signatureG1(sigBin)
+		if err != nil {
+			return false, err
+		}
+		parallel.Execute(n, func(start, end int) {
+			for i := start; i < end; i++ {
+				P[i], errs[i] = bls12381.HashToG1(msgs[i], cs.dst)
+				Q[i].Set(&pks[i].g2)
+			}
+		})
+		P[n].Set(&sig)
+		Q[n].Set(&g2GenNeg)
+	}
+	for i := range errs {
+		if errs[i] != nil {
+			return false, errs[i]
+		}
+	}
+
+	return bls12381.PairingCheck(P, Q)
+}
+
+// FastAggregateVerify checks an aggregate signature of the same message by
+// all pks. It is only available in the proof-of-possession scheme, and the
+// caller must have checked the proof of possession of each public key.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.4
+func (cs *Ciphersuite) FastAggregateVerify(pks []PublicKey, message, sigBin []byte, hFunc hash.Hash) (bool, error) {
+	if cs.scheme != ProofOfPossession {
+		return false, ErrNotProofOfPossession
+	}
+	// an identity key would vanish in the aggregate, KeyValidate each of them.
+	for i := range pks {
+		if err := pks[i].validate(); err != nil {
+			return false, err
+		}
+	}
+	pk, err := cs.AggregatePublicKeys(pks)
+	if err != nil {
+		return false, err
+	}
+	return pk.Verify(sigBin, message, hFunc)
+}
@@ -0,0 +1,479 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+var ciphersuites = []*Ciphersuite{MinPkBasic, MinPkAug, MinPkPop, MinSigBasic, MinSigAug, MinSigPop}
+
+func TestBLS(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 5
+	} else {
+		parameters.MinSuccessfulTests = 10
+	}
+	properties := gopter.NewProperties(parameters)
+
+	for _, cs := range ciphersuites {
+		cs := cs
+		properties.Property("[BLS12-381] test the signing and verification "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				msg := []byte("testing BLS")
+				hFunc := sha256.New()
+				sig, _ := privKey.Sign(msg, hFunc)
+				flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS12-381] test the signing and verification (pre-hashed) "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				msg := []byte("testing BLS")
+				sig, _ := privKey.Sign(msg, nil)
+				flag, _ := publicKey.Verify(sig, msg, nil)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS12-381] wrong message should not verify "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+				flag, _ := publicKey.Verify(sig, []byte("testing BLS!"), nil)
+
+				return !flag
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, err := cs.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := privKey.PopProve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := privKey.PublicKey.PopVerify(proof); err != nil || !ok {
+			t.Fatal("valid proof of possession should verify")
+		}
+
+		// a proof of possession is not a signature of the public key
+		if ok, _ := privKey.PublicKey.Verify(proof, privKey.PublicKey.Bytes(), nil); ok {
+			t.Fatal("proof of possession should not verify as a signature")
+		}
+
+		other, _ := cs.GenerateKey(rand.Reader)
+		if ok, _ := other.PublicKey.PopVerify(proof); ok {
+			t.Fatal("proof of possession should not verify for another key")
+		}
+	}
+
+	for _, cs := range []*Ciphersuite{MinPkBasic, MinPkAug, MinSigBasic, MinSigAug} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		if _, err := privKey.PopProve(); err != ErrNotProofOfPossession {
+			t.Fatal("proof of possession should not be available in " + cs.ID())
+		}
+	}
+}
+
+func TestKeyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+
+		// the identity is not a valid public key
+		pk := cs.NewPublicKey()
+		if _, err := pk.Verify(sig, []byte("testing BLS"), nil); err != ErrInvalidPublicKey {
+			t.Fatal("identity public key should be rejected in " + cs.ID())
+		}
+	}
+}
+
+func TestKeyGen(t *testing.T) {
+	t.Parallel()
+
+	if _, err := MinPkPop.KeyGen(make([]byte, 31), nil); err != ErrShortIKM {
+		t.Fatal("short IKM should be rejected")
+	}
+
+	ikm := make([]byte, 32)
+	k1, _ := MinPkPop.KeyGen(ikm, nil)
+	k2, _ := MinPkPop.KeyGen(ikm, []byte("key info"))
+	if k1.PublicKey.Equal(&k2.PublicKey) {
+		t.Fatal("key info should change the derived key")
+	}
+	k3, _ := MinPkPop.KeyGen(ikm, nil)
+	if !k1.PublicKey.Equal(&k3.PublicKey) {
+		t.Fatal("KeyGen should be deterministic")
+	}
+}
+
+func TestKeyGenVectors(t *testing.T) {
+	t.Parallel()
+
+	// EIP-2333 derive_master_SK, which is KeyGen with an empty key_info
+	// https://eips.ethereum.org/EIPS/eip-2333#test-cases
+	vectors := []struct {
+		seed, sk string
+	}{
+		{
+			seed: "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			sk:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		},
+		{
+			seed: "3141592653589793238462643383279502884197169399375105820974944592",
+			sk:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		},
+		{
+			seed: "0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+			sk:   "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+		},
+		{
+			seed: "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			sk:   "19022158461524446591288038168518313374041767046816487870552872741050760015818",
+		},
+	}
+
+	for _, v := range vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		privKey, err := MinPkPop.KeyGen(seed, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, _ := new(big.Int).SetString(v.sk, 10)
+		if new(big.Int).SetBytes(privKey.scalar[:]).Cmp(expected) != 0 {
+			t.Fatal("KeyGen does not match EIP-2333 test vector")
+		}
+	}
+}
+
+func TestSignVectors(t *testing.T) {
+	t.Parallel()
+
+	// Ethereum consensus-spec BLS test vectors (BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_)
+	// https://github.com/ethereum/consensus-spec-tests/tree/master/tests/general/phase0/bls/sign
+	vectors := []struct {
+		sk, pk, msg, sig string
+	}{
+		{
+			sk:  "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			pk:  "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			msg: "0000000000000000000000000000000000000000000000000000000000000000",
+			sig: "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55",
+		},
+		{
+			sk:  "47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+			pk:  "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+			msg: "0000000000000000000000000000000000000000000000000000000000000000",
+			sig: "b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9",
+		},
+		{
+			sk:  "328388aff0d4a5b7dc9205abd374e7e98f3cd9f3418edb4eafda5fb16473d216",
+			pk:  "b53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f",
+			msg: "0000000000000000000000000000000000000000000000000000000000000000",
+			sig: "948a7cb99f76d616c2c564ce9bf4a519f1bea6b0a624a02276443c245854219fabb8d4ce061d255af5330b078d5380681751aa7053da2c98bae898edc218c75f07e24d8802a17cd1f6833b71e58f5eb5b94208b4d0bb3848cecb075ea21be115",
+		},
+		{
+			sk:  "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			pk:  "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			msg: "5656565656565656565656565656565656565656565656565656565656565656",
+			sig: "882730e5d03f6b42c3abc26d3372625034e1d871b65a8a6b900a56dae22da98abbe1b68f85e49fe7652a55ec3d0591c20767677e33e5cbb1207315c41a9ac03be39c2e7668edc043d6cb1d9fd93033caa8a1c5b0e84bedaeb6c64972503a43eb",
+		},
+		{
+			sk:  "47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+			pk:  "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+			msg: "5656565656565656565656565656565656565656565656565656565656565656",
+			sig: "af1390c3c47acdb37131a51216da683c509fce0e954328a59f93aebda7e4ff974ba208d9a4a2a2389f892a9d418d618418dd7f7a6bc7aa0da999a9d3a5b815bc085e14fd001f6a1948768a3f4afefc8b8240dda329f984cb345c6363272ba4fe",
+		},
+		{
+			sk:  "328388aff0d4a5b7dc9205abd374e7e98f3cd9f3418edb4eafda5fb16473d216",
+			pk:  "b53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f",
+			msg: "5656565656565656565656565656565656565656565656565656565656565656",
+			sig: "a4efa926610b8bd1c8330c918b7a5e9bf374e53435ef8b7ec186abf62e1b1f65aeaaeb365677ac1d1172a1f5b44b4e6d022c252c58486c0a759fbdc7de15a756acc4d343064035667a594b4c2a6f0b0b421975977f297dba63ee2f63ffe47bb6",
+		},
+		{
+			sk:  "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			pk:  "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			msg: "abababababababababababababababababababababababababababababababab",
+			sig: "91347bccf740d859038fcdcaf233eeceb2a436bcaaee9b2aa3bfb70efe29dfb2677562ccbea1c8e061fb9971b0753c240622fab78489ce96768259fc01360346da5b9f579e5da0d941e4c6ba18a0e64906082375394f337fa1af2b7127b0d121",
+		},
+		{
+			sk:  "47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+			pk:  "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+			msg: "abababababababababababababababababababababababababababababababab",
+			sig: "9674e2228034527f4c083206032b020310face156d4a4685e2fcaec2f6f3665aa635d90347b6ce124eb879266b1e801d185de36a0a289b85e9039662634f2eea1e02e670bc7ab849d006a70b2f93b84597558a05b879c8d445f387a5d5b653df",
+		},
+	}
+
+	for _, v := range vectors {
+		sk, _ := hex.DecodeString(v.sk)
+		msg, _ := hex.DecodeString(v.msg)
+		privKey, err := MinPkPop.NewPrivateKey(sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(privKey.PublicKey.Bytes()) != v.pk {
+			t.Fatal("public key does not match test vector")
+		}
+		sig, err := privKey.Sign(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(sig) != v.sig {
+			t.Fatal("signature does not match test vector")
+		}
+		if ok, err := privKey.PublicKey.Verify(sig, msg, nil); err != nil || !ok {
+			t.Fatal("test vector signature should verify")
+		}
+	}
+}
+
+// inputs of the consensus-spec verify, aggregate, aggregate_verify and
+// fast_aggregate_verify test vectors
+// https://github.com/ethereum/consensus-spec-tests/tree/master/tests/general/phase0/bls
+const (
+	vectorPk0 = "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	vectorPk1 = "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81"
+	vectorPk2 = "b53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f"
+
+	vectorMsg00 = "0000000000000000000000000000000000000000000000000000000000000000"
+	vectorMsg56 = "5656565656565656565656565656565656565656565656565656565656565656"
+	vectorMsgAb = "abababababababababababababababababababababababababababababababab"
+
+	// signatures of vectorMsg00 by the keys of vectorPk0, vectorPk1 and vectorPk2
+	vectorSig0 = "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+	vectorSig1 = "b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9"
+	vectorSig2 = "948a7cb99f76d616c2c564ce9bf4a519f1bea6b0a624a02276443c245854219fabb8d4ce061d255af5330b078d5380681751aa7053da2c98bae898edc218c75f07e24d8802a17cd1f6833b71e58f5eb5b94208b4d0bb3848cecb075ea21be115"
+
+	// aggregate of vectorSig0, vectorSig1 and vectorSig2
+	vectorAggregateSig00 = "9683b3e6701f9a4b706709577963110043af78a5b41991b998475a3d3fd62abf35ce03b33908418efc95a058494a8ae504354b9f626231f6b3f3c849dfdeaf5017c4780e2aee1850ceaf4b4d9ce70971a3d2cfcd97b7e5ecf6759f8da5f76d31"
+	// aggregate of the signatures of vectorMsgAb by the three keys
+	vectorAggregateSigAb = "9712c3edd73a209c742b8250759db12549b3eaf43b5ca61376d9f30e2747dbcf842d8b2ac0901d2a093713e20284a7670fcf6954e9ab93de991bb9b313e664785a075fc285806fa5224c82bde146561b446ccfc706a64b8579513cfc4ff1d930"
+	// aggregate of the signatures of vectorMsg00, vectorMsg56 and vectorMsgAb
+	// by the keys of vectorPk0, vectorPk1 and vectorPk2 respectively
+	vectorAggregateSigDistinct = "9104e74b9dfd3ad502f25d6a5ef57db0ed7d9a0e00f3500586d8ce44231212542fcfaf87840539b398bf07626705cf1105d246ca1062c6c2e1a53029a0f790ed5e3cb1f52f8234dc5144c45fc847c0cd37a92d68e7c5ba7c648a8a339f171244"
+
+	vectorInfinityPk  = "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	vectorInfinitySig = "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	vectorZeroSig     = "000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+)
+
+// tamperVector replaces the last 4 bytes of a signature by 0xff, as the
+// consensus-spec generator does for its tampered_signature cases.
+func tamperVector(sig string) string {
+	return sig[:len(sig)-8] + "ffffffff"
+}
+
+func decodeVector(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// decodeVectorPublicKeys returns false if one of the keys is not a valid
+// encoding of a point of the subgroup.
+func decodeVectorPublicKeys(t *testing.T, pks []string) ([]PublicKey, bool) {
+	t.Helper()
+	res := make([]PublicKey, len(pks))
+	for i := range pks {
+		res[i] = *MinPkPop.NewPublicKey()
+		if _, err := res[i].SetBytes(decodeVector(t, pks[i])); err != nil {
+			return nil, false
+		}
+	}
+	return res, true
+}
+
+func TestVerifyVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := []struct {
+		name         string
+		pk, msg, sig string
+		valid        bool
+	}{
+		{"valid", vectorPk0, vectorMsg00, vectorSig0, true},
+		{"valid", vectorPk2, vectorMsg00, vectorSig2, true},
+		{"wrong pubkey", vectorPk1, vectorMsg00, vectorSig0, false},
+		{"wrong message", vectorPk0, vectorMsg56, vectorSig0, false},
+		{"tampered signature", vectorPk0, vectorMsg00, tamperVector(vectorSig0), false},
+		{"infinity pubkey and infinity signature", vectorInfinityPk, vectorMsgAb, vectorInfinitySig, false},
+	}
+
+	for _, v := range vectors {
+		pks, ok := decodeVectorPublicKeys(t, []string{v.pk})
+		if ok {
+			ok, _ = pks[0].Verify(decodeVector(t, v.sig), decodeVector(t, v.msg), nil)
+		}
+		if ok != v.valid {
+			t.Fatalf("verify %s: expected %v", v.name, v.valid)
+		}
+	}
+}
+
+func TestAggregateVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := []struct {
+		name      string
+		sigs      []string
+		aggregate string // empty if the aggregation must fail
+	}{
+		{"valid", []string{vectorSig0, vectorSig1, vectorSig2}, vectorAggregateSig00},
+		{"infinity signature", []string{vectorInfinitySig}, vectorInfinitySig},
+		{"na signatures", nil, ""},
+	}
+
+	for _, v := range vectors {
+		sigs := make([][]byte, len(v.sigs))
+		for i := range v.sigs {
+			sigs[i] = decodeVector(t, v.sigs[i])
+		}
+		aggregate, err := MinPkPop.Aggregate(sigs)
+		if v.aggregate == "" {
+			if err == nil {
+				t.Fatalf("aggregate %s: expected an error", v.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(aggregate) != v.aggregate {
+			t.Fatalf("aggregate %s: does not match test vector", v.name)
+		}
+	}
+}
+
+func TestAggregateVerifyVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := []struct {
+		name  string
+		pks   []string
+		msgs  []string
+		sig   string
+		valid bool
+	}{
+		{"valid", []string{vectorPk0, vectorPk1, vectorPk2}, []string{vectorMsg00, vectorMsg56, vectorMsgAb}, vectorAggregateSigDistinct, true},
+		{"tampered signature", []string{vectorPk0, vectorPk1, vectorPk2}, []string{vectorMsg00, vectorMsg56, vectorMsgAb}, tamperVector(vectorAggregateSigDistinct), false},
+		{"infinity pubkey", []string{vectorPk0, vectorPk1, vectorPk2, vectorInfinityPk}, []string{vectorMsg00, vectorMsg56, vectorMsgAb, vectorMsg00}, vectorAggregateSigDistinct, false},
+		{"na pubkeys and infinity signature", nil, nil, vectorInfinitySig, false},
+		{"na pubkeys and na signature", nil, nil, vectorZeroSig, false},
+	}
+
+	for _, v := range vectors {
+		pks, ok := decodeVectorPublicKeys(t, v.pks)
+		if ok {
+			msgs := make([][]byte, len(v.msgs))
+			for i := range v.msgs {
+				msgs[i] = decodeVector(t, v.msgs[i])
+			}
+			ok, _ = MinPkPop.AggregateVerify(pks, msgs, decodeVector(t, v.sig), nil)
+		}
+		if ok != v.valid {
+			t.Fatalf("aggregate_verify %s: expected %v", v.name, v.valid)
+		}
+	}
+}
+
+func TestFastAggregateVerifyVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := []struct {
+		name  string
+		pks   []string
+		msg   string
+		sig   string
+		valid bool
+	}{
+		{"valid", []string{vectorPk0}, vectorMsg00, vectorSig0, true},
+		{"valid", []string{vectorPk0, vectorPk1, vectorPk2}, vectorMsgAb, vectorAggregateSigAb, true},
+		{"extra pubkey", []string{vectorPk0, vectorPk1}, vectorMsg00, vectorSig0, false},
+		{"tampered signature", []string{vectorPk0, vectorPk1, vectorPk2}, vectorMsgAb, tamperVector(vectorAggregateSigAb), false},
+		{"infinity pubkey", []string{vectorPk0, vectorPk1, vectorPk2, vectorInfinityPk}, vectorMsgAb, vectorAggregateSigAb, false},
+		{"na pubkeys and infinity signature", nil, vectorMsgAb, vectorInfinitySig, false},
+		{"na pubkeys and na signature", nil, vectorMsgAb, vectorZeroSig, false},
+	}
+
+	for _, v := range vectors {
+		pks, ok := decodeVectorPublicKeys(t, v.pks)
+		if ok {
+			ok, _ = MinPkPop.FastAggregateVerify(pks, decodeVector(t, v.msg), decodeVector(t, v.sig), nil)
+		}
+		if ok != v.valid {
+			t.Fatalf("fast_aggregate_verify %s: expected %v", v.name, v.valid)
+		}
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkSignBLS(b *testing.B) {
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		msg := []byte("benchmarking BLS sign()")
+		b.Run(cs.ID(), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				privKey.Sign(msg, nil)
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyBLS(b *testing.B) {
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		msg := []byte("benchmarking BLS verify()")
+		sig, _ := privKey.Sign(msg, nil)
+		b.Run(cs.ID(), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				privKey.PublicKey.Verify(sig, msg, nil)
+			}
+		})
+	}
+}
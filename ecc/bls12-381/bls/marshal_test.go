@@ -0,0 +1,106 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+const (
+	nbFuzzShort = 5
+	nbFuzz      = 20
+)
+
+func TestSerialization(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		cs := cs
+		properties.Property("[BLS12-381] BLS serialization: SetBytes(Bytes()) should stay the same "+cs.ID(), prop.ForAll(
+			func() bool {
+				privKey, _ := cs.GenerateKey(rand.Reader)
+
+				end := PrivateKey{PublicKey: *cs.NewPublicKey()}
+				buf := privKey.Bytes()
+				n, err := end.SetBytes(buf[:])
+				if err != nil {
+					return false
+				}
+				if n != len(buf) {
+					return false
+				}
+
+				return end.PublicKey.Equal(&privKey.PublicKey) && subtle.ConstantTimeCompare(end.scalar[:], privKey.scalar[:]) == 1
+
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := MinPkPop.GenerateKey(rand.Reader)
+	msg := []byte("testing BLS")
+	sig, _ := privKey.Sign(msg, nil)
+
+	// truncated and extended signatures
+	if _, err := privKey.PublicKey.Verify(sig[:len(sig)-1], msg, nil); err != ErrInvalidSignature {
+		t.Fatal("truncated signature should be rejected")
+	}
+	if _, err := privKey.PublicKey.Verify(append(sig, 0), msg, nil); err != ErrInvalidSignature {
+		t.Fatal("extended signature should be rejected")
+	}
+
+	// a min-pk signature is not a valid min-sig signature
+	other, _ := MinSigPop.GenerateKey(rand.Reader)
+	if ok, _ := other.PublicKey.Verify(sig, msg, nil); ok {
+		t.Fatal("signature of another ciphersuite should not verify")
+	}
+
+	// out of range scalar
+	buf := privKey.Bytes()
+	for i := len(buf) - sizeFr; i < len(buf); i++ {
+		buf[i] = 0xff
+	}
+	var end PrivateKey
+	if _, err := end.SetBytes(buf); err != ErrInvalidPrivateKey {
+		t.Fatal("scalar larger than r should be rejected")
+	}
+
+	// public key that doesn't match the scalar
+	for _, cs := range ciphersuites {
+		k1, _ := cs.GenerateKey(rand.Reader)
+		k2, _ := cs.GenerateKey(rand.Reader)
+		buf := k1.Bytes()
+		copy(buf, k2.PublicKey.Bytes())
+		end := PrivateKey{PublicKey: *cs.NewPublicKey()}
+		if _, err := end.SetBytes(buf); err != ErrInvalidPrivateKey {
+			t.Fatal("public key of another scalar should be rejected " + cs.ID())
+		}
+		if _, err := end.SetBytes(k1.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+		if !end.PublicKey.Equal(&k1.PublicKey) {
+			t.Fatal("wrong public key " + cs.ID())
+		}
+	}
+}
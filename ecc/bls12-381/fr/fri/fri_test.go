@@ -6,30 +6,22 @@
 package fri
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
+	"runtime"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 )
 
-// logFiber returns u, v such that {g^u, g^v} = f⁻¹((g²)^{_p})
-func logFiber(_p, _n int) (_u, _v big.Int) {
-	if _p%2 == 0 {
-		_u.SetInt64(int64(_p / 2))
-		_v.SetInt64(int64(_p/2 + _n/2))
-	} else {
-		l := (_n - 1 - _p) / 2
-		_u.SetInt64(int64(_n - 1 - l))
-		_v.SetInt64(int64(_n - 1 - l - _n/2))
-	}
-	return
-}
-
 func randomPolynomial(size uint64, seed int32) []fr.Element {
 	p := make([]fr.Element, size)
 	p[0].SetUint64(uint64(seed))
@@ -39,18 +31,6 @@ func randomPolynomial(size uint64, seed int32) []fr.Element {
 	return p
 }
 
-// convertSortedCanonical convert the index i, an entry in a
-// sorted polynomial, to the corresponding entry in canonical
-// representation. n is the size of the polynomial.
-func convertSortedCanonical(i, n int) int {
-	if i%2 == 0 {
-		return i / 2
-	} else {
-		l := (n - 1 - i) / 2
-		return n - 1 - l
-	}
-}
-
 func TestFRI(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -151,34 +131,81 @@ func TestFRI(t *testing.T) {
 			_s := RADIX_2_FRI.New(uint64(size), sha256.New())
 			s := _s.(radixTwoFri)
 
-			var g fr.Element
+			evaluations := s.evaluate(randomPolynomial(uint64(size), m))
+			g := s.domain.Generator
+			n := len(evaluations)
 
-			_m := int(m) % size
-			pos := s.deriveQueriesPositions(_m, int(s.domain.Cardinality))
-			g.Set(&s.domain.Generator)
-			n := int(s.domain.Cardinality)
+			// a query at pos opens, at each step, the leaf pos mod n/2 storing the
+			// evaluations at the fiber {g^u, g^v} of (g²)^{pos mod n/2}
+			pos := int(m) % n
+			for n > 1 {
+				u := pos % (n / 2)
+				v := u + n/2
 
-			for i := 0; i < len(pos)-1; i++ {
-
-				u, v := logFiber(pos[i], n)
+				fiber, err := parseLeaf(leaves(evaluations, 2)[u], 2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !fiber[0].Equal(&evaluations[u]) || !fiber[1].Equal(&evaluations[v]) {
+					return false
+				}
 
 				var g1, g2, g3 fr.Element
-				g1.Exp(g, &u).Square(&g1)
-				g2.Exp(g, &v).Square(&g2)
-				nextPos := convertSortedCanonical(pos[i+1], n/2)
-				g3.Square(&g).Exp(g3, big.NewInt(int64(nextPos)))
-
+				g1.Exp(g, big.NewInt(int64(u))).Square(&g1)
+				g2.Exp(g, big.NewInt(int64(v))).Square(&g2)
+				g3.Square(&g).Exp(g3, big.NewInt(int64(u)))
 				if !g1.Equal(&g2) || !g1.Equal(&g3) {
 					return false
 				}
+
+				evaluations = evaluations[:n/2]
 				g.Square(&g)
-				n = n >> 1
+				pos = u
+				n >>= 1
 			}
 			return true
 		},
 		gen.Int32Range(0, int32(rho*size)),
 	))
 
+	properties.Property("folding a fiber should match folding the polynomial", prop.ForAll(
+
+		func(m int32) bool {
+
+			_s, err := RADIX_2_FRI.NewWithConfig(uint64(size), sha256.New(), Config{BlowupFactor: rho, FoldingFactor: 16, NbQueries: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := _s.(radixTwoFri)
+
+			p := s.evaluate(randomPolynomial(uint64(size), m))
+			var x fr.Element
+			x.SetUint64(uint64(m))
+
+			// fold the polynomial by 16
+			folded := p
+			var gInv, xi fr.Element
+			gInv.Set(&s.domain.GeneratorInv)
+			xi.Set(&x)
+			for k := 0; k < 4; k++ {
+				folded = foldPolynomialLagrangeBasis(folded, gInv, xi)
+				gInv.Square(&gInv)
+				xi.Square(&xi)
+			}
+
+			// fold a single fiber
+			i := int(m) % len(folded)
+			fiber := make([]fr.Element, 16)
+			for t := range fiber {
+				fiber[t] = p[i+t*len(folded)]
+			}
+			res := foldFiber(fiber, i, len(p), s.domain.GeneratorInv, x)
+
+			return res.Equal(&folded[i])
+		},
+		gen.Int32Range(0, int32(rho*size)),
+	))
+
 	properties.Property("verifying a correctly formed proof should succeed", prop.ForAll(
 
 		func(s int32) bool {
@@ -201,6 +228,188 @@ func TestFRI(t *testing.T) {
 
 }
 
+func TestNew(t *testing.T) {
+
+	if GetRho() != rho {
+		t.Fatal("wrong ρ")
+	}
+
+	// New keeps its historical parameters
+	const size = 64
+	iop := RADIX_2_FRI.New(size, sha256.New())
+	s := iop.(radixTwoFri)
+	if s.domain.Cardinality != rho*size {
+		t.Fatal("wrong domain size")
+	}
+	proof, err := iop.BuildProofOfProximity(randomPolynomial(size, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Rounds) != nbRounds || len(proof.FinalPolynomial) != 1 {
+		t.Fatal("wrong proof shape")
+	}
+
+	if _, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), Config{BlowupFactor: 3, FoldingFactor: 2, NbQueries: 1}); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatal("invalid config should be rejected")
+	}
+	if _, err := IOPP(42).NewWithConfig(size, sha256.New(), DefaultConfig()); err != ErrUnknownIOPP {
+		t.Fatal("unknown iopp should be rejected")
+	}
+}
+
+func TestFRIConfigs(t *testing.T) {
+
+	const size = 1000
+	p := randomPolynomial(size, 7)
+
+	for _, config := range []Config{
+		DefaultConfig(),
+		{BlowupFactor: 2, FoldingFactor: 4, NbQueries: 10},
+		{BlowupFactor: 4, FoldingFactor: 8, NbQueries: 10, FinalDegree: 7},
+		{BlowupFactor: 16, FoldingFactor: 16, NbQueries: 5, FinalDegree: 100, GrindingBits: 8},
+		{BlowupFactor: 2, FoldingFactor: 16, NbQueries: 3, FinalDegree: 2000},
+	} {
+		t.Run(fmt.Sprintf("%+v", config), func(t *testing.T) {
+
+			iop, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := iop.BuildProofOfProximity(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(proof.Rounds) != config.NbQueries {
+				t.Fatal("wrong number of queries")
+			}
+			if err := iop.VerifyProofOfProximity(proof); err != nil {
+				t.Fatal(err)
+			}
+
+			// openings
+			openingProof, err := iop.Open(p, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := iop.VerifyOpening(42, openingProof, proof); err != nil {
+				t.Fatal(err)
+			}
+			openingProof.ClaimedValue.SetOne()
+			if err := iop.VerifyOpening(42, openingProof, proof); err == nil {
+				t.Fatal("wrong claimed value should fail")
+			}
+
+			// a polynomial of too high degree
+			q := randomPolynomial(2*size, 7)
+			iopLarger, err := RADIX_2_FRI.NewWithConfig(2*size, sha256.New(), config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wrongProof, err := iopLarger.BuildProofOfProximity(q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := iop.VerifyProofOfProximity(wrongProof); err == nil {
+				t.Fatal("proof for a larger polynomial should fail")
+			}
+
+			// tampered proofs
+			tampered := proof
+			tampered.FinalPolynomial = append([]fr.Element{}, proof.FinalPolynomial...)
+			tampered.FinalPolynomial[0].SetOne()
+			if err := iop.VerifyProofOfProximity(tampered); err == nil {
+				t.Fatal("tampered final polynomial should fail")
+			}
+			tampered = proof
+			tampered.Nonce++
+			if err := iop.VerifyProofOfProximity(tampered); err == nil {
+				t.Fatal("tampered nonce should fail")
+			}
+			tampered = proof
+			tampered.Rounds = proof.Rounds[1:]
+			if err := iop.VerifyProofOfProximity(tampered); err != ErrProofShape {
+				t.Fatal("missing query should fail")
+			}
+		})
+	}
+}
+
+func TestFRIWrongCodeword(t *testing.T) {
+
+	// the codeword of a polynomial of degree ρ⁻¹*size, which is far from the
+	// code, is rejected
+	const size = 256
+	iop, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), Config{BlowupFactor: 4, FoldingFactor: 4, NbQueries: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := iop.(radixTwoFri)
+
+	proof, err := s.BuildProofOfProximity(randomPolynomial(4*size, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := iop.VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("proof for a codeword far from the code should fail")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+
+	const size = 64
+	p := randomPolynomial(size, 5)
+	iop, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), Config{BlowupFactor: 4, FoldingFactor: 4, NbQueries: 4, FinalDegree: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.ID = []byte("id")
+	t.Run("proof of proximity round trip", testutils.SerializationRoundTrip(&proof))
+
+	openingProof, err := iop.Open(p, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("opening proof round trip", testutils.SerializationRoundTrip(&openingProof))
+}
+
+func TestReadFromTruncated(t *testing.T) {
+
+	u64 := func(v uint64) []byte { return binary.BigEndian.AppendUint64(nil, v) }
+	cat := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+
+	// each input is a valid prefix of a proof of proximity, up to a huge length
+	// prefix with nothing behind it.
+	const huge = maxLength
+	header := cat(u64(0), u64(0), []byte{0, 0, 0, 0}, u64(0)) // empty ID, roots and final polynomial, nonce
+	inputs := map[string][]byte{
+		"id":               u64(huge),
+		"roots":            cat(u64(0), u64(huge)),
+		"root":             cat(u64(0), u64(1), u64(huge)),
+		"final polynomial": cat(u64(0), u64(0), []byte{0xff, 0xff, 0xff, 0xff}),
+		"rounds":           cat(header, u64(huge)),
+		"interactions":     cat(header, u64(1), u64(huge)),
+		"merkle proof":     cat(header, u64(1), u64(1), u64(huge)),
+		"merkle node":      cat(header, u64(1), u64(1), u64(1), u64(huge)),
+	}
+
+	for name, b := range inputs {
+		var proof ProofOfProximity
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		if _, err := proof.ReadFrom(bytes.NewReader(b)); err == nil {
+			t.Fatalf("%s: reading a truncated proof should fail", name)
+		}
+		runtime.ReadMemStats(&after)
+		if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+			t.Fatalf("%s: reading a truncated proof allocated %d bytes", name, allocated)
+		}
+	}
+}
+
 // Benchmarks
 
 func BenchmarkProximityVerification(b *testing.B) {
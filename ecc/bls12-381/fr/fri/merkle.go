@@ -0,0 +1,74 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"hash"
+)
+
+// merkleTree Merkle tree with a power of 2 number of leaves, keeping all its
+// nodes so that several leaves can be proven. It hashes as
+// accumulator/merkletree, so that its proofs are checked with
+// merkletree.VerifyProof.
+type merkleTree struct {
+
+	// leaves data of the leaves, not hashed
+	leaves [][]byte
+
+	// levels[0] are the hashes of the leaves, levels[len(levels)-1] contains
+	// the root.
+	levels [][][]byte
+}
+
+// newMerkleTree builds the Merkle tree of leaves, whose number must be a power
+// of 2.
+func newMerkleTree(h hash.Hash, leaves [][]byte) *merkleTree {
+	res := &merkleTree{leaves: leaves}
+	level := make([][]byte, len(leaves))
+	for i := range leaves {
+		level[i] = sum(h, leaves[i])
+	}
+	res.levels = append(res.levels, level)
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = sum(h, level[2*i], level[2*i+1])
+		}
+		res.levels = append(res.levels, next)
+		level = next
+	}
+	return res
+}
+
+// sum returns the hash of the concatenation of data.
+func sum(h hash.Hash, data ...[]byte) []byte {
+	h.Reset()
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(nil)
+}
+
+// root returns the root of the tree.
+func (t *merkleTree) root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// nbLeaves returns the number of leaves of the tree.
+func (t *merkleTree) nbLeaves() int {
+	return len(t.leaves)
+}
+
+// prove returns the proof set [leaf ∥ node_1 ∥ .. ∥ node_n] of leaf i.
+func (t *merkleTree) prove(i int) [][]byte {
+	res := make([][]byte, 0, len(t.levels))
+	res = append(res, t.leaves[i])
+	for _, level := range t.levels[:len(t.levels)-1] {
+		res = append(res, level[i^1])
+		i >>= 1
+	}
+	return res
+}
@@ -0,0 +1,145 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shamir
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// FeldmanCommitment holds the commitments Cⱼ = [aⱼ]G to the coefficients of the
+// sharing polynomial. C₀ = [secret]G.
+type FeldmanCommitment []curve.G1Affine
+
+// PedersenCommitment holds the commitments Cⱼ = [aⱼ]G + [bⱼ]H to the
+// coefficients of the sharing polynomial f and of the blinding polynomial g.
+type PedersenCommitment []curve.G1Affine
+
+// PedersenShare is a share of the secret along with the matching share of the
+// blinding polynomial.
+type PedersenShare struct {
+	Share
+	Blinding fr.Element // g(Index)
+}
+
+// blindingGenerator is the second generator H of the Pedersen VSS. It is
+// obtained by hashing to G1 so that nobody knows its discrete logarithm in
+// base G.
+var blindingGenerator curve.G1Affine
+
+func init() {
+	var err error
+	blindingGenerator, err = curve.HashToG1([]byte("H"), []byte("gnark-crypto shamir: Pedersen VSS blinding generator"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// BlindingGenerator returns the generator H used by the Pedersen VSS.
+func BlindingGenerator() curve.G1Affine {
+	return blindingGenerator
+}
+
+// SplitFeldman splits secret as in [Split] and returns the Feldman commitment
+// to the sharing polynomial, against which the shares can be verified.
+func SplitFeldman(secret fr.Element, threshold, nbShares int) ([]Share, FeldmanCommitment, error) {
+	coefficients, err := randomPolynomial(secret, threshold, nbShares)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, _, g, _ := curve.Generators()
+	commitment := curve.BatchScalarMultiplicationG1(&g, coefficients)
+	return evaluateShares(coefficients, nbShares), commitment, nil
+}
+
+// Verify checks that [share.Value]G = ∑ⱼ [share.Indexʲ]Cⱼ.
+func (c FeldmanCommitment) Verify(share Share) error {
+	if share.Index == 0 {
+		return ErrInvalidIndex
+	}
+	if len(c) == 0 {
+		return ErrInvalidShare
+	}
+
+	var expected curve.G1Affine
+	if _, err := expected.MultiExp(c, powers(share.Index, len(c)), ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	var seen curve.G1Affine
+	var v big.Int
+	share.Value.BigInt(&v)
+	seen.ScalarMultiplicationBase(&v)
+
+	if !seen.Equal(&expected) {
+		return ErrInvalidShare
+	}
+	return nil
+}
+
+// SplitPedersen splits secret as in [Split] and returns the Pedersen
+// commitment to the sharing polynomial, against which the shares can be
+// verified. Unlike [SplitFeldman], the commitment reveals no information on
+// the secret.
+func SplitPedersen(secret fr.Element, threshold, nbShares int) ([]PedersenShare, PedersenCommitment, error) {
+	coefficients, err := randomPolynomial(secret, threshold, nbShares)
+	if err != nil {
+		return nil, nil, err
+	}
+	var blindingSecret fr.Element
+	if _, err := blindingSecret.SetRandom(); err != nil {
+		return nil, nil, err
+	}
+	blindingCoefficients, err := randomPolynomial(blindingSecret, threshold, nbShares)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, _, g, _ := curve.Generators()
+	commitment := curve.BatchScalarMultiplicationG1(&g, coefficients)
+	blinding := curve.BatchScalarMultiplicationG1(&blindingGenerator, blindingCoefficients)
+	for i := range commitment {
+		commitment[i].Add(&commitment[i], &blinding[i])
+	}
+
+	values := evaluateShares(coefficients, nbShares)
+	blindingValues := evaluateShares(blindingCoefficients, nbShares)
+	shares := make([]PedersenShare, nbShares)
+	for i := range shares {
+		shares[i].Share = values[i]
+		shares[i].Blinding = blindingValues[i].Value
+	}
+	return shares, commitment, nil
+}
+
+// Verify checks that [share.Value]G + [share.Blinding]H = ∑ⱼ [share.Indexʲ]Cⱼ.
+func (c PedersenCommitment) Verify(share PedersenShare) error {
+	if share.Index == 0 {
+		return ErrInvalidIndex
+	}
+	if len(c) == 0 {
+		return ErrInvalidShare
+	}
+
+	var expected curve.G1Affine
+	if _, err := expected.MultiExp(c, powers(share.Index, len(c)), ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	_, _, g, _ := curve.Generators()
+	var seen curve.G1Affine
+	if _, err := seen.MultiExp([]curve.G1Affine{g, blindingGenerator}, []fr.Element{share.Value, share.Blinding}, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	if !seen.Equal(&expected) {
+		return ErrInvalidShare
+	}
+	return nil
+}
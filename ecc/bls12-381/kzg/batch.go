@@ -0,0 +1,157 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"fmt"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// BatchVerifyError is returned by BatchVerifyMultiPointsReport and
+// BatchVerifySinglePointsReport when some proofs of the batch are wrong. It
+// wraps ErrVerifyOpeningProof.
+type BatchVerifyError struct {
+	// Indices of the wrong proofs in the batch, in increasing order
+	Indices []int
+}
+
+func (e *BatchVerifyError) Error() string {
+	return fmt.Sprintf("%s: wrong proofs at indices %v", ErrVerifyOpeningProof, e.Indices)
+}
+
+func (e *BatchVerifyError) Unwrap() error {
+	return ErrVerifyOpeningProof
+}
+
+// BatchVerifyMultiPointsReport batch verifies a list of opening proofs at
+// different points as BatchVerifyMultiPoints. If the batch does not verify,
+// the wrong proofs are isolated by splitting the batch in halves, re-using the
+// random linear combination, and a *BatchVerifyError listing them is returned.
+//
+// When all the proofs are correct, the cost is the one of
+// BatchVerifyMultiPoints. Otherwise, it adds one scalar multiplication per
+// proof and at most 2k·log(n) pairing checks for k wrong proofs out of n.
+func BatchVerifyMultiPointsReport(digests []Digest, proofs []OpeningProof, points []fr.Element, vk VerifyingKey) error {
+
+	if len(digests) != len(proofs) || len(digests) != len(points) {
+		return ErrInvalidNbDigests
+	}
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+
+	randomNumbers, err := sampleRandomNumbers(len(digests))
+	if err != nil {
+		return err
+	}
+
+	// fast path: a single pairing check
+	err = checkMultiPoints(digests, proofs, points, randomNumbers, vk)
+	if err != ErrVerifyOpeningProof {
+		return err
+	}
+
+	// λᵢ[fᵢ(α) - fᵢ(aᵢ) + aᵢHᵢ(α)]G₁ and -λᵢ[Hᵢ(α)]G₁, such that proof i is
+	// correct iff e(λᵢ[fᵢ(α) - fᵢ(aᵢ) + aᵢHᵢ(α)]G₁, G₂).e(-λᵢ[Hᵢ(α)]G₁, [α]G₂) = 1
+	n := len(digests)
+	terms := make([]bls12381.G1Jac, 2*n)
+	parallel.Execute(n, func(start, end int) {
+		var claimedValueNeg fr.Element
+		var claimedValueBigInt, pointBigInt, lambdaBigInt big.Int
+		for i := start; i < end; i++ {
+			claimedValueNeg.Neg(&proofs[i].ClaimedValue).BigInt(&claimedValueBigInt)
+			points[i].BigInt(&pointBigInt)
+			randomNumbers[i].BigInt(&lambdaBigInt)
+			terms[i].JointScalarMultiplication(&vk.G1, &proofs[i].H, &claimedValueBigInt, &pointBigInt).
+				AddMixed(&digests[i]).
+				ScalarMultiplication(&terms[i], &lambdaBigInt)
+			terms[n+i].FromAffine(&proofs[i].H)
+			terms[n+i].ScalarMultiplication(&terms[n+i], &lambdaBigInt).Neg(&terms[n+i])
+		}
+	})
+	affineTerms := bls12381.BatchJacobianToAffineG1(terms)
+
+	// the pairing products of the proofs multiply, so that when a failing batch
+	// splits in a correct half, the other half fails without checking it.
+	var indices []int
+	var isolate func(start, end int) error
+	isolate = func(start, end int) error {
+		if end-start == 1 {
+			indices = append(indices, start)
+			return nil
+		}
+		mid := (start + end) / 2
+		ok, err := checkTerms(affineTerms[start:mid], affineTerms[n+start:n+mid], vk)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return isolate(mid, end)
+		}
+		if err := isolate(start, mid); err != nil {
+			return err
+		}
+		if ok, err = checkTerms(affineTerms[mid:end], affineTerms[n+mid:n+end], vk); err != nil || ok {
+			return err
+		}
+		return isolate(mid, end)
+	}
+	if err := isolate(0, n); err != nil {
+		return err
+	}
+	return &BatchVerifyError{Indices: indices}
+}
+
+// BatchVerifySinglePointsReport verifies a list of batched opening proofs,
+// each at its own point, as BatchVerifySinglePoint. The proofs are folded and
+// checked with BatchVerifyMultiPointsReport, so that if some of them are wrong
+// the *BatchVerifyError lists the indices of the wrong batched opening proofs.
+//
+// * digests[i] list of digests on which batchOpeningProofs[i] is done
+// * dataTranscript[i] extra data used to derive the challenge folding batchOpeningProofs[i], if any
+func BatchVerifySinglePointsReport(digests [][]Digest, batchOpeningProofs []BatchOpeningProof, points []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[][]byte) error {
+
+	if len(digests) != len(batchOpeningProofs) || len(digests) != len(points) {
+		return ErrInvalidNbDigests
+	}
+	if len(dataTranscript) > 0 && len(dataTranscript) != len(digests) {
+		return ErrInvalidNbDigests
+	}
+
+	foldedProofs := make([]OpeningProof, len(digests))
+	foldedDigests := make([]Digest, len(digests))
+	for i := range digests {
+		var data [][]byte
+		if len(dataTranscript) > 0 {
+			data = dataTranscript[i]
+		}
+		var err error
+		foldedProofs[i], foldedDigests[i], err = FoldProof(digests[i], &batchOpeningProofs[i], points[i], hf, data...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return BatchVerifyMultiPointsReport(foldedDigests, foldedProofs, points, vk)
+}
+
+// checkTerms returns e(∑ᵢlᵢ, G₂).e(∑ᵢrᵢ, [α]G₂) == 1.
+func checkTerms(left, right []bls12381.G1Affine, vk VerifyingKey) (bool, error) {
+	var sums [2]bls12381.G1Jac
+	for i := range left {
+		sums[0].AddMixed(&left[i])
+		sums[1].AddMixed(&right[i])
+	}
+	var sumsAffine [2]bls12381.G1Affine
+	sumsAffine[0].FromJacobian(&sums[0])
+	sumsAffine[1].FromJacobian(&sums[1])
+	return bls12381.PairingCheckFixedQ(sumsAffine[:], vk.Lines[:])
+}
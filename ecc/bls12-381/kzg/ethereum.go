@@ -0,0 +1,76 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+var ErrInvalidTrustedSetup = errors.New("invalid trusted setup file")
+
+// ethereumTrustedSetup is the JSON trusted setup of the consensus
+// specifications, with hex-encoded compressed points.
+type ethereumTrustedSetup struct {
+	G1Monomial []string `json:"g1_monomial"`
+	G1Lagrange []string `json:"g1_lagrange"`
+	G2Monomial []string `json:"g2_monomial"`
+}
+
+// ReadEthereumTrustedSetup returns the SRS of the given size from the
+// trusted_setup.json of the Ethereum KZG ceremony, as distributed with the
+// consensus specifications. The points are checked as in [NewSRSFromPowers].
+//
+// Only g1_monomial and the first two points of g2_monomial are used; the
+// Lagrange form, also in natural order in the file, is obtained with
+// [LagrangeProvingKey].
+func ReadEthereumTrustedSetup(r io.Reader, size uint64) (*SRS, error) {
+	var setup ethereumTrustedSetup
+	if err := json.NewDecoder(r).Decode(&setup); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTrustedSetup, err)
+	}
+	if uint64(len(setup.G1Monomial)) < size {
+		return nil, ErrInvalidSRSSize
+	}
+	if len(setup.G2Monomial) < 2 {
+		return nil, fmt.Errorf("%w: missing [τ]G₂", ErrInvalidTrustedSetup)
+	}
+
+	g1 := make([]bls12381.G1Affine, size)
+	for i := range g1 {
+		b, err := decodeHex(setup.G1Monomial[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, err := g1[i].SetBytes(b); err != nil {
+			return nil, fmt.Errorf("%w: g1_monomial[%d]: %w", ErrInvalidTrustedSetup, i, err)
+		}
+	}
+	var g2 [2]bls12381.G2Affine
+	for i := range g2 {
+		b, err := decodeHex(setup.G2Monomial[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, err := g2[i].SetBytes(b); err != nil {
+			return nil, fmt.Errorf("%w: g2_monomial[%d]: %w", ErrInvalidTrustedSetup, i, err)
+		}
+	}
+
+	return NewSRSFromPowers(g1, g2, size)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTrustedSetup, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEthereumTrustedSetup(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewSRS(64, big.NewInt(42))
+	assert.NoError(err)
+	g2 := []bls12381.G2Affine{srs.Vk.G2[0], srs.Vk.G2[1], {}}
+	g2[2].ScalarMultiplication(&g2[1], big.NewInt(42))
+	setup := writeEthereumTrustedSetup(srs.Pk.G1, g2)
+
+	for _, size := range []uint64{2, 33, 64} {
+		imported, err := ReadEthereumTrustedSetup(bytes.NewReader(setup), size)
+		assert.NoError(err)
+		assert.Equal(srs.Pk.G1[:size], imported.Pk.G1)
+		assert.Equal(srs.Vk, imported.Vk)
+	}
+
+	_, err = ReadEthereumTrustedSetup(bytes.NewReader(setup), 65)
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+	_, err = ReadEthereumTrustedSetup(bytes.NewReader(setup[:100]), 2)
+	assert.ErrorIs(err, ErrInvalidTrustedSetup)
+
+	// [τ]G₂ replaced by [τ²]G₂
+	_, err = ReadEthereumTrustedSetup(bytes.NewReader(writeEthereumTrustedSetup(srs.Pk.G1, []bls12381.G2Affine{g2[0], g2[2]})), 16)
+	assert.ErrorIs(err, ErrSRSInconsistent)
+}
+
+// TestReadEthereumTrustedSetupFile reads the trusted_setup.json of the
+// consensus specifications, the output of the Ethereum KZG ceremony.
+func TestReadEthereumTrustedSetupFile(t *testing.T) {
+	assert := require.New(t)
+
+	data, err := os.ReadFile("testdata/trusted_setup.json")
+	assert.NoError(err)
+	srs, err := ReadEthereumTrustedSetup(bytes.NewReader(data), 4096)
+	assert.NoError(err)
+
+	_, _, g1, g2 := bls12381.Generators()
+	assert.Equal(g1, srs.Pk.G1[0])
+	assert.Equal(g1, srs.Vk.G1)
+	assert.Equal(g2, srs.Vk.G2[0])
+
+	// [τ]G₁ and [τ]G₂ of the ceremony
+	tauG1 := srs.Pk.G1[1].Bytes()
+	assert.Equal("ad3eb50121139aa34db1d545093ac9374ab7bca2c0f3bf28e27c8dcd8fc7cb42d25926fc0c97b336e9f0fb35e5a04c81", hex.EncodeToString(tauG1[:]))
+	tauG2 := srs.Vk.G2[1].Bytes()
+	assert.Equal("b5bfd7dd8cdeb128843bc287230af38926187075cbfbefa81009a2ce615ac53d2914e5870cb452d2afaaab24f3499f72185cbfee53492714734429b7b38608e23926c911cceceac9a36851477ba4c60b087041de621000edc98edada20c1def2", hex.EncodeToString(tauG2[:]))
+
+	// the Lagrange form computed from g1_monomial matches g1_lagrange
+	var setup ethereumTrustedSetup
+	assert.NoError(json.Unmarshal(data, &setup))
+	lagrange, err := LagrangeProvingKey(srs.Pk, 4096)
+	assert.NoError(err)
+	assert.Len(setup.G1Lagrange, len(lagrange.G1))
+	for i := range lagrange.G1 {
+		b := lagrange.G1[i].Bytes()
+		assert.Equal(setup.G1Lagrange[i], "0x"+hex.EncodeToString(b[:]), "g1_lagrange[%d]", i)
+	}
+}
+
+func writeEthereumTrustedSetup(g1 []bls12381.G1Affine, g2 []bls12381.G2Affine) []byte {
+	var setup ethereumTrustedSetup
+	for i := range g1 {
+		b := g1[i].Bytes()
+		setup.G1Monomial = append(setup.G1Monomial, "0x"+hex.EncodeToString(b[:]))
+	}
+	for i := range g2 {
+		b := g2[i].Bytes()
+		setup.G2Monomial = append(setup.G2Monomial, "0x"+hex.EncodeToString(b[:]))
+	}
+	res, err := json.Marshal(&setup)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
@@ -0,0 +1,14 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package ethkzg implements the blob KZG API of Ethereum on BLS12-381:
+// the commitments and proofs of the Deneb polynomial commitments
+// specification (EIP-4844), and the cells of the Fulu PeerDAS specification
+// (EIP-7594).
+//
+// Blobs, cells, commitments and proofs are the byte arrays of the
+// specification; a [Context] is built from the monomial powers of the trusted
+// setup.
+//
+// See https://github.com/ethereum/consensus-specs.
+package ethkzg
@@ -0,0 +1,303 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+)
+
+// BlobToKZGCommitment returns the commitment to the polynomial whose
+// evaluations are blob.
+func (c *Context) BlobToKZGCommitment(blob *Blob) (KZGCommitment, error) {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGCommitment{}, err
+	}
+	commitment, err := g1Lincomb(c.lagrange, polynomial)
+	if err != nil {
+		return KZGCommitment{}, err
+	}
+	return commitment.Bytes(), nil
+}
+
+// ComputeKZGProof returns the proof that the polynomial of blob evaluates to y
+// at z, along with y.
+func (c *Context) ComputeKZGProof(blob *Blob, z Bytes32) (KZGProof, Bytes32, error) {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGProof{}, Bytes32{}, err
+	}
+	zFr, err := bytesToBLSField(z[:])
+	if err != nil {
+		return KZGProof{}, Bytes32{}, err
+	}
+	proof, y, err := c.computeKZGProofImpl(polynomial, zFr)
+	if err != nil {
+		return KZGProof{}, Bytes32{}, err
+	}
+	return proof.Bytes(), y.Bytes(), nil
+}
+
+// VerifyKZGProof verifies that the polynomial committed to by commitment
+// evaluates to y at z.
+func (c *Context) VerifyKZGProof(commitment KZGCommitment, z, y Bytes32, proof KZGProof) error {
+	cm, err := bytesToG1(commitment[:])
+	if err != nil {
+		return err
+	}
+	zFr, err := bytesToBLSField(z[:])
+	if err != nil {
+		return err
+	}
+	yFr, err := bytesToBLSField(y[:])
+	if err != nil {
+		return err
+	}
+	pi, err := bytesToG1(proof[:])
+	if err != nil {
+		return err
+	}
+	return c.verifyKZGProofImpl(&cm, zFr, yFr, &pi)
+}
+
+// ComputeBlobKZGProof returns the proof of the evaluation of the polynomial of
+// blob at the Fiat-Shamir challenge derived from blob and commitment.
+//
+// commitment is not checked against blob.
+func (c *Context) ComputeBlobKZGProof(blob *Blob, commitment KZGCommitment) (KZGProof, error) {
+	if _, err := bytesToG1(commitment[:]); err != nil {
+		return KZGProof{}, err
+	}
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGProof{}, err
+	}
+	z := computeChallenge(blob, &commitment)
+	proof, _, err := c.computeKZGProofImpl(polynomial, z)
+	if err != nil {
+		return KZGProof{}, err
+	}
+	return proof.Bytes(), nil
+}
+
+// VerifyBlobKZGProof verifies that commitment is a commitment to blob, given
+// the proof returned by [Context.ComputeBlobKZGProof].
+func (c *Context) VerifyBlobKZGProof(blob *Blob, commitment KZGCommitment, proof KZGProof) error {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return err
+	}
+	cm, err := bytesToG1(commitment[:])
+	if err != nil {
+		return err
+	}
+	pi, err := bytesToG1(proof[:])
+	if err != nil {
+		return err
+	}
+	z := computeChallenge(blob, &commitment)
+	y := c.evaluatePolynomialInEvaluationForm(polynomial, z)
+	return c.verifyKZGProofImpl(&cm, z, y, &pi)
+}
+
+// VerifyBlobKZGProofBatch verifies that commitments[i] is a commitment to
+// blobs[i] for all i, given the proofs returned by [Context.ComputeBlobKZGProof].
+//
+// The proofs are verified at once, with a random linear combination.
+func (c *Context) VerifyBlobKZGProofBatch(blobs []Blob, commitments []KZGCommitment, proofs []KZGProof) error {
+	if len(blobs) != len(commitments) || len(blobs) != len(proofs) {
+		return ErrInvalidNbElements
+	}
+	if len(blobs) == 1 {
+		return c.VerifyBlobKZGProof(&blobs[0], commitments[0], proofs[0])
+	}
+
+	cms := make([]bls12381.G1Affine, len(blobs))
+	pis := make([]bls12381.G1Affine, len(blobs))
+	zs := make([]fr.Element, len(blobs))
+	ys := make([]fr.Element, len(blobs))
+	for i := range blobs {
+		polynomial, err := blobToPolynomial(&blobs[i])
+		if err != nil {
+			return err
+		}
+		if cms[i], err = bytesToG1(commitments[i][:]); err != nil {
+			return err
+		}
+		if pis[i], err = bytesToG1(proofs[i][:]); err != nil {
+			return err
+		}
+		zs[i] = computeChallenge(&blobs[i], &commitments[i])
+		ys[i] = c.evaluatePolynomialInEvaluationForm(polynomial, zs[i])
+	}
+
+	return c.verifyKZGProofBatch(cms, zs, ys, pis)
+}
+
+// computeChallenge returns the Fiat-Shamir challenge at which the polynomial
+// of blob is opened.
+func computeChallenge(blob *Blob, commitment *KZGCommitment) fr.Element {
+	data := make([]byte, 0, len(fiatShamirProtocolDomain)+16+BytesPerBlob+BytesPerCommitment)
+	data = append(data, fiatShamirProtocolDomain...)
+	data = append(data, uint64ToBytes(FieldElementsPerBlob, 16)...)
+	data = append(data, blob[:]...)
+	data = append(data, commitment[:]...)
+	return hashToBLSField(data)
+}
+
+// evaluatePolynomialInEvaluationForm evaluates at z the polynomial given by its
+// evaluations on the bit-reversed blob domain, with the barycentric formula
+//
+//	p(z) = (zⁿ-1)/n ∑ᵢ pᵢωᵢ/(z-ωᵢ)
+func (c *Context) evaluatePolynomialInEvaluationForm(polynomial []fr.Element, z fr.Element) fr.Element {
+	denominators := make([]fr.Element, len(polynomial))
+	for i := range denominators {
+		denominators[i].Sub(&z, &c.roots[i])
+		if denominators[i].IsZero() {
+			// z is in the domain
+			return polynomial[i]
+		}
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	var res, tmp fr.Element
+	for i := range polynomial {
+		tmp.Mul(&polynomial[i], &c.roots[i]).Mul(&tmp, &denominators[i])
+		res.Add(&res, &tmp)
+	}
+
+	var zn fr.Element
+	zn.Exp(z, big.NewInt(FieldElementsPerBlob))
+	tmp.SetOne()
+	zn.Sub(&zn, &tmp)
+	res.Mul(&res, &zn).Mul(&res, &c.domain.CardinalityInv)
+	return res
+}
+
+// computeKZGProofImpl returns the opening proof of the polynomial given in
+// evaluation form at z, and the evaluation.
+func (c *Context) computeKZGProofImpl(polynomial []fr.Element, z fr.Element) (bls12381.G1Affine, fr.Element, error) {
+	y := c.evaluatePolynomialInEvaluationForm(polynomial, z)
+
+	// quotient (p(X) - y)/(X - z) in evaluation form
+	quotient := make([]fr.Element, len(polynomial))
+	denominators := make([]fr.Element, len(polynomial))
+	inDomain := -1
+	for i := range denominators {
+		denominators[i].Sub(&c.roots[i], &z)
+		if denominators[i].IsZero() {
+			inDomain = i
+		}
+	}
+	denominators = fr.BatchInvert(denominators)
+	for i := range quotient {
+		if i == inDomain {
+			continue
+		}
+		quotient[i].Sub(&polynomial[i], &y).Mul(&quotient[i], &denominators[i])
+	}
+	if inDomain != -1 {
+		quotient[inDomain] = c.computeQuotientEvalWithinDomain(polynomial, z, y)
+	}
+
+	proof, err := g1Lincomb(c.lagrange, quotient)
+	return proof, y, err
+}
+
+// computeQuotientEvalWithinDomain returns the evaluation at z = ωₘ of the
+// quotient (p(X) - y)/(X - z), that is
+//
+//	∑_{i≠m} (pᵢ-y)ωᵢ/(z(z-ωᵢ))
+func (c *Context) computeQuotientEvalWithinDomain(polynomial []fr.Element, z, y fr.Element) fr.Element {
+	denominators := make([]fr.Element, len(polynomial))
+	for i := range denominators {
+		// z(z-ωᵢ), zero for i = m and skipped below
+		denominators[i].Sub(&z, &c.roots[i]).Mul(&denominators[i], &z)
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	var res, tmp fr.Element
+	for i := range polynomial {
+		if denominators[i].IsZero() {
+			continue
+		}
+		tmp.Sub(&polynomial[i], &y).Mul(&tmp, &c.roots[i]).Mul(&tmp, &denominators[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+// verifyKZGProofImpl checks e(C - [y]₁, [1]₂) = e(π, [τ - z]₂).
+func (c *Context) verifyKZGProofImpl(commitment *bls12381.G1Affine, z, y fr.Element, proof *bls12381.G1Affine) error {
+	if err := kzg.Verify(commitment, &kzg.OpeningProof{H: *proof, ClaimedValue: y}, z, c.vk); err != nil {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// verifyKZGProofBatch verifies the openings commitments[i](zs[i]) = ys[i] with
+// a random linear combination of the pairing equations:
+//
+//	e(∑ rⁱπᵢ, [τ]₂) = e(∑ rⁱ(Cᵢ - [yᵢ]₁ + [zᵢ]πᵢ), [1]₂)
+func (c *Context) verifyKZGProofBatch(commitments []bls12381.G1Affine, zs, ys []fr.Element, proofs []bls12381.G1Affine) error {
+	n := len(commitments)
+
+	data := make([]byte, 0, len(randomChallengeKZGBatchDomain)+16+n*(BytesPerCommitment+2*BytesPerFieldElement+BytesPerProof))
+	data = append(data, randomChallengeKZGBatchDomain...)
+	data = append(data, uint64ToBytes(FieldElementsPerBlob, 8)...)
+	data = append(data, uint64ToBytes(uint64(n), 8)...)
+	for i := 0; i < n; i++ {
+		cm := commitments[i].Bytes()
+		z := zs[i].Bytes()
+		y := ys[i].Bytes()
+		pi := proofs[i].Bytes()
+		data = append(data, cm[:]...)
+		data = append(data, z[:]...)
+		data = append(data, y[:]...)
+		data = append(data, pi[:]...)
+	}
+	r := hashToBLSField(data)
+	rPowers := powers(r, n)
+
+	proofLincomb, err := g1Lincomb(proofs, rPowers)
+	if err != nil {
+		return err
+	}
+
+	// ∑ rⁱ(Cᵢ + [zᵢ]πᵢ) - [∑ rⁱyᵢ]₁
+	points := make([]bls12381.G1Affine, 0, 2*n+1)
+	scalars := make([]fr.Element, 0, 2*n+1)
+	points = append(points, commitments...)
+	scalars = append(scalars, rPowers...)
+	points = append(points, proofs...)
+	var ySum, tmp fr.Element
+	for i := 0; i < n; i++ {
+		tmp.Mul(&zs[i], &rPowers[i])
+		scalars = append(scalars, tmp)
+		tmp.Mul(&ys[i], &rPowers[i])
+		ySum.Add(&ySum, &tmp)
+	}
+	points = append(points, c.vk.G1)
+	scalars = append(scalars, *ySum.Neg(&ySum))
+	rhs, err := g1Lincomb(points, scalars)
+	if err != nil {
+		return err
+	}
+
+	// the Miller loop overwrites the lines, which are shared by concurrent calls
+	lines := c.vk.Lines
+	proofLincomb.Neg(&proofLincomb)
+	ok, err := bls12381.PairingCheckFixedQ([]bls12381.G1Affine{rhs, proofLincomb}, lines[:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyBatchOpeningProof
+	}
+	return nil
+}
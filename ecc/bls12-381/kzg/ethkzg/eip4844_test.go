@@ -0,0 +1,88 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatePolynomialInEvaluationForm(t *testing.T) {
+	assert := require.New(t)
+	c := testContext()
+
+	blob := randomBlob(t)
+	polynomial, err := blobToPolynomial(blob)
+	assert.NoError(err)
+	coefficients := make([]fr.Element, len(polynomial))
+	copy(coefficients, polynomial)
+	c.domain.FFTInverse(coefficients, fft.DIT)
+
+	var z fr.Element
+	z.SetRandom()
+	var expected fr.Element
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		expected.Mul(&expected, &z).Add(&expected, &coefficients[i])
+	}
+	assert.Equal(expected, c.evaluatePolynomialInEvaluationForm(polynomial, z))
+
+	// in the domain
+	assert.Equal(polynomial[5], c.evaluatePolynomialInEvaluationForm(polynomial, c.roots[5]))
+}
+
+func TestKZGProof(t *testing.T) {
+	assert := require.New(t)
+	c := testContext()
+
+	blob := randomBlob(t)
+	commitment, err := c.BlobToKZGCommitment(blob)
+	assert.NoError(err)
+
+	var z fr.Element
+	z.SetRandom()
+	for _, z := range []Bytes32{z.Bytes(), c.roots[3].Bytes()} {
+		proof, y, err := c.ComputeKZGProof(blob, z)
+		assert.NoError(err)
+		assert.NoError(c.VerifyKZGProof(commitment, z, y, proof))
+
+		y[31] ^= 1
+		assert.ErrorIs(c.VerifyKZGProof(commitment, z, y, proof), ErrVerifyOpeningProof)
+	}
+}
+
+func TestBlobKZGProof(t *testing.T) {
+	assert := require.New(t)
+	c := testContext()
+
+	const nbBlobs = 3
+	blobs := make([]Blob, nbBlobs)
+	commitments := make([]KZGCommitment, nbBlobs)
+	proofs := make([]KZGProof, nbBlobs)
+	for i := range blobs {
+		blobs[i] = *randomBlob(t)
+		var err error
+		commitments[i], err = c.BlobToKZGCommitment(&blobs[i])
+		assert.NoError(err)
+		proofs[i], err = c.ComputeBlobKZGProof(&blobs[i], commitments[i])
+		assert.NoError(err)
+		assert.NoError(c.VerifyBlobKZGProof(&blobs[i], commitments[i], proofs[i]))
+	}
+
+	assert.NoError(c.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+	assert.NoError(c.VerifyBlobKZGProofBatch(blobs[:1], commitments[:1], proofs[:1]))
+	assert.NoError(c.VerifyBlobKZGProofBatch(nil, nil, nil))
+	assert.ErrorIs(c.VerifyBlobKZGProofBatch(blobs, commitments[:2], proofs), ErrInvalidNbElements)
+
+	// swap two proofs
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	assert.ErrorIs(c.VerifyBlobKZGProof(&blobs[0], commitments[0], proofs[0]), ErrVerifyOpeningProof)
+	assert.ErrorIs(c.VerifyBlobKZGProofBatch(blobs, commitments, proofs), ErrVerifyBatchOpeningProof)
+
+	// invalid point
+	proofs[0][5] ^= 1
+	assert.Error(c.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+}
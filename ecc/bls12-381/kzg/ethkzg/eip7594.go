@@ -0,0 +1,314 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+// ComputeCellsAndKZGProofs extends blob with its Reed-Solomon parity, and
+// returns the CellsPerExtBlob cells of the extended blob along with the proofs
+// of their evaluations.
+func (c *Context) ComputeCellsAndKZGProofs(blob *Blob) ([]Cell, []KZGProof, error) {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the blob is in bit-reversed order, the coefficients in natural order
+	c.domain.FFTInverse(polynomial, fft.DIT)
+
+	return c.computeCellsAndKZGProofsPolynomialCoeff(polynomial)
+}
+
+// RecoverCellsAndKZGProofs recovers all the cells of an extended blob, and
+// their proofs, from at least half of them. cellIndices[i] is the index of
+// cells[i] in the extended blob, and the indices must be in increasing order.
+//
+// The proofs of the given cells are not verified.
+func (c *Context) RecoverCellsAndKZGProofs(cellIndices []uint64, cells []Cell) ([]Cell, []KZGProof, error) {
+	if len(cellIndices) != len(cells) {
+		return nil, nil, ErrInvalidNbElements
+	}
+	if len(cellIndices) < CellsPerExtBlob/2 || len(cellIndices) > CellsPerExtBlob {
+		return nil, nil, ErrNotEnoughCells
+	}
+	var seen [CellsPerExtBlob]bool
+	for i, idx := range cellIndices {
+		if idx >= CellsPerExtBlob {
+			return nil, nil, ErrInvalidCellIndex
+		}
+		if seen[idx] {
+			return nil, nil, ErrDuplicateCellIndex
+		}
+		if i > 0 && idx < cellIndices[i-1] {
+			return nil, nil, ErrUnsortedCellIndices
+		}
+		seen[idx] = true
+	}
+
+	// extended blob, in bit-reversed order, with zeros in place of the missing cells
+	evaluations := make([]fr.Element, FieldElementsPerExtBlob)
+	for i := range cells {
+		cosetEvals, err := cellToCosetEvals(&cells[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		copy(evaluations[cellIndices[i]*FieldElementsPerCell:], cosetEvals)
+	}
+
+	polynomial := c.recoverPolynomialCoeff(evaluations, seen[:])
+	return c.computeCellsAndKZGProofsPolynomialCoeff(polynomial)
+}
+
+// VerifyCellKZGProofBatch verifies that cells[i] is the cell of index
+// cellIndices[i] of the extended blob committed to by commitments[i], given
+// the proofs[i] returned by [Context.ComputeCellsAndKZGProofs].
+//
+// The proofs are verified at once, with a random linear combination.
+func (c *Context) VerifyCellKZGProofBatch(commitments []KZGCommitment, cellIndices []uint64, cells []Cell, proofs []KZGProof) error {
+	n := len(cellIndices)
+	if len(commitments) != n || len(cells) != n || len(proofs) != n {
+		return ErrInvalidNbElements
+	}
+	for _, idx := range cellIndices {
+		if idx >= CellsPerExtBlob {
+			return ErrInvalidCellIndex
+		}
+	}
+
+	// deduplicate the commitments
+	var (
+		uniqueCommitments   []KZGCommitment
+		commitmentIndices   = make([]uint64, n)
+		commitmentPositions = make(map[KZGCommitment]uint64)
+	)
+	for i := range commitments {
+		pos, ok := commitmentPositions[commitments[i]]
+		if !ok {
+			pos = uint64(len(uniqueCommitments))
+			commitmentPositions[commitments[i]] = pos
+			uniqueCommitments = append(uniqueCommitments, commitments[i])
+		}
+		commitmentIndices[i] = pos
+	}
+	cms := make([]bls12381.G1Affine, len(uniqueCommitments))
+	for i := range uniqueCommitments {
+		var err error
+		if cms[i], err = bytesToG1(uniqueCommitments[i][:]); err != nil {
+			return err
+		}
+	}
+
+	pis := make([]bls12381.G1Affine, n)
+	cosetsEvals := make([][]fr.Element, n)
+	for i := range cells {
+		var err error
+		if pis[i], err = bytesToG1(proofs[i][:]); err != nil {
+			return err
+		}
+		if cosetsEvals[i], err = cellToCosetEvals(&cells[i]); err != nil {
+			return err
+		}
+	}
+
+	return c.verifyCellKZGProofBatchImpl(uniqueCommitments, cms, commitmentIndices, cellIndices, cosetsEvals, proofs, pis)
+}
+
+// computeCellsAndKZGProofsPolynomialCoeff returns the cells of the extended
+// blob of the polynomial given by its FieldElementsPerBlob coefficients, and
+// the proofs of the cells.
+func (c *Context) computeCellsAndKZGProofsPolynomialCoeff(polynomial []fr.Element) ([]Cell, []KZGProof, error) {
+	// the DIF FFT outputs the evaluations on the extended domain in
+	// bit-reversed order, which is the order of the extended blob.
+	evaluations := make([]fr.Element, FieldElementsPerExtBlob)
+	copy(evaluations, polynomial)
+	c.domainExt.FFT(evaluations, fft.DIF)
+
+	cells := make([]Cell, CellsPerExtBlob)
+	for i := range cells {
+		for j := 0; j < FieldElementsPerCell; j++ {
+			b := evaluations[i*FieldElementsPerCell+j].Bytes()
+			copy(cells[i][j*BytesPerFieldElement:], b[:])
+		}
+	}
+
+	// the cell i is the coset hᵢ⟨ω⟩ of the roots of X^FieldElementsPerCell - hᵢ^FieldElementsPerCell.
+	// hᵢ is the bit-reversed root of index i⋅FieldElementsPerCell, so that
+	// the proofs of FK20, ordered by the natural cosets, are in bit-reversed order.
+	fk20Proofs, err := c.fk20.Open(polynomial)
+	if err != nil {
+		return nil, nil, err
+	}
+	bitReverse(fk20Proofs)
+	proofs := make([]KZGProof, CellsPerExtBlob)
+	for i := range proofs {
+		proofs[i] = fk20Proofs[i].Bytes()
+	}
+
+	return cells, proofs, nil
+}
+
+// cosetShift returns hᵢ, the first element of the coset of the cell i.
+func (c *Context) cosetShift(cellIndex uint64) fr.Element {
+	return c.rootsExt[cellIndex*FieldElementsPerCell]
+}
+
+// cosetShiftPow returns hᵢ^FieldElementsPerCell.
+func (c *Context) cosetShiftPow(cellIndex uint64) fr.Element {
+	h := c.cosetShift(cellIndex)
+	var res fr.Element
+	res.Exp(h, big.NewInt(FieldElementsPerCell))
+	return res
+}
+
+// recoverPolynomialCoeff returns the coefficients of the polynomial of an
+// extended blob from the evaluations of the available cells.
+//
+// Let Z be the polynomial vanishing on the missing cells, and E the extended
+// blob with zeros on the missing cells. Then E⋅Z = P⋅Z on the whole domain,
+// so that P = (E⋅Z)/Z, where the division is done on a coset of the domain.
+func (c *Context) recoverPolynomialCoeff(evaluations []fr.Element, available []bool) []fr.Element {
+	// the cosets of the cells are the roots of X^FieldElementsPerCell - hᵢ^FieldElementsPerCell,
+	// so that Z(X) = z(X^FieldElementsPerCell) with z vanishing on the hᵢ^FieldElementsPerCell of
+	// the missing cells.
+	z := make([]fr.Element, 1, CellsPerExtBlob+1)
+	z[0].SetOne()
+	for i := range available {
+		if available[i] {
+			continue
+		}
+		// z ← z⋅(X - hᵢ^FieldElementsPerCell)
+		root := c.cosetShiftPow(uint64(i))
+		z = append(z, fr.Element{})
+		for j := len(z) - 1; j > 0; j-- {
+			var tmp fr.Element
+			tmp.Mul(&z[j], &root)
+			z[j].Sub(&z[j-1], &tmp)
+		}
+		z[0].Mul(&z[0], &root).Neg(&z[0])
+	}
+	zeroPoly := make([]fr.Element, FieldElementsPerExtBlob)
+	for i := range z {
+		zeroPoly[i*FieldElementsPerCell] = z[i]
+	}
+
+	// E⋅Z in evaluation form (bit-reversed), then in coefficient form
+	zeroEvals := make([]fr.Element, FieldElementsPerExtBlob)
+	copy(zeroEvals, zeroPoly)
+	c.domainExt.FFT(zeroEvals, fft.DIF)
+	for i := range evaluations {
+		evaluations[i].Mul(&evaluations[i], &zeroEvals[i])
+	}
+	c.domainExt.FFTInverse(evaluations, fft.DIT)
+
+	// (E⋅Z)/Z on the coset
+	c.domainExt.FFT(evaluations, fft.DIF, fft.OnCoset())
+	c.domainExt.FFT(zeroPoly, fft.DIF, fft.OnCoset())
+	zeroPoly = fr.BatchInvert(zeroPoly)
+	for i := range evaluations {
+		evaluations[i].Mul(&evaluations[i], &zeroPoly[i])
+	}
+	c.domainExt.FFTInverse(evaluations, fft.DIT, fft.OnCoset())
+
+	return evaluations[:FieldElementsPerBlob]
+}
+
+// verifyCellKZGProofBatchImpl verifies the cells openings with a random linear
+// combination of the pairing equations e(πₖ, [τⁿ - hₖⁿ]₂) = e(C_{iₖ} - [Iₖ(τ)]₁, [1]₂),
+// where Iₖ interpolates the cell k on its coset and n = FieldElementsPerCell:
+//
+//	e(∑ rᵏπₖ, [τⁿ]₂) = e(∑ᵢ wᵢCᵢ - [∑ rᵏIₖ(τ)]₁ + ∑ rᵏhₖⁿπₖ, [1]₂)
+//
+// with wᵢ the sum of the rᵏ of the cells of the commitment i.
+func (c *Context) verifyCellKZGProofBatchImpl(commitmentsBytes []KZGCommitment, commitments []bls12381.G1Affine, commitmentIndices, cellIndices []uint64, cosetsEvals [][]fr.Element, proofsBytes []KZGProof, proofs []bls12381.G1Affine) error {
+	nbCells := len(cellIndices)
+
+	// Fiat-Shamir challenge
+	data := make([]byte, 0, len(randomChallengeKZGCellBatchDomain)+32+len(commitments)*BytesPerCommitment+nbCells*(16+BytesPerCell+BytesPerProof))
+	data = append(data, randomChallengeKZGCellBatchDomain...)
+	data = append(data, uint64ToBytes(FieldElementsPerBlob, 8)...)
+	data = append(data, uint64ToBytes(FieldElementsPerCell, 8)...)
+	data = append(data, uint64ToBytes(uint64(len(commitments)), 8)...)
+	data = append(data, uint64ToBytes(uint64(nbCells), 8)...)
+	for i := range commitmentsBytes {
+		data = append(data, commitmentsBytes[i][:]...)
+	}
+	for k := 0; k < nbCells; k++ {
+		data = append(data, uint64ToBytes(commitmentIndices[k], 8)...)
+		data = append(data, uint64ToBytes(cellIndices[k], 8)...)
+		for j := range cosetsEvals[k] {
+			b := cosetsEvals[k][j].Bytes()
+			data = append(data, b[:]...)
+		}
+		data = append(data, proofsBytes[k][:]...)
+	}
+	r := hashToBLSField(data)
+	rPowers := powers(r, nbCells)
+
+	// ∑ rᵏπₖ
+	ll, err := g1Lincomb(proofs, rPowers)
+	if err != nil {
+		return err
+	}
+
+	// ∑ᵢ wᵢCᵢ + ∑ rᵏhₖⁿπₖ
+	weights := make([]fr.Element, len(commitments))
+	for k := 0; k < nbCells; k++ {
+		weights[commitmentIndices[k]].Add(&weights[commitmentIndices[k]], &rPowers[k])
+	}
+	points := make([]bls12381.G1Affine, 0, len(commitments)+nbCells+FieldElementsPerCell)
+	scalars := make([]fr.Element, 0, len(commitments)+nbCells+FieldElementsPerCell)
+	points = append(points, commitments...)
+	scalars = append(scalars, weights...)
+	points = append(points, proofs...)
+	for k := 0; k < nbCells; k++ {
+		hn := c.cosetShiftPow(cellIndices[k])
+		hn.Mul(&hn, &rPowers[k])
+		scalars = append(scalars, hn)
+	}
+
+	// -[∑ rᵏIₖ(τ)]₁
+	interpolation := make([]fr.Element, FieldElementsPerCell)
+	evals := make([]fr.Element, FieldElementsPerCell)
+	for k := 0; k < nbCells; k++ {
+		// the cell holds Iₖ(hₖωʲ) in bit-reversed order of j, so that the inverse
+		// FFT gives the coefficients of Iₖ(hₖX)
+		copy(evals, cosetsEvals[k])
+		c.domainCell.FFTInverse(evals, fft.DIT)
+		h := c.cosetShift(cellIndices[k])
+		var hInv, s fr.Element
+		hInv.Inverse(&h)
+		s.Set(&rPowers[k])
+		for j := range evals {
+			evals[j].Mul(&evals[j], &s)
+			interpolation[j].Add(&interpolation[j], &evals[j])
+			s.Mul(&s, &hInv)
+		}
+	}
+	points = append(points, c.monomial[:FieldElementsPerCell]...)
+	for j := range interpolation {
+		interpolation[j].Neg(&interpolation[j])
+	}
+	scalars = append(scalars, interpolation...)
+
+	rl, err := g1Lincomb(points, scalars)
+	if err != nil {
+		return err
+	}
+
+	ll.Neg(&ll)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{ll, rl}, []bls12381.G2Affine{c.g2Cell, c.vk.G2[0]})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyBatchOpeningProof
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCellsAndKZGProofs(t *testing.T) {
+	assert := require.New(t)
+	c := testContext()
+
+	blob := randomBlob(t)
+	commitment, err := c.BlobToKZGCommitment(blob)
+	assert.NoError(err)
+
+	cells, proofs, err := c.ComputeCellsAndKZGProofs(blob)
+	assert.NoError(err)
+	assert.Len(cells, CellsPerExtBlob)
+	assert.Len(proofs, CellsPerExtBlob)
+
+	// the first half of the extended blob is the blob
+	for i := 0; i < CellsPerExtBlob/2; i++ {
+		assert.Equal(blob[i*BytesPerCell:(i+1)*BytesPerCell], cells[i][:])
+	}
+
+	commitments := make([]KZGCommitment, CellsPerExtBlob)
+	cellIndices := make([]uint64, CellsPerExtBlob)
+	for i := range commitments {
+		commitments[i] = commitment
+		cellIndices[i] = uint64(i)
+	}
+	assert.NoError(c.VerifyCellKZGProofBatch(commitments, cellIndices, cells, proofs))
+	assert.NoError(c.VerifyCellKZGProofBatch(commitments[:3], []uint64{7, 100, 7}, []Cell{cells[7], cells[100], cells[7]}, []KZGProof{proofs[7], proofs[100], proofs[7]}))
+	assert.NoError(c.VerifyCellKZGProofBatch(nil, nil, nil, nil))
+
+	// wrong cell index
+	assert.ErrorIs(c.VerifyCellKZGProofBatch(commitments[:1], []uint64{8}, cells[7:8], proofs[7:8]), ErrVerifyBatchOpeningProof)
+	assert.ErrorIs(c.VerifyCellKZGProofBatch(commitments[:1], []uint64{CellsPerExtBlob}, cells[7:8], proofs[7:8]), ErrInvalidCellIndex)
+
+	// tampered cell
+	cells[7][31] ^= 1
+	assert.ErrorIs(c.VerifyCellKZGProofBatch(commitments, cellIndices, cells, proofs), ErrVerifyBatchOpeningProof)
+}
+
+func TestRecoverCellsAndKZGProofs(t *testing.T) {
+	assert := require.New(t)
+	c := testContext()
+
+	blob := randomBlob(t)
+	cells, proofs, err := c.ComputeCellsAndKZGProofs(blob)
+	assert.NoError(err)
+
+	nbCellsList := []int{CellsPerExtBlob / 2, CellsPerExtBlob/2 + 17, CellsPerExtBlob}
+	if testing.Short() {
+		nbCellsList = nbCellsList[:1]
+	}
+	for _, nbCells := range nbCellsList {
+		perm := rand.Perm(CellsPerExtBlob)[:nbCells]
+		slices.Sort(perm)
+		cellIndices := make([]uint64, nbCells)
+		partialCells := make([]Cell, nbCells)
+		for i, idx := range perm {
+			cellIndices[i] = uint64(idx)
+			partialCells[i] = cells[idx]
+		}
+
+		recoveredCells, recoveredProofs, err := c.RecoverCellsAndKZGProofs(cellIndices, partialCells)
+		assert.NoError(err)
+		assert.Equal(cells, recoveredCells)
+		assert.Equal(proofs, recoveredProofs)
+	}
+
+	_, _, err = c.RecoverCellsAndKZGProofs([]uint64{0}, cells[:1])
+	assert.ErrorIs(err, ErrNotEnoughCells)
+
+	cellIndices := make([]uint64, CellsPerExtBlob/2)
+	for i := range cellIndices {
+		cellIndices[i] = uint64(i)
+	}
+	cellIndices[0], cellIndices[1] = 1, 0
+	_, _, err = c.RecoverCellsAndKZGProofs(cellIndices, cells[:CellsPerExtBlob/2])
+	assert.ErrorIs(err, ErrUnsortedCellIndices)
+	cellIndices[0] = 0
+	_, _, err = c.RecoverCellsAndKZGProofs(cellIndices, cells[:CellsPerExtBlob/2])
+	assert.ErrorIs(err, ErrDuplicateCellIndex)
+}
@@ -0,0 +1,227 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+)
+
+const (
+	// FieldElementsPerBlob is the number of scalars in a blob
+	FieldElementsPerBlob = 4096
+	// FieldElementsPerExtBlob is the number of scalars in a blob extended with its Reed-Solomon parity
+	FieldElementsPerExtBlob = 2 * FieldElementsPerBlob
+	// FieldElementsPerCell is the number of scalars in a cell
+	FieldElementsPerCell = 64
+	// CellsPerExtBlob is the number of cells in an extended blob
+	CellsPerExtBlob = FieldElementsPerExtBlob / FieldElementsPerCell
+
+	// BytesPerFieldElement is the size of a serialized scalar
+	BytesPerFieldElement = fr.Bytes
+	// BytesPerBlob is the size of a blob
+	BytesPerBlob = FieldElementsPerBlob * BytesPerFieldElement
+	// BytesPerCell is the size of a cell
+	BytesPerCell = FieldElementsPerCell * BytesPerFieldElement
+	// BytesPerCommitment is the size of a serialized KZG commitment
+	BytesPerCommitment = bls12381.SizeOfG1AffineCompressed
+	// BytesPerProof is the size of a serialized KZG proof
+	BytesPerProof = bls12381.SizeOfG1AffineCompressed
+)
+
+// domain separators of the Fiat-Shamir challenges
+const (
+	fiatShamirProtocolDomain          = "FSBLOBVERIFY_V1_"
+	randomChallengeKZGBatchDomain     = "RCKZGBATCH___V1_"
+	randomChallengeKZGCellBatchDomain = "RCKZGCBATCH__V1_"
+)
+
+var (
+	ErrInvalidSetupSize         = errors.New("setup must have at least FieldElementsPerBlob G1 and FieldElementsPerCell+1 G2 powers")
+	ErrNonCanonicalFieldElement = errors.New("scalar is not canonical")
+	ErrInvalidPoint             = errors.New("invalid G1 point encoding")
+	ErrInvalidNbElements        = errors.New("inputs don't have the same length")
+	ErrInvalidCellIndex         = errors.New("cell index out of range")
+	ErrDuplicateCellIndex       = errors.New("duplicate cell index")
+	ErrUnsortedCellIndices      = errors.New("cell indices are not in increasing order")
+	ErrNotEnoughCells           = errors.New("not enough cells to recover the blob")
+	ErrVerifyOpeningProof       = errors.New("can't verify opening proof")
+	ErrVerifyBatchOpeningProof  = errors.New("can't verify batch opening proof")
+)
+
+// Blob is a vector of FieldElementsPerBlob big-endian scalars, in evaluation
+// form over the bit-reversed roots of unity.
+type Blob [BytesPerBlob]byte
+
+// Cell is a chunk of FieldElementsPerCell evaluations of an extended blob.
+type Cell [BytesPerCell]byte
+
+// KZGCommitment is a compressed G1 point committing to a blob.
+type KZGCommitment [BytesPerCommitment]byte
+
+// KZGProof is a compressed G1 point proving an opening.
+type KZGProof [BytesPerProof]byte
+
+// Bytes32 is a big-endian scalar.
+type Bytes32 [32]byte
+
+// Context holds the trusted setup and the precomputed evaluation domains.
+// It is safe for concurrent use.
+type Context struct {
+	vk       kzg.VerifyingKey    // [1]₁, [1]₂, [τ]₂
+	g2Cell   bls12381.G2Affine   // [τ^FieldElementsPerCell]₂
+	monomial []bls12381.G1Affine // [τⁱ]₁ for i < FieldElementsPerBlob
+	lagrange []bls12381.G1Affine // [Lᵢ(τ)]₁ over the blob domain, in bit-reversed order
+	fk20     *kzg.FK20           // cell proofs over the extended blob domain
+
+	domain     *fft.Domain  // blob domain
+	domainExt  *fft.Domain  // extended blob domain
+	domainCell *fft.Domain  // cell domain
+	roots      []fr.Element // roots of unity of the blob domain, in bit-reversed order
+	rootsExt   []fr.Element // roots of unity of the extended blob domain, in bit-reversed order
+}
+
+// NewContext returns a Context from the monomial trusted setup
+// g1 = [τⁱ]₁ for i < FieldElementsPerBlob and g2 = [τⁱ]₂ for i ≤ FieldElementsPerCell,
+// e.g. the Ethereum KZG ceremony output. Extra powers are ignored.
+func NewContext(g1 []bls12381.G1Affine, g2 []bls12381.G2Affine) (*Context, error) {
+	if len(g1) < FieldElementsPerBlob || len(g2) < FieldElementsPerCell+1 {
+		return nil, ErrInvalidSetupSize
+	}
+	c := &Context{
+		monomial:   make([]bls12381.G1Affine, FieldElementsPerBlob),
+		domain:     fft.NewDomain(FieldElementsPerBlob),
+		domainExt:  fft.NewDomain(FieldElementsPerExtBlob),
+		domainCell: fft.NewDomain(FieldElementsPerCell),
+	}
+	copy(c.monomial, g1)
+
+	c.vk.G1 = g1[0]
+	c.vk.G2[0] = g2[0]
+	c.vk.G2[1] = g2[1]
+	c.vk.Lines[0] = bls12381.PrecomputeLines(c.vk.G2[0])
+	c.vk.Lines[1] = bls12381.PrecomputeLines(c.vk.G2[1])
+	c.g2Cell = g2[FieldElementsPerCell]
+
+	var err error
+	if c.lagrange, err = kzg.ToLagrangeG1(c.monomial); err != nil {
+		return nil, err
+	}
+	bitReverse(c.lagrange)
+
+	if c.fk20, err = kzg.NewFK20(kzg.ProvingKey{G1: c.monomial}, FieldElementsPerExtBlob, FieldElementsPerCell); err != nil {
+		return nil, err
+	}
+
+	c.roots = bitReversedRoots(c.domain)
+	c.rootsExt = bitReversedRoots(c.domainExt)
+
+	return c, nil
+}
+
+// bitReversedRoots returns the powers of the domain generator in bit-reversed order.
+func bitReversedRoots(d *fft.Domain) []fr.Element {
+	roots := make([]fr.Element, d.Cardinality)
+	roots[0].SetOne()
+	for i := 1; i < len(roots); i++ {
+		roots[i].Mul(&roots[i-1], &d.Generator)
+	}
+	fft.BitReverse(roots)
+	return roots
+}
+
+func bitReverse[T any](a []T) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
+
+// bytesToBLSField decodes a big-endian canonical scalar.
+func bytesToBLSField(b []byte) (fr.Element, error) {
+	var res fr.Element
+	if err := res.SetBytesCanonical(b); err != nil {
+		return res, ErrNonCanonicalFieldElement
+	}
+	return res, nil
+}
+
+// hashToBLSField returns SHA-256(data) mod r.
+func hashToBLSField(data []byte) fr.Element {
+	h := sha256.Sum256(data)
+	var res fr.Element
+	res.SetBytes(h[:])
+	return res
+}
+
+// bytesToG1 decodes a compressed G1 point, checking it is in the subgroup.
+func bytesToG1(b []byte) (bls12381.G1Affine, error) {
+	var p bls12381.G1Affine
+	if _, err := p.SetBytes(b); err != nil {
+		return p, ErrInvalidPoint
+	}
+	return p, nil
+}
+
+// blobToPolynomial decodes the scalars of a blob.
+func blobToPolynomial(blob *Blob) ([]fr.Element, error) {
+	return bytesToScalars(blob[:])
+}
+
+// cellToCosetEvals decodes the scalars of a cell.
+func cellToCosetEvals(cell *Cell) ([]fr.Element, error) {
+	return bytesToScalars(cell[:])
+}
+
+func bytesToScalars(b []byte) ([]fr.Element, error) {
+	res := make([]fr.Element, len(b)/BytesPerFieldElement)
+	var err error
+	for i := range res {
+		if res[i], err = bytesToBLSField(b[i*BytesPerFieldElement : (i+1)*BytesPerFieldElement]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// powers returns 1, x, …, x^{n-1}.
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// g1Lincomb returns ∑ᵢ [scalars[i]]points[i].
+func g1Lincomb(points []bls12381.G1Affine, scalars []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	if len(points) == 0 {
+		return res, nil
+	}
+	_, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+	return res, err
+}
+
+// uint64ToBytes returns the big-endian encoding of v on size bytes.
+func uint64ToBytes(v uint64, size int) []byte {
+	return new(big.Int).SetUint64(v).FillBytes(make([]byte, size))
+}
@@ -0,0 +1,72 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// testContext returns a Context from an insecure setup with a known τ.
+var testContext = sync.OnceValue(func() *Context {
+	var tau fr.Element
+	tau.SetUint64(42)
+
+	g1Powers := powers(tau, FieldElementsPerBlob)
+	g2Powers := powers(tau, FieldElementsPerCell+1)
+
+	_, _, g1, g2 := bls12381.Generators()
+	g1s := bls12381.BatchScalarMultiplicationG1(&g1, g1Powers)
+	g2s := make([]bls12381.G2Affine, len(g2Powers))
+	for i := range g2s {
+		var b big.Int
+		g2s[i].ScalarMultiplication(&g2, g2Powers[i].BigInt(&b))
+	}
+
+	c, err := NewContext(g1s, g2s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+})
+
+func randomBlob(t *testing.T) *Blob {
+	var blob Blob
+	for i := 0; i < FieldElementsPerBlob; i++ {
+		var e fr.Element
+		_, err := e.SetRandom()
+		require.NoError(t, err)
+		b := e.Bytes()
+		copy(blob[i*BytesPerFieldElement:], b[:])
+	}
+	return &blob
+}
+
+func TestNewContext(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewContext(make([]bls12381.G1Affine, FieldElementsPerBlob-1), make([]bls12381.G2Affine, FieldElementsPerCell+1))
+	assert.ErrorIs(err, ErrInvalidSetupSize)
+	_, err = NewContext(make([]bls12381.G1Affine, FieldElementsPerBlob), make([]bls12381.G2Affine, FieldElementsPerCell))
+	assert.ErrorIs(err, ErrInvalidSetupSize)
+}
+
+func TestBytesToBLSField(t *testing.T) {
+	assert := require.New(t)
+
+	// r is not canonical
+	b := fr.Modulus().FillBytes(make([]byte, BytesPerFieldElement))
+	_, err := bytesToBLSField(b)
+	assert.ErrorIs(err, ErrNonCanonicalFieldElement)
+
+	var blob Blob
+	copy(blob[BytesPerFieldElement:], b)
+	_, err = testContext().BlobToKZGCommitment(&blob)
+	assert.ErrorIs(err, ErrNonCanonicalFieldElement)
+}
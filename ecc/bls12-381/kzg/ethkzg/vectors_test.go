@@ -0,0 +1,363 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ethkzg
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+// The vectors in testdata are a subset of the KZG test vectors of the
+// consensus specifications (mainnet preset), as distributed with
+// github.com/ethereum/c-kzg-4844 v2.1.8, and ../testdata/trusted_setup.json is
+// the output of the Ethereum KZG ceremony. A null output means that the inputs
+// are invalid and an error is expected.
+
+// ethereumTrustedSetup is the JSON trusted setup of the consensus specifications.
+type ethereumTrustedSetup struct {
+	G1Monomial []string `json:"g1_monomial"`
+	G1Lagrange []string `json:"g1_lagrange"`
+	G2Monomial []string `json:"g2_monomial"`
+}
+
+var readEthereumTrustedSetup = sync.OnceValues(func() (*ethereumTrustedSetup, error) {
+	f, err := os.Open("../testdata/trusted_setup.json")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var setup ethereumTrustedSetup
+	if err := json.NewDecoder(f).Decode(&setup); err != nil {
+		return nil, err
+	}
+	return &setup, nil
+})
+
+// ethereumContext returns a Context from the trusted setup of the Ethereum KZG ceremony.
+var ethereumContext = sync.OnceValues(func() (*Context, error) {
+	setup, err := readEthereumTrustedSetup()
+	if err != nil {
+		return nil, err
+	}
+	g1, err := decodeG1Points(setup.G1Monomial)
+	if err != nil {
+		return nil, err
+	}
+	g2 := make([]bls12381.G2Affine, len(setup.G2Monomial))
+	for i := range g2 {
+		var b [bls12381.SizeOfG2AffineCompressed]byte
+		if err := fromHex(setup.G2Monomial[i], b[:]); err != nil {
+			return nil, err
+		}
+		if _, err := g2[i].SetBytes(b[:]); err != nil {
+			return nil, err
+		}
+	}
+	return NewContext(g1, g2)
+})
+
+func decodeG1Points(s []string) ([]bls12381.G1Affine, error) {
+	points := make([]bls12381.G1Affine, len(s))
+	for i := range points {
+		var b [bls12381.SizeOfG1AffineCompressed]byte
+		if err := fromHex(s[i], b[:]); err != nil {
+			return nil, err
+		}
+		if _, err := points[i].SetBytes(b[:]); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
+// fromHex decodes the 0x-prefixed hex string s into dst, which it must fill.
+func fromHex(s string, dst []byte) error {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return err
+	}
+	if len(b) != len(dst) {
+		return errors.New("invalid length")
+	}
+	copy(dst, b)
+	return nil
+}
+
+func toHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// runVectors runs test on the input and the expected output of each vector
+// of testdata/category.
+func runVectors[I, O any](t *testing.T, category string, test func(t *testing.T, c *Context, input *I, output *O)) {
+	c, err := ethereumContext()
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join("testdata", category, "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".yaml"), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var v struct {
+				Input  I  `yaml:"input"`
+				Output *O `yaml:"output"`
+			}
+			require.NoError(t, yaml.Unmarshal(data, &v))
+			test(t, c, &v.Input, v.Output)
+		})
+	}
+}
+
+// decodeSlice decodes the hex strings s in a slice of byte arrays, of which
+// bytes returns the underlying slice.
+func decodeSlice[T any](s []string, bytes func(*T) []byte) ([]T, error) {
+	res := make([]T, len(s))
+	for i := range s {
+		if err := fromHex(s[i], bytes(&res[i])); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func TestTrustedSetupLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	c, err := ethereumContext()
+	assert.NoError(err)
+	setup, err := readEthereumTrustedSetup()
+	assert.NoError(err)
+	lagrange, err := decodeG1Points(setup.G1Lagrange)
+	assert.NoError(err)
+
+	// g1_lagrange is in natural order
+	bitReverse(lagrange)
+	assert.Equal(lagrange, c.lagrange)
+}
+
+func TestBlobToKZGCommitmentVectors(t *testing.T) {
+	type input struct {
+		Blob string `yaml:"blob"`
+	}
+	runVectors(t, "blob_to_kzg_commitment", func(t *testing.T, c *Context, in *input, out *string) {
+		var blob Blob
+		if err := fromHex(in.Blob, blob[:]); err != nil {
+			require.Nil(t, out)
+			return
+		}
+		commitment, err := c.BlobToKZGCommitment(&blob)
+		if out == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, *out, toHex(commitment[:]))
+	})
+}
+
+func TestComputeKZGProofVectors(t *testing.T) {
+	type input struct {
+		Blob string `yaml:"blob"`
+		Z    string `yaml:"z"`
+	}
+	runVectors(t, "compute_kzg_proof", func(t *testing.T, c *Context, in *input, out *[]string) {
+		var blob Blob
+		var z Bytes32
+		if fromHex(in.Blob, blob[:]) != nil || fromHex(in.Z, z[:]) != nil {
+			require.Nil(t, out)
+			return
+		}
+		proof, y, err := c.ComputeKZGProof(&blob, z)
+		if out == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, *out, []string{toHex(proof[:]), toHex(y[:])})
+	})
+}
+
+func TestVerifyKZGProofVectors(t *testing.T) {
+	type input struct {
+		Commitment string `yaml:"commitment"`
+		Z          string `yaml:"z"`
+		Y          string `yaml:"y"`
+		Proof      string `yaml:"proof"`
+	}
+	runVectors(t, "verify_kzg_proof", func(t *testing.T, c *Context, in *input, out *bool) {
+		var commitment KZGCommitment
+		var z, y Bytes32
+		var proof KZGProof
+		if fromHex(in.Commitment, commitment[:]) != nil || fromHex(in.Z, z[:]) != nil ||
+			fromHex(in.Y, y[:]) != nil || fromHex(in.Proof, proof[:]) != nil {
+			require.Nil(t, out)
+			return
+		}
+		err := c.VerifyKZGProof(commitment, z, y, proof)
+		checkVerification(t, err, out)
+	})
+}
+
+func TestComputeBlobKZGProofVectors(t *testing.T) {
+	type input struct {
+		Blob       string `yaml:"blob"`
+		Commitment string `yaml:"commitment"`
+	}
+	runVectors(t, "compute_blob_kzg_proof", func(t *testing.T, c *Context, in *input, out *string) {
+		var blob Blob
+		var commitment KZGCommitment
+		if fromHex(in.Blob, blob[:]) != nil || fromHex(in.Commitment, commitment[:]) != nil {
+			require.Nil(t, out)
+			return
+		}
+		proof, err := c.ComputeBlobKZGProof(&blob, commitment)
+		if out == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, *out, toHex(proof[:]))
+	})
+}
+
+func TestVerifyBlobKZGProofVectors(t *testing.T) {
+	type input struct {
+		Blob       string `yaml:"blob"`
+		Commitment string `yaml:"commitment"`
+		Proof      string `yaml:"proof"`
+	}
+	runVectors(t, "verify_blob_kzg_proof", func(t *testing.T, c *Context, in *input, out *bool) {
+		var blob Blob
+		var commitment KZGCommitment
+		var proof KZGProof
+		if fromHex(in.Blob, blob[:]) != nil || fromHex(in.Commitment, commitment[:]) != nil ||
+			fromHex(in.Proof, proof[:]) != nil {
+			require.Nil(t, out)
+			return
+		}
+		err := c.VerifyBlobKZGProof(&blob, commitment, proof)
+		checkVerification(t, err, out)
+	})
+}
+
+func TestVerifyBlobKZGProofBatchVectors(t *testing.T) {
+	type input struct {
+		Blobs       []string `yaml:"blobs"`
+		Commitments []string `yaml:"commitments"`
+		Proofs      []string `yaml:"proofs"`
+	}
+	runVectors(t, "verify_blob_kzg_proof_batch", func(t *testing.T, c *Context, in *input, out *bool) {
+		blobs, err1 := decodeSlice(in.Blobs, func(b *Blob) []byte { return b[:] })
+		commitments, err2 := decodeSlice(in.Commitments, func(b *KZGCommitment) []byte { return b[:] })
+		proofs, err3 := decodeSlice(in.Proofs, func(b *KZGProof) []byte { return b[:] })
+		if err1 != nil || err2 != nil || err3 != nil {
+			require.Nil(t, out)
+			return
+		}
+		err := c.VerifyBlobKZGProofBatch(blobs, commitments, proofs)
+		checkVerification(t, err, out)
+	})
+}
+
+func TestComputeCellsAndKZGProofsVectors(t *testing.T) {
+	type input struct {
+		Blob string `yaml:"blob"`
+	}
+	runVectors(t, "compute_cells_and_kzg_proofs", func(t *testing.T, c *Context, in *input, out *[][]string) {
+		var blob Blob
+		if err := fromHex(in.Blob, blob[:]); err != nil {
+			require.Nil(t, out)
+			return
+		}
+		cells, proofs, err := c.ComputeCellsAndKZGProofs(&blob)
+		if out == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		checkCellsAndProofs(t, *out, cells, proofs)
+	})
+}
+
+func TestRecoverCellsAndKZGProofsVectors(t *testing.T) {
+	type input struct {
+		CellIndices []uint64 `yaml:"cell_indices"`
+		Cells       []string `yaml:"cells"`
+	}
+	runVectors(t, "recover_cells_and_kzg_proofs", func(t *testing.T, c *Context, in *input, out *[][]string) {
+		cells, err := decodeSlice(in.Cells, func(b *Cell) []byte { return b[:] })
+		if err != nil {
+			require.Nil(t, out)
+			return
+		}
+		recoveredCells, recoveredProofs, err := c.RecoverCellsAndKZGProofs(in.CellIndices, cells)
+		if out == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		checkCellsAndProofs(t, *out, recoveredCells, recoveredProofs)
+	})
+}
+
+func TestVerifyCellKZGProofBatchVectors(t *testing.T) {
+	type input struct {
+		Commitments []string `yaml:"commitments"`
+		CellIndices []uint64 `yaml:"cell_indices"`
+		Cells       []string `yaml:"cells"`
+		Proofs      []string `yaml:"proofs"`
+	}
+	runVectors(t, "verify_cell_kzg_proof_batch", func(t *testing.T, c *Context, in *input, out *bool) {
+		commitments, err1 := decodeSlice(in.Commitments, func(b *KZGCommitment) []byte { return b[:] })
+		cells, err2 := decodeSlice(in.Cells, func(b *Cell) []byte { return b[:] })
+		proofs, err3 := decodeSlice(in.Proofs, func(b *KZGProof) []byte { return b[:] })
+		if err1 != nil || err2 != nil || err3 != nil {
+			require.Nil(t, out)
+			return
+		}
+		err := c.VerifyCellKZGProofBatch(commitments, in.CellIndices, cells, proofs)
+		checkVerification(t, err, out)
+	})
+}
+
+// checkVerification checks the result of a verification against the expected
+// output: no error if true, a verification error if false, and another error
+// if the inputs are invalid.
+func checkVerification(t *testing.T, err error, out *bool) {
+	// a batch of one proof is verified on its own
+	failed := errors.Is(err, ErrVerifyOpeningProof) || errors.Is(err, ErrVerifyBatchOpeningProof)
+	switch {
+	case out == nil:
+		require.Error(t, err)
+		require.False(t, failed, "invalid inputs should not reach the verification")
+	case *out:
+		require.NoError(t, err)
+	default:
+		require.True(t, failed, "expected a verification error, got %v", err)
+	}
+}
+
+func checkCellsAndProofs(t *testing.T, out [][]string, cells []Cell, proofs []KZGProof) {
+	require.Len(t, out, 2)
+	require.Len(t, cells, len(out[0]))
+	require.Len(t, proofs, len(out[1]))
+	for i := range cells {
+		require.Equal(t, out[0][i], toHex(cells[i][:]), "cell %d", i)
+	}
+	for i := range proofs {
+		require.Equal(t, out[1][i], toHex(proofs[i][:]), "proof %d", i)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSRSFromPowers(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewSRSFromPowers(testSrs.Pk.G1, testSrs.Vk.G2, 64)
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1[:64], srs.Pk.G1)
+	assert.Equal(testSrs.Vk, srs.Vk)
+
+	// the imported SRS commits and opens
+	p := randomPolynomial(64)
+	digest, err := Commit(p, srs.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(p, point, srs.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, srs.Vk))
+
+	_, err = NewSRSFromPowers(testSrs.Pk.G1[:10], testSrs.Vk.G2, 64)
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+	_, err = NewSRSFromPowers(testSrs.Pk.G1, testSrs.Vk.G2, 1)
+	assert.ErrorIs(err, ErrMinSRSSize)
+
+	// τ⁵ is replaced by another power
+	g1 := make([]curve.G1Affine, 64)
+	copy(g1, testSrs.Pk.G1)
+	g1[5] = g1[6]
+	_, err = NewSRSFromPowers(g1, testSrs.Vk.G2, 64)
+	assert.ErrorIs(err, ErrSRSInconsistent)
+
+	// [τ]G₂ of another τ
+	g2 := testSrs.Vk.G2
+	g2[1].ScalarMultiplication(&g2[1], big.NewInt(2))
+	_, err = NewSRSFromPowers(testSrs.Pk.G1, g2, 64)
+	assert.ErrorIs(err, ErrSRSInconsistent)
+
+	// wrong generator
+	g1[5] = testSrs.Pk.G1[5]
+	g1[0] = g1[1]
+	_, err = NewSRSFromPowers(g1, testSrs.Vk.G2, 64)
+	assert.ErrorIs(err, ErrSRSInvalidGenerator)
+}
+
+func TestLagrangeProvingKey(t *testing.T) {
+	assert := require.New(t)
+
+	pk, err := LagrangeProvingKey(testSrs.Pk, 32)
+	assert.NoError(err)
+	expected, err := ToLagrangeG1(testSrs.Pk.G1[:32])
+	assert.NoError(err)
+	assert.Equal(expected, pk.G1)
+
+	_, err = LagrangeProvingKey(testSrs.Pk, 24)
+	assert.ErrorIs(err, ErrInvalidDomainSize)
+	_, err = LagrangeProvingKey(ProvingKey{G1: testSrs.Pk.G1[:16]}, 32)
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+}
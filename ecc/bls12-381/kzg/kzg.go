@@ -420,14 +420,32 @@ func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr
 	}
 
 	// sample random numbers λᵢ for sampling
-	randomNumbers := make([]fr.Element, len(digests))
+	randomNumbers, err := sampleRandomNumbers(len(digests))
+	if err != nil {
+		return err
+	}
+
+	return checkMultiPoints(digests, proofs, points, randomNumbers, vk)
+
+}
+
+// sampleRandomNumbers returns n random numbers λᵢ for folding opening proofs,
+// with λ₀ = 1.
+func sampleRandomNumbers(n int) ([]fr.Element, error) {
+	randomNumbers := make([]fr.Element, n)
 	randomNumbers[0].SetOne()
 	for i := 1; i < len(randomNumbers); i++ {
 		_, err := randomNumbers[i].SetRandom()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	return randomNumbers, nil
+}
+
+// checkMultiPoints verifies the opening proofs folded with randomNumbers using
+// a single pairing check.
+func checkMultiPoints(digests []Digest, proofs []OpeningProof, points []fr.Element, randomNumbers []fr.Element, vk VerifyingKey) error {
 
 	// fold the committed quotients compute ∑ᵢλᵢ[Hᵢ(α)]G₁
 	var foldedQuotients bls12381.G1Affine
@@ -465,10 +483,11 @@ func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr
 	// combien the points and the quotients using γᵢ
 	// ∑ᵢλᵢ[p_i]([Hᵢ(α)]G₁)
 	var foldedPointsQuotients bls12381.G1Affine
+	pointsRandomNumbers := make([]fr.Element, len(randomNumbers))
 	for i := 0; i < len(randomNumbers); i++ {
-		randomNumbers[i].Mul(&randomNumbers[i], &points[i])
+		pointsRandomNumbers[i].Mul(&randomNumbers[i], &points[i])
 	}
-	_, err = foldedPointsQuotients.MultiExp(quotients, randomNumbers, config)
+	_, err = foldedPointsQuotients.MultiExp(quotients, pointsRandomNumbers, config)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,113 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// CommitLagrange commits to a polynomial given by its evaluations on the
+// domain ⟨ω⟩ of size n = len(p), in natural order, with the ProvingKey in
+// Lagrange form returned by LagrangeProvingKey for the same size. The
+// commitment is the one of the polynomial in canonical form, without
+// interpolating it.
+func CommitLagrange(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	if len(p) != len(pk.G1) || bits.OnesCount(uint(len(p))) != 1 {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	return Commit(p, pk, nbTasks...)
+}
+
+// OpenLagrange computes an opening proof at point of a polynomial given by its
+// evaluations on the domain ⟨ω⟩ of size n = len(p), in natural order, with the
+// ProvingKey in Lagrange form returned by LagrangeProvingKey for the same size.
+// The proof is the same as Open on the polynomial in canonical form, and is
+// checked with Verify.
+//
+// The quotient q = (p - p(a))/(X - a) is computed in evaluation form: when a
+// is not in the domain, p(a) = (aⁿ - 1)/n ∑ᵢ pᵢωⁱ/(a - ωⁱ) and
+// qᵢ = (pᵢ - p(a))/(ωⁱ - a). When a = ωᵐ, p(a) = pₘ, qᵢ = (pᵢ - pₘ)/(ωⁱ - ωᵐ)
+// for i ≠ m, and qₘ = -∑_{i≠m} qᵢωⁱ⁻ᵐ since q has degree at most n-2.
+func OpenLagrange(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error) {
+	n := len(p)
+	if n < 2 || n != len(pk.G1) || bits.OnesCount(uint(n)) != 1 {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+	omega, err := fr.Generator(uint64(n))
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// omegas[i] = ωⁱ, denominators[i] = ωⁱ - a
+	omegas := make([]fr.Element, n)
+	omegas[0].SetOne()
+	for i := 1; i < n; i++ {
+		omegas[i].Mul(&omegas[i-1], &omega)
+	}
+	m := -1
+	denominators := make([]fr.Element, n)
+	for i := range denominators {
+		denominators[i].Sub(&omegas[i], &point)
+		if denominators[i].IsZero() {
+			m = i
+		}
+	}
+	inverses := fr.BatchInvert(denominators)
+
+	var res OpeningProof
+	if m < 0 {
+		// p(a) = -(aⁿ - 1)/n ∑ᵢ pᵢωⁱ/(ωⁱ - a)
+		var sum, factor, nInv fr.Element
+		terms := make([]fr.Element, n)
+		parallel.Execute(n, func(start, end int) {
+			for i := start; i < end; i++ {
+				terms[i].Mul(&p[i], &omegas[i]).Mul(&terms[i], &inverses[i])
+			}
+		})
+		for i := range terms {
+			sum.Add(&sum, &terms[i])
+		}
+		factor.Set(&point)
+		for i := 1; i < n; i <<= 1 {
+			factor.Square(&factor)
+		}
+		one := fr.One()
+		factor.Sub(&one, &factor)
+		nInv.SetUint64(uint64(n)).Inverse(&nInv)
+		res.ClaimedValue.Mul(&sum, &factor).Mul(&res.ClaimedValue, &nInv)
+	} else {
+		res.ClaimedValue = p[m]
+	}
+
+	// qᵢ = (pᵢ - p(a))/(ωⁱ - a), i ≠ m
+	q := make([]fr.Element, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			if i != m {
+				q[i].Sub(&p[i], &res.ClaimedValue).Mul(&q[i], &inverses[i])
+			}
+		}
+	})
+	if m >= 0 {
+		// qₘ = -∑_{i≠m} qᵢωⁱ⁻ᵐ
+		var t fr.Element
+		for i := range q {
+			if i != m {
+				t.Mul(&q[i], &omegas[(i-m+n)%n])
+				q[m].Sub(&q[m], &t)
+			}
+		}
+	}
+
+	res.H, err = Commit(q, pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	return res, nil
+}
@@ -284,11 +284,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		parallel.Execute(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
+					if err := (*t)[i].unsafeComputeY(false); err != nil {
 						atomic.AddUint64(&nbErrs, 1)
 					}
 				}
@@ -297,6 +293,9 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
+		if dec.subGroupCheck && !BatchIsInSubGroupG1(*t) {
+			return errors.New("invalid point: subgroup check failed")
+		}
 
 		return nil
 	case *[]G2Affine:
@@ -349,11 +348,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		parallel.Execute(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
+					if err := (*t)[i].unsafeComputeY(false); err != nil {
 						atomic.AddUint64(&nbErrs, 1)
 					}
 				}
@@ -362,6 +357,9 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
+		if dec.subGroupCheck && !BatchIsInSubGroupG2(*t) {
+			return errors.New("invalid point: subgroup check failed")
+		}
 
 		return nil
 	default:
@@ -0,0 +1,136 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package powersoftau
+
+import (
+	"bytes"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhase1(t *testing.T) {
+	assert := require.New(t)
+
+	const N = 8
+	p := InitializeSetup(N)
+	for i := 0; i < 3; i++ {
+		next := p
+		next.parameters = cloneParameters(&p.parameters)
+		next.Contribute()
+		assert.NoError(p.Verify(&next))
+		p = next
+	}
+
+	srs := p.Seal([]byte("beacon"))
+	assert.Len(srs.G1.Tau, 2*N-1)
+	assert.Len(srs.G2.Tau, N)
+
+	// the powers of τ make a valid KZG SRS
+	_, err := kzg.NewSRSFromPowers(srs.G1.Tau, [2]curve.G2Affine{srs.G2.Tau[0], srs.G2.Tau[1]}, 2*N-1)
+	assert.NoError(err)
+}
+
+func TestPhase1InvalidContribution(t *testing.T) {
+	assert := require.New(t)
+
+	p := InitializeSetup(4)
+	next := p
+	next.parameters = cloneParameters(&p.parameters)
+	next.Contribute()
+
+	tampered := next
+	tampered.parameters = cloneParameters(&next.parameters)
+	tampered.parameters.G1.Tau[3] = tampered.parameters.G1.Tau[4]
+	assert.Error(p.Verify(&tampered))
+
+	tampered.parameters = cloneParameters(&next.parameters)
+	tampered.parameters.G1.AlphaTau[2] = tampered.parameters.G1.BetaTau[2]
+	assert.Error(p.Verify(&tampered))
+
+	tampered.parameters = cloneParameters(&next.parameters)
+	tampered.parameters.G2.Beta = tampered.parameters.G2.Tau[1]
+	assert.Error(p.Verify(&tampered))
+
+	// contribution on another state
+	tampered = next
+	tampered.Challenge = []byte("wrong challenge")
+	assert.Error(p.Verify(&tampered))
+
+	assert.NoError(p.Verify(&next))
+}
+
+func TestTranscript(t *testing.T) {
+	assert := require.New(t)
+
+	const N = 4
+	var buf bytes.Buffer
+	w, err := NewTranscriptWriter(&buf, N)
+	assert.NoError(err)
+
+	p := InitializeSetup(N)
+	for i := 0; i < 3; i++ {
+		p.Contribute()
+		assert.NoError(w.Append(&p))
+	}
+	assert.Error(w.Append(&Phase1{}))
+
+	last, nbContributions, err := VerifyTranscript(bytes.NewReader(buf.Bytes()), N)
+	assert.NoError(err)
+	assert.Equal(3, nbContributions)
+	assert.Equal(p.parameters, last.parameters)
+	assert.Equal(p.Seal([]byte("beacon")), last.Seal([]byte("beacon")))
+
+	// a contribution is replaced by a fresh one
+	buf.Reset()
+	w, err = NewTranscriptWriter(&buf, N)
+	assert.NoError(err)
+	p = InitializeSetup(N)
+	p.Contribute()
+	assert.NoError(w.Append(&p))
+	q := InitializeSetup(N)
+	q.Contribute()
+	q.Contribute()
+	assert.NoError(w.Append(&q))
+	_, _, err = VerifyTranscript(bytes.NewReader(buf.Bytes()), N)
+	assert.Error(err)
+
+	// the domain size is larger than the caller accepts
+	_, _, err = VerifyTranscript(bytes.NewReader(buf.Bytes()), N-1)
+	assert.Error(err)
+	buf.Reset()
+	_, err = NewTranscriptWriter(&buf, 1<<32)
+	assert.NoError(err)
+	_, _, err = VerifyTranscript(bytes.NewReader(buf.Bytes()), N)
+	assert.EqualError(err, "invalid domain size")
+}
+
+func TestSrsCommonsSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	p := InitializeSetup(4)
+	p.Contribute()
+
+	var buf bytes.Buffer
+	_, err := p.WriteTo(&buf)
+	assert.NoError(err)
+	var q Phase1
+	_, err = q.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(p, q)
+}
+
+func cloneParameters(c *SrsCommons) SrsCommons {
+	var res SrsCommons
+	res.G1.Tau = append([]curve.G1Affine(nil), c.G1.Tau...)
+	res.G1.AlphaTau = append([]curve.G1Affine(nil), c.G1.AlphaTau...)
+	res.G1.BetaTau = append([]curve.G1Affine(nil), c.G1.BetaTau...)
+	res.G2.Tau = append([]curve.G2Affine(nil), c.G2.Tau...)
+	res.G2.Beta = c.G2.Beta
+	return res
+}
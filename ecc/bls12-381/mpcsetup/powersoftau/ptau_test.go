@@ -0,0 +1,57 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package powersoftau
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritePtau(t *testing.T) {
+	assert := require.New(t)
+
+	const N = 8
+	p := InitializeSetup(N)
+	p.Contribute()
+	srs := p.Seal([]byte("beacon"))
+
+	var buf bytes.Buffer
+	assert.NoError(srs.WritePtau(&buf))
+	ptau := buf.Bytes()
+
+	// header
+	le := binary.LittleEndian
+	assert.Equal("ptau", string(ptau[:4]))
+	assert.Equal(uint32(7), le.Uint32(ptau[8:12]))
+	assert.Equal(uint32(fp.Bytes), le.Uint32(ptau[24:28]))
+	assert.Equal(uint32(3), le.Uint32(ptau[28+fp.Bytes:]))
+
+	// sections sizes
+	offset := 12
+	expectedSizes := []int{4 + fp.Bytes + 8, (2*N - 1) * 2 * fp.Bytes, N * 4 * fp.Bytes, N * 2 * fp.Bytes, N * 2 * fp.Bytes, 4 * fp.Bytes, 4}
+	for i, size := range expectedSizes {
+		assert.Equal(uint32(i+1), le.Uint32(ptau[offset:]))
+		assert.Equal(uint64(size), le.Uint64(ptau[offset+4:]))
+		offset += 12 + size
+	}
+	assert.Equal(len(ptau), offset)
+
+	// [τ]₁ in Montgomery form
+	var x fp.Element
+	x.Mul(&srs.G1.Tau[1].X, &montgomeryR)
+	var expected [fp.Bytes]byte
+	fp.LittleEndian.PutElement(&expected, x)
+	offset = 12 + 12 + expectedSizes[0] + 12
+	assert.Equal(expected[:], ptau[offset+2*fp.Bytes:offset+3*fp.Bytes])
+	_ = curve.G1Affine{}
+
+	assert.Error((&SrsCommons{}).WritePtau(&buf))
+}
@@ -0,0 +1,126 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12381
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestBatchIsInSubGroupG1(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{1, 5, 300}
+	if !testing.Short() {
+		sizes = append(sizes, 5000)
+	}
+	for _, n := range sizes {
+		points := randomG1SubGroupPoints(n)
+		if !BatchIsInSubGroupG1(points) {
+			t.Fatalf("n=%d: points in the subgroup rejected", n)
+		}
+
+		// a point is not on the curve
+		i := rand.IntN(n) //#nosec G404 weak rng is fine here
+		p := points[i]
+		points[i].Y.Double(&points[i].Y)
+		if BatchIsInSubGroupG1(points) {
+			t.Fatalf("n=%d: point not on the curve accepted", n)
+		}
+		points[i] = p
+
+		// a point is not in the subgroup
+		points[i] = randomG1PointNotInSubGroup()
+		if BatchIsInSubGroupG1(points) {
+			t.Fatalf("n=%d: point not in the subgroup accepted", n)
+		}
+
+		// a point of the subgroup plus a torsion point
+		var q G1Jac
+		q.FromAffine(&points[i])
+		q.mulWindowed(&q, fr.Modulus())
+		if !q.Z.IsZero() {
+			points[i].FromJacobian(&q)
+			points[i].Add(&points[i], &p)
+			if BatchIsInSubGroupG1(points) {
+				t.Fatalf("n=%d: point with a torsion component accepted", n)
+			}
+		}
+	}
+}
+
+func TestG1BatchSubGroupParameters(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 100, 1 << 10, 1 << 20, 1 << 28} {
+		c, nbRounds := g1BatchSubGroupParameters(n)
+		if c < 2 || c > 16 {
+			t.Fatalf("n=%d: unexpected coefficient size %d", n, c)
+		}
+
+		// probability of failure ⌈2ᶜ/ℓ⌉/2ᶜ per round
+		var numerator, denominator, bound big.Int
+		numerator.SetUint64(((uint64(1) << c) + g1CofactorBound - 1) / g1CofactorBound)
+		numerator.Exp(&numerator, big.NewInt(int64(nbRounds)), nil)
+		denominator.Lsh(big.NewInt(1), uint(c*nbRounds))
+		bound.Lsh(&numerator, g1BatchSubGroupSecurity)
+		if bound.Cmp(&denominator) > 0 {
+			t.Fatalf("n=%d: %d rounds of %d bits are not enough", n, nbRounds, c)
+		}
+	}
+}
+
+func BenchmarkBatchIsInSubGroupG1(b *testing.B) {
+	points := randomG1SubGroupPoints(1 << 15)
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchIsInSubGroupG1(points)
+		}
+	})
+	b.Run("individual", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range points {
+				points[j].IsInSubGroup()
+			}
+		}
+	})
+}
+
+// randomG1SubGroupPoints returns n random points of the subgroup.
+func randomG1SubGroupPoints(n int) []G1Affine {
+	points := make([]G1Affine, n)
+	var s fr.Element
+	var sInt big.Int
+	for i := range points {
+		if _, err := s.SetRandom(); err != nil {
+			panic(err)
+		}
+		points[i].ScalarMultiplication(&g1GenAff, s.BigInt(&sInt))
+	}
+	return points
+}
+
+// randomG1PointNotInSubGroup returns a random point on the curve, outside the
+// subgroup.
+func randomG1PointNotInSubGroup() G1Affine {
+	for {
+		var p G1Affine
+		var rhs, y fp.Element
+		if _, err := p.X.SetRandom(); err != nil {
+			panic(err)
+		}
+		rhs.Square(&p.X).Mul(&rhs, &p.X)
+		rhs.Add(&rhs, &bCurveCoeff)
+		p.Y.Sqrt(&rhs)
+		if y.Square(&p.Y); y.Equal(&rhs) && !p.IsInSubGroup() {
+			return p
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package zeromorph provides a multilinear polynomial commitment scheme on top of
+// KZG, cf https://eprint.iacr.org/2023/917.
+//
+// A multilinear polynomial, given by its evaluations on the hypercube as a
+// polynomial.MultiLin, is committed as the univariate polynomial whose
+// coefficients are these evaluations, with a kzg.ProvingKey. The commitment is
+// thus the same as kzg.Commit on the evaluations, and the usual univariate SRS
+// can be used.
+//
+// An opening proof at a point (u₁, ..., uₙ) is made of n+2 points of G₁ and is
+// checked with a multi-exponentiation of size n+3 and two pairings. Several
+// polynomials opened at the same point are folded with a random linear
+// combination into a single proof.
+package zeromorph
@@ -0,0 +1,253 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// maxLength bounds the lengths read when deserializing. The slices are not
+// allocated from these lengths but grown as their elements are read, so that
+// a malformed input can't make ReadFrom allocate much more than its own size.
+const maxLength = 1 << 30
+
+var errLength = errors.New("invalid length")
+
+// WriteTo writes a binary representation of the proof of proximity to w.
+func (proof *ProofOfProximity) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	if err := writeBytes(w, &n, proof.ID); err != nil {
+		return n, err
+	}
+	if err := writeByteSlices(w, &n, proof.Roots); err != nil {
+		return n, err
+	}
+	finalPolynomial := fr.Vector(proof.FinalPolynomial)
+	m, err := finalPolynomial.WriteTo(w)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	if err := writeUint64(w, &n, proof.Nonce); err != nil {
+		return n, err
+	}
+	if err := writeUint64(w, &n, uint64(len(proof.Rounds))); err != nil {
+		return n, err
+	}
+	for i := range proof.Rounds {
+		if err := writeUint64(w, &n, uint64(len(proof.Rounds[i].Interactions))); err != nil {
+			return n, err
+		}
+		for j := range proof.Rounds[i].Interactions {
+			if err := writeByteSlices(w, &n, proof.Rounds[i].Interactions[j].ProofSet); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom reads a binary representation of a proof of proximity written by
+// WriteTo from r.
+func (proof *ProofOfProximity) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	var err error
+	if proof.ID, err = readBytes(r, &n); err != nil {
+		return n, err
+	}
+	if proof.Roots, err = readByteSlices(r, &n); err != nil {
+		return n, err
+	}
+	if proof.FinalPolynomial, err = readVector(r, &n); err != nil {
+		return n, err
+	}
+	if proof.Nonce, err = readUint64(r, &n); err != nil {
+		return n, err
+	}
+	nbRounds, err := readLength(r, &n)
+	if err != nil {
+		return n, err
+	}
+	proof.Rounds = nil
+	for i := 0; i < nbRounds; i++ {
+		nbInteractions, err := readLength(r, &n)
+		if err != nil {
+			return n, err
+		}
+		var round Round
+		for j := 0; j < nbInteractions; j++ {
+			var interaction MerkleProof
+			if interaction.ProofSet, err = readByteSlices(r, &n); err != nil {
+				return n, err
+			}
+			round.Interactions = append(round.Interactions, interaction)
+		}
+		proof.Rounds = append(proof.Rounds, round)
+	}
+	return n, nil
+}
+
+// WriteTo writes a binary representation of the opening proof to w.
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	if err := writeBytes(w, &n, proof.merkleRoot); err != nil {
+		return n, err
+	}
+	if err := writeByteSlices(w, &n, proof.ProofSet); err != nil {
+		return n, err
+	}
+	if err := writeUint64(w, &n, proof.numLeaves); err != nil {
+		return n, err
+	}
+	if err := writeUint64(w, &n, proof.index); err != nil {
+		return n, err
+	}
+	b := proof.ClaimedValue.Bytes()
+	m, err := w.Write(b[:])
+	n += int64(m)
+	return n, err
+}
+
+// ReadFrom reads a binary representation of an opening proof written by
+// WriteTo from r.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	var err error
+	if proof.merkleRoot, err = readBytes(r, &n); err != nil {
+		return n, err
+	}
+	if proof.ProofSet, err = readByteSlices(r, &n); err != nil {
+		return n, err
+	}
+	if proof.numLeaves, err = readUint64(r, &n); err != nil {
+		return n, err
+	}
+	if proof.index, err = readUint64(r, &n); err != nil {
+		return n, err
+	}
+	var b [fr.Bytes]byte
+	m, err := io.ReadFull(r, b[:])
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+	err = proof.ClaimedValue.SetBytesCanonical(b[:])
+	return n, err
+}
+
+func writeUint64(w io.Writer, n *int64, v uint64) error {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	m, err := w.Write(b[:])
+	*n += int64(m)
+	return err
+}
+
+func writeBytes(w io.Writer, n *int64, b []byte) error {
+	if err := writeUint64(w, n, uint64(len(b))); err != nil {
+		return err
+	}
+	m, err := w.Write(b)
+	*n += int64(m)
+	return err
+}
+
+func writeByteSlices(w io.Writer, n *int64, b [][]byte) error {
+	if err := writeUint64(w, n, uint64(len(b))); err != nil {
+		return err
+	}
+	for i := range b {
+		if err := writeBytes(w, n, b[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readUint64(r io.Reader, n *int64) (uint64, error) {
+	var b [8]byte
+	m, err := io.ReadFull(r, b[:])
+	*n += int64(m)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
+
+func readLength(r io.Reader, n *int64) (int, error) {
+	l, err := readUint64(r, n)
+	if err != nil {
+		return 0, err
+	}
+	if l > maxLength {
+		return 0, errLength
+	}
+	return int(l), nil
+}
+
+// readBytes reads a slice written by writeBytes, returning nil for an empty
+// slice.
+func readBytes(r io.Reader, n *int64) ([]byte, error) {
+	l, err := readLength(r, n)
+	if err != nil || l == 0 {
+		return nil, err
+	}
+	var res bytes.Buffer
+	m, err := io.CopyN(&res, r, int64(l))
+	*n += m
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return res.Bytes(), err
+}
+
+// readByteSlices reads slices written by writeByteSlices, returning nil for
+// an empty list.
+func readByteSlices(r io.Reader, n *int64) ([][]byte, error) {
+	l, err := readLength(r, n)
+	if err != nil || l == 0 {
+		return nil, err
+	}
+	var res [][]byte
+	for i := 0; i < l; i++ {
+		b, err := readBytes(r, n)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+// readVector reads a vector written by fr.Vector.WriteTo.
+func readVector(r io.Reader, n *int64) ([]fr.Element, error) {
+	var b [fr.Bytes]byte
+	m, err := io.ReadFull(r, b[:4])
+	*n += int64(m)
+	if err != nil {
+		return nil, err
+	}
+	l := binary.BigEndian.Uint32(b[:4])
+	res := fr.Vector{}
+	for i := uint32(0); i < l; i++ {
+		m, err := io.ReadFull(r, b[:])
+		*n += int64(m)
+		if err != nil {
+			return nil, err
+		}
+		e, err := fr.BigEndian.Element(&b)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shamir
+
+import (
+	"math/big"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitReconstruct(t *testing.T) {
+	assert := require.New(t)
+
+	var secret fr.Element
+	secret.SetRandom()
+
+	const threshold, nbShares = 3, 5
+	shares, err := Split(secret, threshold, nbShares)
+	assert.NoError(err)
+	assert.Len(shares, nbShares)
+
+	// any threshold shares reconstruct the secret
+	for _, subset := range [][]int{{0, 1, 2}, {0, 2, 4}, {4, 3, 1}, {0, 1, 2, 3, 4}} {
+		selected := make([]Share, len(subset))
+		for i, j := range subset {
+			selected[i] = shares[j]
+		}
+		reconstructed, err := Reconstruct(selected)
+		assert.NoError(err)
+		assert.True(reconstructed.Equal(&secret), "subset %v", subset)
+	}
+
+	// less than threshold shares don't
+	reconstructed, err := Reconstruct(shares[:threshold-1])
+	assert.NoError(err)
+	assert.False(reconstructed.Equal(&secret))
+
+	// threshold 1 is the secret itself
+	shares, err = Split(secret, 1, nbShares)
+	assert.NoError(err)
+	for _, s := range shares {
+		assert.True(s.Value.Equal(&secret))
+	}
+}
+
+func TestInvalidInputs(t *testing.T) {
+	assert := require.New(t)
+
+	var secret fr.Element
+	secret.SetRandom()
+
+	_, err := Split(secret, 0, 3)
+	assert.ErrorIs(err, ErrInvalidThreshold)
+	_, err = Split(secret, 4, 3)
+	assert.ErrorIs(err, ErrInvalidThreshold)
+
+	shares, err := Split(secret, 2, 3)
+	assert.NoError(err)
+
+	_, err = Reconstruct(nil)
+	assert.ErrorIs(err, ErrNotEnoughShares)
+	_, err = Reconstruct([]Share{shares[0], shares[0]})
+	assert.ErrorIs(err, ErrDuplicateIndex)
+	_, err = Reconstruct([]Share{{Index: 0, Value: secret}, shares[1]})
+	assert.ErrorIs(err, ErrInvalidIndex)
+}
+
+func TestLagrangeCoefficients(t *testing.T) {
+	assert := require.New(t)
+
+	// the coefficients at 0 of any set of points sum to 1 (interpolation of f = 1)
+	lambdas, err := LagrangeCoefficients([]uint64{2, 5, 7, 11})
+	assert.NoError(err)
+	var sum, one fr.Element
+	one.SetOne()
+	for i := range lambdas {
+		sum.Add(&sum, &lambdas[i])
+	}
+	assert.True(sum.Equal(&one))
+}
+
+func TestFeldman(t *testing.T) {
+	assert := require.New(t)
+
+	var secret fr.Element
+	secret.SetRandom()
+
+	const threshold, nbShares = 4, 7
+	shares, commitment, err := SplitFeldman(secret, threshold, nbShares)
+	assert.NoError(err)
+	assert.Len(commitment, threshold)
+
+	for _, s := range shares {
+		assert.NoError(commitment.Verify(s))
+	}
+
+	// C₀ is the public key of the secret
+	var expected curve.G1Affine
+	var b big.Int
+	expected.ScalarMultiplicationBase(secret.BigInt(&b))
+	assert.True(commitment[0].Equal(&expected))
+
+	reconstructed, err := Reconstruct(shares[nbShares-threshold:])
+	assert.NoError(err)
+	assert.True(reconstructed.Equal(&secret))
+
+	// tampered shares are rejected
+	bad := shares[2]
+	bad.Value.Add(&bad.Value, &bad.Value)
+	assert.ErrorIs(commitment.Verify(bad), ErrInvalidShare)
+	bad = shares[2]
+	bad.Index++
+	assert.ErrorIs(commitment.Verify(bad), ErrInvalidShare)
+	bad.Index = 0
+	assert.ErrorIs(commitment.Verify(bad), ErrInvalidIndex)
+}
+
+func TestPedersen(t *testing.T) {
+	assert := require.New(t)
+
+	var secret fr.Element
+	secret.SetRandom()
+
+	const threshold, nbShares = 3, 6
+	shares, commitment, err := SplitPedersen(secret, threshold, nbShares)
+	assert.NoError(err)
+	assert.Len(commitment, threshold)
+
+	selected := make([]Share, 0, threshold)
+	for _, s := range shares {
+		assert.NoError(commitment.Verify(s))
+		selected = append(selected, s.Share)
+	}
+
+	reconstructed, err := Reconstruct(selected[:threshold])
+	assert.NoError(err)
+	assert.True(reconstructed.Equal(&secret))
+
+	// tampered shares are rejected
+	bad := shares[1]
+	bad.Blinding.Add(&bad.Blinding, &bad.Blinding)
+	assert.ErrorIs(commitment.Verify(bad), ErrInvalidShare)
+	bad = shares[1]
+	bad.Value.Add(&bad.Value, &bad.Value)
+	assert.ErrorIs(commitment.Verify(bad), ErrInvalidShare)
+}
+
+func BenchmarkSplitFeldman(b *testing.B) {
+	var secret fr.Element
+	secret.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, err := SplitFeldman(secret, 67, 100)
+		assert.NoError(b, err)
+	}
+}
+
+func BenchmarkVerifyFeldman(b *testing.B) {
+	var secret fr.Element
+	secret.SetRandom()
+	shares, commitment, err := SplitFeldman(secret, 67, 100)
+	assert.NoError(b, err)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		assert.NoError(b, commitment.Verify(shares[i%len(shares)]))
+	}
+}
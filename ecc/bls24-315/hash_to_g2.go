@@ -34,6 +34,10 @@ func MapToCurve2(u fptower.E4) G2Affine {
 	c3.B0.A1.SetString("38867788984497805540592493226397363174027239449768861944710564870925669104016488974244557160817")
 	c3.B1.A0.SetString("7207770078990411004130237352587865513334954456592365258287987262730492706089979112564450405406")
 	c3.B1.A1.SetString("11314632945591044023254019576500732396578160594635551958097682961894415495755352199773541527735")
+	c4.B0.A0.SetString("16176169252023993395751206992388839525713649132817120099470634835630249074977398733992955128490")
+	c4.B0.A1.SetString("11764486728744722469637241449010065109609926642048814617796825335003817509074471806540331002539")
+	c4.B1.A0.SetString("5882243364372361234818620724505032554804963321024407308898412667501908754537235903270165501268")
+	c4.B1.A1.SetString("15384328799127713998756392664090085143336057916525372961734310053466530588789693900860432849474")
 
 	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fptower.E4
 	one.SetOne()
@@ -0,0 +1,36 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls24315
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/internal/fptower"
+)
+
+func TestMapToCurve2(t *testing.T) {
+	t.Parallel()
+
+	// when neither x1 nor x2 are valid, x3 must depend on u: it used to be the
+	// constant Z as c4 was left to 0.
+	const n = 200
+	seen := make(map[G2Affine]struct{}, n)
+	for i := 0; i < n; i++ {
+		var u fptower.E4
+		if _, err := u.B0.A0.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := u.B1.A0.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		p := MapToCurve2(u)
+		if !p.IsOnCurve() {
+			t.Fatal("point not on the curve")
+		}
+		seen[p] = struct{}{}
+	}
+	if len(seen) != n {
+		t.Fatalf("%d collisions", n-len(seen))
+	}
+}
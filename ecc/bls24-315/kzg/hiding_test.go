@@ -0,0 +1,167 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Test hiding SRS re-used across tests of the hiding KZG scheme
+var testHidingSrs *HidingSRS
+
+func init() {
+	var err error
+	testHidingSrs, err = NewHidingSRS(64, 8, new(big.Int).SetInt64(42), new(big.Int).SetInt64(1789))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestCommitHiding(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+	digest, blinding, err := CommitHiding(f, testHidingSrs.Pk, 3)
+	assert.NoError(err)
+	assert.Len(blinding, 4)
+
+	// the commitment is [f(α)]G₁ + [γr(α)]G₁
+	fCommit, err := Commit(f, testHidingSrs.Pk.ProvingKey)
+	assert.NoError(err)
+	blindingCommit, err := Commit(blinding, ProvingKey{G1: testHidingSrs.Pk.HidingG1})
+	assert.NoError(err)
+	fCommit.Add(&fCommit, &blindingCommit)
+	assert.True(digest.Equal(&fCommit))
+
+	// the commitment is randomised
+	other, _, err := CommitHiding(f, testHidingSrs.Pk, 3)
+	assert.NoError(err)
+	assert.False(digest.Equal(&other))
+
+	_, _, err = CommitHiding(f, testHidingSrs.Pk, len(testHidingSrs.Pk.HidingG1))
+	assert.ErrorIs(err, ErrInvalidHidingBound)
+	_, _, err = CommitHiding(f, testHidingSrs.Pk, -1)
+	assert.ErrorIs(err, ErrInvalidHidingBound)
+}
+
+func TestVerifySinglePointHiding(t *testing.T) {
+	assert := require.New(t)
+
+	for _, hidingBound := range []int{0, 1, 7} {
+		f := randomPolynomial(60)
+		digest, blinding, err := CommitHiding(f, testHidingSrs.Pk, hidingBound)
+		assert.NoError(err)
+
+		var point fr.Element
+		point.SetRandom()
+		proof, err := OpenHiding(f, blinding, point, testHidingSrs.Pk)
+		assert.NoError(err)
+
+		expected := eval(f, point)
+		assert.True(proof.ClaimedValue.Equal(&expected), "inconsistent claimed value")
+		assert.NoError(VerifyHiding(&digest, &proof, point, testHidingSrs.Vk))
+
+		// the unblinded proof is not valid
+		assert.Error(Verify(&digest, &proof.OpeningProof, point, testHidingSrs.Vk.VerifyingKey))
+
+		// wrong claimed value
+		wrong := proof
+		wrong.ClaimedValue.Double(&wrong.ClaimedValue)
+		assert.Error(VerifyHiding(&digest, &wrong, point, testHidingSrs.Vk))
+
+		// wrong blinding value
+		wrong = proof
+		wrong.BlindingValue.Double(&wrong.BlindingValue)
+		assert.Error(VerifyHiding(&digest, &wrong, point, testHidingSrs.Vk))
+
+		// wrong point
+		var otherPoint fr.Element
+		otherPoint.SetRandom()
+		assert.Error(VerifyHiding(&digest, &proof, otherPoint, testHidingSrs.Vk))
+	}
+}
+
+func TestBatchVerifySinglePointHiding(t *testing.T) {
+	assert := require.New(t)
+
+	const nbPolynomials = 10
+	polynomials := make([][]fr.Element, nbPolynomials)
+	blindings := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(60 - i)
+		var err error
+		digests[i], blindings[i], err = CommitHiding(polynomials[i], testHidingSrs.Pk, i%3)
+		assert.NoError(err)
+	}
+
+	var point fr.Element
+	point.SetRandom()
+	hf := sha256.New()
+	proof, err := BatchOpenSinglePointHiding(polynomials, blindings, digests, point, hf, testHidingSrs.Pk, []byte("test"))
+	assert.NoError(err)
+	for i := range polynomials {
+		expected := eval(polynomials[i], point)
+		assert.True(proof.ClaimedValues[i].Equal(&expected), "inconsistent claimed value")
+		expected = eval(blindings[i], point)
+		assert.True(proof.BlindingValues[i].Equal(&expected), "inconsistent blinding value")
+	}
+	assert.NoError(BatchVerifySinglePointHiding(digests, &proof, point, hf, testHidingSrs.Vk, []byte("test")))
+
+	// the folded proof is a hiding opening proof of the folded digest
+	foldedProof, foldedDigest, err := FoldHidingProof(digests, &proof, point, hf, []byte("test"))
+	assert.NoError(err)
+	assert.NoError(VerifyHiding(&foldedDigest, &foldedProof, point, testHidingSrs.Vk))
+
+	// wrong transcript data
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, testHidingSrs.Vk))
+
+	// wrong claimed value
+	proof.ClaimedValues[3].Double(&proof.ClaimedValues[3])
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, testHidingSrs.Vk, []byte("test")))
+	proof.ClaimedValues[3] = eval(polynomials[3], point)
+
+	// wrong blinding value
+	proof.BlindingValues[5].Double(&proof.BlindingValues[5])
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, testHidingSrs.Vk, []byte("test")))
+	proof.BlindingValues[5] = eval(blindings[5], point)
+
+	// wrong number of blindings
+	_, err = BatchOpenSinglePointHiding(polynomials, blindings[1:], digests, point, hf, testHidingSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbBlindings)
+	proof.BlindingValues = proof.BlindingValues[1:]
+	assert.ErrorIs(BatchVerifySinglePointHiding(digests, &proof, point, hf, testHidingSrs.Vk, []byte("test")), ErrInvalidNbBlindings)
+}
+
+func TestSerializationHiding(t *testing.T) {
+	t.Run("proving key round-trip", testutils.SerializationRoundTrip(&testHidingSrs.Pk))
+	t.Run("proving key raw round-trip", testutils.SerializationRoundTripRaw(&testHidingSrs.Pk))
+	t.Run("verifying key round-trip", testutils.SerializationRoundTrip(&testHidingSrs.Vk))
+	t.Run("verifying key raw round-trip", testutils.SerializationRoundTripRaw(&testHidingSrs.Vk))
+	t.Run("whole SRS round-trip", testutils.SerializationRoundTrip(testHidingSrs))
+	t.Run("whole SRS raw round-trip", testutils.SerializationRoundTripRaw(testHidingSrs))
+
+	f := randomPolynomial(10)
+	_, blinding, err := CommitHiding(f, testHidingSrs.Pk, 2)
+	require.NoError(t, err)
+	var point fr.Element
+	point.SetRandom()
+	proof, err := OpenHiding(f, blinding, point, testHidingSrs.Pk)
+	require.NoError(t, err)
+	t.Run("opening proof round-trip", testutils.SerializationRoundTrip(&proof))
+
+	batchProof := HidingBatchOpeningProof{
+		BatchOpeningProof: BatchOpeningProof{H: proof.H, ClaimedValues: []fr.Element{proof.ClaimedValue, point}},
+		BlindingValues:    []fr.Element{proof.BlindingValue, point},
+	}
+	t.Run("batch opening proof round-trip", testutils.SerializationRoundTrip(&batchProof))
+}
@@ -0,0 +1,78 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 64
+	pk, err := LagrangeProvingKey(testSrs.Pk, size)
+	assert.NoError(err)
+	domain := fft.NewDomain(size)
+
+	// p in Lagrange form and in canonical form
+	evaluations := randomPolynomial(size)
+	coefficients := make([]fr.Element, size)
+	copy(coefficients, evaluations)
+	domain.FFTInverse(coefficients, fft.DIF)
+	fft.BitReverse(coefficients)
+
+	digest, err := CommitLagrange(evaluations, pk)
+	assert.NoError(err)
+	expectedDigest, err := Commit(coefficients, testSrs.Pk)
+	assert.NoError(err)
+	assert.True(digest.Equal(&expectedDigest))
+
+	var outside, inside fr.Element
+	outside.SetRandom()
+	inside.Exp(domain.Generator, big.NewInt(37))
+	var one fr.Element
+	one.SetOne()
+	for _, point := range []fr.Element{outside, inside, one} {
+		proof, err := OpenLagrange(evaluations, point, pk)
+		assert.NoError(err)
+		expected, err := Open(coefficients, point, testSrs.Pk)
+		assert.NoError(err)
+		assert.Equal(expected, proof)
+		assert.NoError(Verify(&digest, &proof, point, testSrs.Vk))
+	}
+
+	_, err = CommitLagrange(evaluations[:size/2], pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = OpenLagrange(evaluations[:size/2], outside, pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func BenchmarkOpenLagrange(b *testing.B) {
+	const size = 1 << 16
+	srs, err := NewSRS(size, bAlpha)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pk, err := LagrangeProvingKey(srs.Pk, size)
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := randomPolynomial(size)
+	var point fr.Element
+	point.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := OpenLagrange(p, point, pk); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
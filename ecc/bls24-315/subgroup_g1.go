@@ -0,0 +1,153 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"crypto/rand"
+	"math"
+	"math/bits"
+	mrand "math/rand/v2"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// g1CofactorBound is a lower bound ℓ on the prime factors of the cofactor of
+// G1: the smallest of them, or a power of two below it when it is large, which
+// keeps the soundness bound of BatchIsInSubGroupG1 valid.
+const g1CofactorBound = 2
+
+// g1BatchSubGroupSecurity is -log₂ of the probability that
+// BatchIsInSubGroupG1 accepts a point outside the subgroup.
+const g1BatchSubGroupSecurity = 64
+
+// BatchIsInSubGroupG1 returns true if all the points are on the curve and in the
+// prime order subgroup, false otherwise. It is equivalent to, and much faster
+// than, calling IsInSubGroup on each point.
+//
+// It checks that the points are on the curve, then that random linear
+// combinations ∑ rᵢPᵢ with c-bit coefficients are in the subgroup. A point
+// outside the subgroup has a component whose order is a prime factor of the
+// cofactor, hence at least ℓ = g1CofactorBound, so that a combination is in the
+// subgroup with probability at most ⌈2ᶜ/ℓ⌉/2ᶜ. Enough combinations are checked
+// for all of them to be in the subgroup with probability at most 2⁻⁶⁴.
+//
+// It also returns false if the random coefficients can't be drawn from
+// crypto/rand.
+func BatchIsInSubGroupG1(points []G1Affine) bool {
+	var nbErrs uint64
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if !points[i].IsOnCurve() {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return false
+	}
+
+	c, nbRounds := g1BatchSubGroupParameters(len(points))
+	if len(points) <= nbRounds {
+		parallel.Execute(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				if !points[i].IsInSubGroup() {
+					atomic.AddUint64(&nbErrs, 1)
+					return
+				}
+			}
+		})
+		return nbErrs == 0
+	}
+
+	// each round is split in chunks to use all the cores
+	nbChunks := (runtime.NumCPU() + nbRounds - 1) / nbRounds
+	chunkSize := (len(points) + nbChunks - 1) / nbChunks
+	nbChunks = (len(points) + chunkSize - 1) / chunkSize
+
+	sums := make([]G1Jac, nbRounds*nbChunks)
+	seeds := make([][32]byte, len(sums))
+	for i := range seeds {
+		if _, err := rand.Read(seeds[i][:]); err != nil {
+			return false
+		}
+	}
+	parallel.Execute(len(sums), func(start, end int) {
+		buckets := make([]g1JacExtended, (1<<c)-1)
+		for i := start; i < end; i++ {
+			chunk := i % nbChunks
+			sums[i] = g1RandomCombination(points[chunk*chunkSize:min((chunk+1)*chunkSize, len(points))], buckets, c, &seeds[i])
+		}
+	}, min(len(sums), runtime.NumCPU()))
+
+	parallel.Execute(nbRounds, func(start, end int) {
+		for round := start; round < end; round++ {
+			sum := sums[round*nbChunks]
+			for chunk := 1; chunk < nbChunks; chunk++ {
+				sum.AddAssign(&sums[round*nbChunks+chunk])
+			}
+			if !sum.IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	return nbErrs == 0
+}
+
+// g1BatchSubGroupParameters returns the size c in bits of the coefficients of the
+// random linear combinations checked by BatchIsInSubGroupG1 on n points, and
+// the number of combinations.
+func g1BatchSubGroupParameters(n int) (c, nbRounds int) {
+	// the bucket reduction costs 2ᶜ⁺¹ additions, we keep it below n/2
+	c = min(max(bits.Len(uint(n))-3, 2), 16)
+
+	// a combination is in the subgroup with probability at most ⌈2ᶜ/ℓ⌉/2ᶜ
+	nbCollisions := ((uint64(1) << c) + g1CofactorBound - 1) / g1CofactorBound
+	securityPerRound := float64(c) - math.Log2(float64(nbCollisions))
+	nbRounds = int(math.Ceil(g1BatchSubGroupSecurity / securityPerRound))
+	return
+}
+
+// g1RandomCombination returns ∑ rᵢPᵢ where the rᵢ are c-bit coefficients drawn
+// from a ChaCha8 stream with the given seed. The buckets are used as scratch
+// space and must have 2ᶜ-1 elements.
+func g1RandomCombination(points []G1Affine, buckets []g1JacExtended, c int, seed *[32]byte) G1Jac {
+	for i := range buckets {
+		buckets[i].SetInfinity()
+	}
+
+	rng := mrand.NewChaCha8(*seed)
+	mask := uint64(1)<<c - 1
+	var r uint64
+	nbBits := 0
+	for i := range points {
+		if nbBits < c {
+			r = rng.Uint64()
+			nbBits = 64
+		}
+		coefficient := r & mask
+		r >>= c
+		nbBits -= c
+		if coefficient != 0 {
+			buckets[coefficient-1].addMixed(&points[i])
+		}
+	}
+
+	// ∑ k⋅buckets[k-1]
+	var runningSum, sum g1JacExtended
+	runningSum.SetInfinity()
+	sum.SetInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.add(&buckets[k])
+		sum.add(&runningSum)
+	}
+
+	var res G1Jac
+	res.fromJacExtended(&sum)
+	return res
+}
@@ -0,0 +1,158 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/mimc"
+)
+
+func generateBatch(t testing.TB, n int) ([]PublicKey, [][]byte, [][]byte) {
+	hFunc := mimc.NewMiMC()
+	pubs := make([]PublicKey, n)
+	sigs := make([][]byte, n)
+	msgs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(crand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubs[i] = privKey.PublicKey
+		var m fr.Element
+		m.SetRandom()
+		msgs[i] = m.Marshal()
+		if sigs[i], err = privKey.Sign(msgs[i], hFunc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return pubs, sigs, msgs
+}
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+
+	const n = 20
+	hFunc := mimc.NewMiMC()
+	pubs, sigs, msgs := generateBatch(t, n)
+
+	ok, err := BatchVerify(pubs, sigs, msgs, hFunc)
+	if err != nil || !ok {
+		t.Fatal("valid batch should verify")
+	}
+
+	// invalidate a few signatures, including a malformed one
+	var m fr.Element
+	m.SetRandom()
+	msgs[3] = m.Marshal()
+	pubs[11] = pubs[12]
+	sigs[17] = sigs[17][:len(sigs[17])-1]
+
+	ok, err = BatchVerify(pubs, sigs, msgs, hFunc)
+	if ok {
+		t.Fatal("invalid batch should not verify")
+	}
+	var batchErr *BatchVerifyError
+	if !errors.As(err, &batchErr) {
+		t.Fatal("expected a BatchVerifyError")
+	}
+	if fmt.Sprint(batchErr.Indices) != fmt.Sprint([]int{3, 11, 17}) {
+		t.Fatalf("wrong invalid indices %v", batchErr.Indices)
+	}
+
+	// individual verification agrees with the batch
+	for i := range pubs {
+		expected := i != 3 && i != 11 && i != 17
+		if ok, _ := pubs[i].Verify(sigs[i], msgs[i], hFunc); ok != expected {
+			t.Fatal("BatchVerify and Verify disagree")
+		}
+	}
+}
+
+func TestBatchVerifyLarge(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large batch in short mode")
+	}
+	t.Parallel()
+
+	// 2n+1 points, enough for the multi-exponentiation of BatchVerify to use
+	// 16-bit windows. The signatures are decoded and hashed once, then
+	// repeated with fresh random coefficients to keep the setup cheap.
+	const n = 1 << 17
+	hFunc := mimc.NewMiMC()
+	pubs, sigs, msgs := generateBatch(t, 16)
+	decoded := make([]batchEntry, len(pubs))
+	for i := range decoded {
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil {
+			t.Fatal(err)
+		}
+		decoded[i].A.Set(&pubs[i].A)
+		decoded[i].R.Set(&sig.R)
+		decoded[i].S.SetBytes(sig.S[:])
+		if err := computeHRAM(&decoded[i].hram, hFunc, &decoded[i].R, &decoded[i].A, msgs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries := make([]batchEntry, n)
+	subset := make([]int, n)
+	var zBytes [16]byte
+	for i := range entries {
+		entries[i].A.Set(&decoded[i%16].A)
+		entries[i].R.Set(&decoded[i%16].R)
+		entries[i].S.Set(&decoded[i%16].S)
+		entries[i].hram.Set(&decoded[i%16].hram)
+		if _, err := crand.Read(zBytes[:]); err != nil {
+			t.Fatal(err)
+		}
+		entries[i].z.SetBytes(zBytes[:])
+		subset[i] = i
+	}
+
+	if invalid := findInvalid(entries, subset); len(invalid) != 0 {
+		t.Fatalf("valid batch should verify, got invalid indices %v", invalid)
+	}
+
+	entries[n-5].hram.Set(&decoded[0].hram)
+	if invalid := findInvalid(entries, subset); fmt.Sprint(invalid) != fmt.Sprint([]int{n - 5}) {
+		t.Fatalf("wrong invalid indices %v", invalid)
+	}
+}
+
+func TestBatchVerifyEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	hFunc := mimc.NewMiMC()
+	if ok, err := BatchVerify(nil, nil, nil, hFunc); err != nil || !ok {
+		t.Fatal("empty batch should verify")
+	}
+
+	pubs, sigs, msgs := generateBatch(t, 2)
+	if _, err := BatchVerify(pubs, sigs[:1], msgs, hFunc); err != errBatchSize {
+		t.Fatal("mismatched batch sizes should be rejected")
+	}
+	if _, err := BatchVerify(pubs, sigs, msgs, nil); err != errHashNeeded {
+		t.Fatal("nil hash function should be rejected")
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	for _, n := range []int{16, 256} {
+		pubs, sigs, msgs := generateBatch(b, n)
+		hFunc := mimc.NewMiMC()
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				BatchVerify(pubs, sigs, msgs, hFunc)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointAffine) MultiExp(points []PointAffine, scalars []fr.Element, config ecc.MultiExpConfig) (*PointAffine, error) {
+	var _p PointExtended
+	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&_p)
+	return p, nil
+}
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+// with buckets in extended coordinates.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []fr.Element, config ecc.MultiExpConfig) (*PointExtended, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	if nbPoints == 0 {
+		p.setInfinity()
+		return p, nil
+	}
+	_points := make([]PointExtended, nbPoints)
+	_scalars := make([][fr.Limbs]uint64, nbPoints)
+	parallel.Execute(nbPoints, func(start, end int) {
+		for i := start; i < end; i++ {
+			_points[i].FromAffine(&points[i])
+			_scalars[i] = scalars[i].Bits()
+		}
+	})
+
+	return p.multiExp(_points, _scalars, config), nil
+}
+
+// multiExp computes ∑ scalars[i]⋅points[i] for scalars in regular (non-Montgomery) form.
+func (p *PointExtended) multiExp(points []PointExtended, scalars [][fr.Limbs]uint64, config ecc.MultiExpConfig) *PointExtended {
+	nbBits := 0
+	for i := range scalars {
+		for j := fr.Limbs - 1; j >= 0; j-- {
+			if scalars[i][j] != 0 {
+				nbBits = max(nbBits, 64*j+bits.Len64(scalars[i][j]))
+				break
+			}
+		}
+	}
+	if nbBits == 0 {
+		p.setInfinity()
+		return p
+	}
+
+	// here, we compute the best C for nbPoints
+	// cost = nbBits/c * (nbPoints + 2^{c-1})
+	nbPoints := len(points)
+	var c uint64
+	minCost := math.MaxFloat64
+	for _c := uint64(2); _c <= 16; _c++ {
+		cost := float64(nbBits+1) * float64(nbPoints+(1<<(_c-1))) / float64(_c)
+		if cost < minCost {
+			minCost = cost
+			c = _c
+		}
+	}
+
+	return p.multiExpC(points, scalars, c, nbBits, config)
+}
+
+// multiExpC computes ∑ scalars[i]⋅points[i] with c-bit windows, for scalars of at most nbBits bits.
+func (p *PointExtended) multiExpC(points []PointExtended, scalars [][fr.Limbs]uint64, c uint64, nbBits int, config ecc.MultiExpConfig) *PointExtended {
+	nbPoints := len(points)
+
+	// one extra bit so that the last window absorbs the carry of the signed digits
+	nbChunks := (nbBits + int(c)) / int(c)
+	digits := partitionScalars(scalars, c, nbChunks)
+
+	// if there are fewer chunks than tasks, we also split the points
+	nbSplits := max(1, min(config.NbTasks/nbChunks, nbPoints/1024))
+	splitSize := (nbPoints + nbSplits - 1) / nbSplits
+
+	chunks := make([]PointExtended, nbChunks*nbSplits)
+	sem := make(chan struct{}, min(config.NbTasks, runtime.NumCPU()))
+	done := make(chan struct{}, len(chunks))
+	for j := 0; j < nbChunks; j++ {
+		for s := 0; s < nbSplits; s++ {
+			start := s * splitSize
+			end := min(start+splitSize, nbPoints)
+			sem <- struct{}{}
+			go func(res *PointExtended, chunkDigits []uint32, chunkPoints []PointExtended) {
+				processChunk(res, c, chunkPoints, chunkDigits)
+				<-sem
+				done <- struct{}{}
+			}(&chunks[j*nbSplits+s], digits[j*nbPoints+start:j*nbPoints+end], points[start:end])
+		}
+	}
+	for range chunks {
+		<-done
+	}
+
+	// reduce the weighted sums of the chunks
+	var res PointExtended
+	res.setInfinity()
+	for j := nbChunks - 1; j >= 0; j-- {
+		for l := uint64(0); l < c; l++ {
+			res.Double(&res)
+		}
+		for s := 0; s < nbSplits; s++ {
+			res.Add(&res, &chunks[j*nbSplits+s])
+		}
+	}
+
+	p.Set(&res)
+	return p
+}
+
+// processChunk sets res to the weighted sum of the buckets in which the points
+// are placed according to their digit in the chunk.
+func processChunk(res *PointExtended, c uint64, points []PointExtended, digits []uint32) {
+	buckets := make([]PointExtended, 1<<(c-1))
+	for i := range buckets {
+		buckets[i].setInfinity()
+	}
+
+	var neg PointExtended
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		// if msbWindow bit is set, we need to subtract
+		if digit&1 == 0 {
+			// add
+			buckets[(digit>>1)-1].Add(&buckets[(digit>>1)-1], &points[i])
+		} else {
+			// sub
+			neg.Neg(&points[i])
+			buckets[digit>>1].Add(&buckets[digit>>1], &neg)
+		}
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var runningSum, total PointExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.Add(&runningSum, &buckets[k])
+		total.Add(&total, &runningSum)
+	}
+
+	res.Set(&total)
+}
+
+// partitionScalars computes, for each chunk, the signed c-bit digits of the scalars.
+// If a digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and
+// subtract 2^{c} to the current digit, making it negative.
+// The last chunk is never borrowed from, so its digit can reach 2^{c-1}.
+// A digit d is encoded as d<<1 if positive and ((-d-1)<<1)+1 if negative, which
+// takes c+1 bits, and the digits of the chunk j are stored in
+// digits[j*len(scalars):(j+1)*len(scalars)].
+func partitionScalars(scalars [][fr.Limbs]uint64, c uint64, nbChunks int) []uint32 {
+	digits := make([]uint32, len(scalars)*nbChunks)
+
+	mask := uint64((1 << c) - 1)  // low c bits are 1
+	maxDigit := int(1<<(c-1)) - 1 // max value (inclusive) we want for our digits
+
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			scalar := scalars[i]
+			carry := 0
+			for chunk := 0; chunk < nbChunks; chunk++ {
+				// digit = value of the c-bit window
+				jc := uint64(chunk) * c
+				index, shift := jc/64, jc%64
+				digit := carry
+				carry = 0
+				if index < fr.Limbs {
+					w := scalar[index] >> shift
+					if shift+c > 64 && index+1 < fr.Limbs {
+						w |= scalar[index+1] << (64 - shift)
+					}
+					digit += int(w & mask)
+				}
+
+				if digit > maxDigit && chunk != nbChunks-1 {
+					digit -= (1 << c)
+					carry = 1
+				}
+
+				// if digit is zero, no impact on result
+				if digit == 0 {
+					continue
+				}
+
+				var bits uint32
+				if digit > 0 {
+					bits = uint32(digit) << 1
+				} else {
+					bits = (uint32(-digit-1) << 1) + 1
+				}
+				digits[chunk*len(scalars)+i] = bits
+			}
+		}
+	})
+
+	return digits
+}
@@ -0,0 +1,175 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+var ciphersuites = []*Ciphersuite{MinPkBasic, MinPkAug, MinPkPop, MinSigBasic, MinSigAug, MinSigPop}
+
+func TestBLS(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 5
+	} else {
+		parameters.MinSuccessfulTests = 10
+	}
+	properties := gopter.NewProperties(parameters)
+
+	for _, cs := range ciphersuites {
+		cs := cs
+		properties.Property("[BLS24-317] test the signing and verification "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				msg := []byte("testing BLS")
+				hFunc := sha256.New()
+				sig, _ := privKey.Sign(msg, hFunc)
+				flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS24-317] test the signing and verification (pre-hashed) "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				msg := []byte("testing BLS")
+				sig, _ := privKey.Sign(msg, nil)
+				flag, _ := publicKey.Verify(sig, msg, nil)
+
+				return flag
+			},
+		))
+
+		properties.Property("[BLS24-317] wrong message should not verify "+cs.ID(), prop.ForAll(
+			func() bool {
+
+				privKey, _ := cs.GenerateKey(rand.Reader)
+				publicKey := privKey.Public()
+
+				sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+				flag, _ := publicKey.Verify(sig, []byte("testing BLS!"), nil)
+
+				return !flag
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, err := cs.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := privKey.PopProve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := privKey.PublicKey.PopVerify(proof); err != nil || !ok {
+			t.Fatal("valid proof of possession should verify")
+		}
+
+		// a proof of possession is not a signature of the public key
+		if ok, _ := privKey.PublicKey.Verify(proof, privKey.PublicKey.Bytes(), nil); ok {
+			t.Fatal("proof of possession should not verify as a signature")
+		}
+
+		other, _ := cs.GenerateKey(rand.Reader)
+		if ok, _ := other.PublicKey.PopVerify(proof); ok {
+			t.Fatal("proof of possession should not verify for another key")
+		}
+	}
+
+	for _, cs := range []*Ciphersuite{MinPkBasic, MinPkAug, MinSigBasic, MinSigAug} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		if _, err := privKey.PopProve(); err != ErrNotProofOfPossession {
+			t.Fatal("proof of possession should not be available in " + cs.ID())
+		}
+	}
+}
+
+func TestKeyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		sig, _ := privKey.Sign([]byte("testing BLS"), nil)
+
+		// the identity is not a valid public key
+		pk := cs.NewPublicKey()
+		if _, err := pk.Verify(sig, []byte("testing BLS"), nil); err != ErrInvalidPublicKey {
+			t.Fatal("identity public key should be rejected in " + cs.ID())
+		}
+	}
+}
+
+func TestKeyGen(t *testing.T) {
+	t.Parallel()
+
+	if _, err := MinPkPop.KeyGen(make([]byte, 31), nil); err != ErrShortIKM {
+		t.Fatal("short IKM should be rejected")
+	}
+
+	ikm := make([]byte, 32)
+	k1, _ := MinPkPop.KeyGen(ikm, nil)
+	k2, _ := MinPkPop.KeyGen(ikm, []byte("key info"))
+	if k1.PublicKey.Equal(&k2.PublicKey) {
+		t.Fatal("key info should change the derived key")
+	}
+	k3, _ := MinPkPop.KeyGen(ikm, nil)
+	if !k1.PublicKey.Equal(&k3.PublicKey) {
+		t.Fatal("KeyGen should be deterministic")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkSignBLS(b *testing.B) {
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		msg := []byte("benchmarking BLS sign()")
+		b.Run(cs.ID(), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				privKey.Sign(msg, nil)
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyBLS(b *testing.B) {
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		privKey, _ := cs.GenerateKey(rand.Reader)
+		msg := []byte("benchmarking BLS verify()")
+		sig, _ := privKey.Sign(msg, nil)
+		b.Run(cs.ID(), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				privKey.PublicKey.Verify(sig, msg, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/subtle"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// sizePublicKey returns the size of the compressed public key.
+func (pk *PublicKey) sizePublicKey() int {
+	if pk.ciphersuite().variant == MinPk {
+		return sizeG1
+	}
+	return sizeG2
+}
+
+// Bytes returns the binary representation of the public key,
+// that is the compressed encoding of the point in G1 (MinPk) or G2 (MinSig).
+func (pk *PublicKey) Bytes() []byte {
+	if pk.ciphersuite().variant == MinPk {
+		pkBin := pk.g1.Bytes()
+		return pkBin[:]
+	}
+	pkBin := pk.g2.Bytes()
+	return pkBin[:]
+}
+
+// SetBytes sets pk from its compressed binary representation in buf, checking
+// that the point is in the prime order subgroup.
+// The ciphersuite of pk is preserved (MinPkPop if unset).
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	size := pk.sizePublicKey()
+	if len(buf) < size {
+		return 0, io.ErrShortBuffer
+	}
+	if pk.ciphersuite().variant == MinPk {
+		return pk.g1.SetBytes(buf[:size])
+	}
+	return pk.g2.SetBytes(buf[:size])
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+func (privKey *PrivateKey) Bytes() []byte {
+	pubkBin := privKey.PublicKey.Bytes()
+	res := make([]byte, len(pubkBin)+sizeFr)
+	subtle.ConstantTimeCopy(1, res[:len(pubkBin)], pubkBin)
+	subtle.ConstantTimeCopy(1, res[len(pubkBin):], privKey.scalar[:])
+	return res
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as  publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+// The ciphersuite of privKey.PublicKey is preserved (MinPkPop if unset).
+// The public key is recomputed from the scalar and must match publicKey.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	size := privKey.PublicKey.sizePublicKey()
+	if len(buf) < size+sizeFr {
+		return 0, io.ErrShortBuffer
+	}
+	pk := privKey.PublicKey
+	n, err := pk.SetBytes(buf[:size])
+	if err != nil {
+		return 0, err
+	}
+	sk := new(big.Int).SetBytes(buf[n : n+sizeFr])
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return 0, ErrInvalidPrivateKey
+	}
+	expected := pk
+	expected.fromScalar(sk)
+	if !expected.Equal(&pk) {
+		return 0, ErrInvalidPrivateKey
+	}
+	privKey.PublicKey = pk
+	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[n:n+sizeFr])
+	n += sizeFr
+	return n, nil
+}
+
+// signatureG1 decodes a compressed signature in G1, checking subgroup membership.
+func signatureG1(sigBin []byte) (bls24317.G1Affine, error) {
+	var sig bls24317.G1Affine
+	if len(sigBin) != sizeG1 {
+		return sig, ErrInvalidSignature
+	}
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return sig, err
+	}
+	return sig, nil
+}
+
+// signatureG2 decodes a compressed signature in G2, checking subgroup membership.
+func signatureG2(sigBin []byte) (bls24317.G2Affine, error) {
+	var sig bls24317.G2Affine
+	if len(sigBin) != sizeG2 {
+		return sig, ErrInvalidSignature
+	}
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return sig, err
+	}
+	return sig, nil
+}
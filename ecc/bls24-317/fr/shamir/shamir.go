@@ -0,0 +1,159 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package shamir
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+var (
+	ErrInvalidThreshold = errors.New("threshold must be in [1, number of shares]")
+	ErrNotEnoughShares  = errors.New("not enough shares")
+	ErrInvalidIndex     = errors.New("share index must be non-zero")
+	ErrDuplicateIndex   = errors.New("duplicate share index")
+	ErrInvalidShare     = errors.New("share does not match the commitment")
+)
+
+// Share is the evaluation of the sharing polynomial at Index.
+type Share struct {
+	Index uint64 // participant index, starting at 1
+	Value fr.Element
+}
+
+// Split splits secret into nbShares shares, such that any threshold of them
+// allow to reconstruct the secret.
+//
+// The shares are the evaluations at 1, …, nbShares of a random polynomial of degree
+// threshold-1 whose constant coefficient is secret.
+func Split(secret fr.Element, threshold, nbShares int) ([]Share, error) {
+	coefficients, err := randomPolynomial(secret, threshold, nbShares)
+	if err != nil {
+		return nil, err
+	}
+	return evaluateShares(coefficients, nbShares), nil
+}
+
+// Reconstruct recovers the secret from the shares by Lagrange interpolation at 0.
+//
+// It doesn't know the threshold: all the shares are used, and if they are less
+// than the threshold, the result is not the secret.
+func Reconstruct(shares []Share) (fr.Element, error) {
+	var secret fr.Element
+	if len(shares) == 0 {
+		return secret, ErrNotEnoughShares
+	}
+	indices := make([]uint64, len(shares))
+	for i := range shares {
+		indices[i] = shares[i].Index
+	}
+	lambdas, err := LagrangeCoefficients(indices)
+	if err != nil {
+		return secret, err
+	}
+	var tmp fr.Element
+	for i := range shares {
+		tmp.Mul(&lambdas[i], &shares[i].Value)
+		secret.Add(&secret, &tmp)
+	}
+	return secret, nil
+}
+
+// LagrangeCoefficients returns the Lagrange coefficients λᵢ at 0 of the given
+// indices, such that f(0) = ∑ᵢ λᵢ⋅f(indices[i]) for any polynomial f of degree
+// less than len(indices).
+//
+// Threshold protocols use them to combine partial results, e.g. partial
+// signatures [f(i)]H(m) into [f(0)]H(m).
+func LagrangeCoefficients(indices []uint64) ([]fr.Element, error) {
+	if len(indices) == 0 {
+		return nil, ErrNotEnoughShares
+	}
+	seen := make(map[uint64]struct{}, len(indices))
+	x := make([]fr.Element, len(indices))
+	for i, idx := range indices {
+		if idx == 0 {
+			return nil, ErrInvalidIndex
+		}
+		if _, ok := seen[idx]; ok {
+			return nil, ErrDuplicateIndex
+		}
+		seen[idx] = struct{}{}
+		x[i].SetUint64(idx)
+	}
+
+	// λᵢ = ∏_{j≠i} xⱼ / (xⱼ - xᵢ)
+	num := make([]fr.Element, len(x))
+	den := make([]fr.Element, len(x))
+	var tmp fr.Element
+	for i := range x {
+		num[i].SetOne()
+		den[i].SetOne()
+		for j := range x {
+			if j == i {
+				continue
+			}
+			num[i].Mul(&num[i], &x[j])
+			tmp.Sub(&x[j], &x[i])
+			den[i].Mul(&den[i], &tmp)
+		}
+	}
+	den = fr.BatchInvert(den)
+	for i := range num {
+		num[i].Mul(&num[i], &den[i])
+	}
+	return num, nil
+}
+
+// randomPolynomial returns the coefficients of a random polynomial of degree
+// threshold-1 whose constant coefficient is secret.
+func randomPolynomial(secret fr.Element, threshold, nbShares int) ([]fr.Element, error) {
+	if threshold < 1 || threshold > nbShares {
+		return nil, ErrInvalidThreshold
+	}
+	coefficients := make([]fr.Element, threshold)
+	coefficients[0] = secret
+	for i := 1; i < threshold; i++ {
+		if _, err := coefficients[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return coefficients, nil
+}
+
+// evaluateShares evaluates the polynomial at 1, …, nbShares.
+func evaluateShares(coefficients []fr.Element, nbShares int) []Share {
+	shares := make([]Share, nbShares)
+	for i := range shares {
+		shares[i].Index = uint64(i + 1)
+		shares[i].Value = evaluate(coefficients, shares[i].Index)
+	}
+	return shares
+}
+
+// evaluate evaluates the polynomial at x using Horner's method.
+func evaluate(coefficients []fr.Element, x uint64) fr.Element {
+	var res, _x fr.Element
+	_x.SetUint64(x)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		res.Mul(&res, &_x).Add(&res, &coefficients[i])
+	}
+	return res
+}
+
+// powers returns 1, x, …, x^{n-1}.
+func powers(x uint64, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	if n > 1 {
+		res[1].SetUint64(x)
+	}
+	for i := 2; i < n; i++ {
+		res[i].Mul(&res[i-1], &res[1])
+	}
+	return res
+}
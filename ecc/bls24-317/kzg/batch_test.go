@@ -0,0 +1,124 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchVerifyMultiPointsReport(t *testing.T) {
+	assert := require.New(t)
+
+	const nbProofs = 13
+	digests := make([]Digest, nbProofs)
+	proofs := make([]OpeningProof, nbProofs)
+	points := make([]fr.Element, nbProofs)
+	for i := range digests {
+		p := randomPolynomial(20)
+		var err error
+		digests[i], err = Commit(p, testSrs.Pk)
+		assert.NoError(err)
+		points[i].SetRandom()
+		proofs[i], err = Open(p, points[i], testSrs.Pk)
+		assert.NoError(err)
+	}
+	assert.NoError(BatchVerifyMultiPointsReport(digests, proofs, points, testSrs.Vk))
+
+	for _, wrong := range [][]int{{0}, {12}, {3, 4}, {1, 6, 7, 11}, {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}} {
+		tampered := make([]OpeningProof, nbProofs)
+		copy(tampered, proofs)
+		for _, i := range wrong {
+			tampered[i].ClaimedValue.Double(&tampered[i].ClaimedValue)
+		}
+		err := BatchVerifyMultiPointsReport(digests, tampered, points, testSrs.Vk)
+		assert.ErrorIs(err, ErrVerifyOpeningProof)
+		var batchErr *BatchVerifyError
+		assert.ErrorAs(err, &batchErr)
+		assert.Equal(wrong, batchErr.Indices)
+	}
+
+	// quotient set to infinity
+	tampered := make([]OpeningProof, nbProofs)
+	copy(tampered, proofs)
+	tampered[5].H.X.SetZero()
+	tampered[5].H.Y.SetZero()
+	var batchErr *BatchVerifyError
+	assert.ErrorAs(BatchVerifyMultiPointsReport(digests, tampered, points, testSrs.Vk), &batchErr)
+	assert.Equal([]int{5}, batchErr.Indices)
+
+	// single proof
+	tampered[5] = proofs[5]
+	tampered[0].ClaimedValue.SetRandom()
+	assert.ErrorAs(BatchVerifyMultiPointsReport(digests[:1], tampered[:1], points[:1], testSrs.Vk), &batchErr)
+	assert.Equal([]int{0}, batchErr.Indices)
+
+	assert.ErrorIs(BatchVerifyMultiPointsReport(digests[1:], proofs, points, testSrs.Vk), ErrInvalidNbDigests)
+	assert.ErrorIs(BatchVerifyMultiPointsReport(nil, nil, nil, testSrs.Vk), ErrZeroNbDigests)
+}
+
+func TestBatchVerifySinglePointsReport(t *testing.T) {
+	assert := require.New(t)
+
+	const nbBatches, nbPolynomials = 4, 3
+	digests := make([][]Digest, nbBatches)
+	proofs := make([]BatchOpeningProof, nbBatches)
+	points := make([]fr.Element, nbBatches)
+	data := make([][][]byte, nbBatches)
+	hf := sha256.New()
+	for i := range digests {
+		polynomials := make([][]fr.Element, nbPolynomials)
+		digests[i] = make([]Digest, nbPolynomials)
+		for j := range polynomials {
+			polynomials[j] = randomPolynomial(20)
+			var err error
+			digests[i][j], err = Commit(polynomials[j], testSrs.Pk)
+			assert.NoError(err)
+		}
+		points[i].SetRandom()
+		data[i] = [][]byte{{byte(i)}}
+		var err error
+		proofs[i], err = BatchOpenSinglePoint(polynomials, digests[i], points[i], hf, testSrs.Pk, data[i]...)
+		assert.NoError(err)
+	}
+	assert.NoError(BatchVerifySinglePointsReport(digests, proofs, points, hf, testSrs.Vk, data...))
+
+	// wrong claimed value in batch 2
+	proofs[2].ClaimedValues[1].SetRandom()
+	err := BatchVerifySinglePointsReport(digests, proofs, points, hf, testSrs.Vk, data...)
+	var batchErr *BatchVerifyError
+	assert.ErrorAs(err, &batchErr)
+	assert.Equal([]int{2}, batchErr.Indices)
+
+	// wrong transcript data in batch 0
+	data[0] = [][]byte{{42}}
+	assert.ErrorAs(BatchVerifySinglePointsReport(digests, proofs, points, hf, testSrs.Vk, data...), &batchErr)
+	assert.Equal([]int{0, 2}, batchErr.Indices)
+
+	assert.ErrorIs(BatchVerifySinglePointsReport(digests, proofs, points, hf, testSrs.Vk, data[1:]...), ErrInvalidNbDigests)
+}
+
+func BenchmarkBatchVerifyMultiPointsReport(b *testing.B) {
+	const nbProofs = 64
+	digests := make([]Digest, nbProofs)
+	proofs := make([]OpeningProof, nbProofs)
+	points := make([]fr.Element, nbProofs)
+	for i := range digests {
+		p := randomPolynomial(20)
+		digests[i], _ = Commit(p, testSrs.Pk)
+		points[i].SetRandom()
+		proofs[i], _ = Open(p, points[i], testSrs.Pk)
+	}
+	proofs[17].ClaimedValue.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchVerifyMultiPointsReport(digests, proofs, points, testSrs.Vk)
+	}
+}
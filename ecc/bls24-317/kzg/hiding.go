@@ -0,0 +1,305 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidHidingBound = errors.New("invalid hiding bound (negative or larger than the hiding part of the SRS)")
+	ErrInvalidNbBlindings = errors.New("number of blinding polynomials is not the same as the number of polynomials")
+)
+
+// HidingProvingKey used to create or open hiding commitments. A hiding
+// commitment to f is [f(α)]G₁ + [γr(α)]G₁ where r is a random blinding
+// polynomial, following Kate et al. and Marlin (https://eprint.iacr.org/2019/1047).
+type HidingProvingKey struct {
+	ProvingKey
+	HidingG1 []bls24317.G1Affine // [γ]G₁, [γα]G₁, [γα²]G₁, ...
+}
+
+// HidingVerifyingKey used to verify hiding opening proofs
+type HidingVerifyingKey struct {
+	VerifyingKey
+	HidingG1 bls24317.G1Affine // [γ]G₁
+}
+
+// HidingSRS must be computed through MPC and comprises the HidingProvingKey and
+// the HidingVerifyingKey
+type HidingSRS struct {
+	Pk HidingProvingKey
+	Vk HidingVerifyingKey
+}
+
+// HidingOpeningProof hiding KZG proof for opening at a single point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingOpeningProof struct {
+	// OpeningProof where H is the commitment to the quotients (f - f(z))/(x-z)
+	// and (r - r(z))/(x-z), the latter using [γαⁱ]G₁
+	OpeningProof
+
+	// BlindingValue evaluation of the blinding polynomial r at the point
+	BlindingValue fr.Element
+}
+
+// HidingBatchOpeningProof hiding opening proof for many polynomials at the same point
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingBatchOpeningProof struct {
+	// BatchOpeningProof where H also commits to the folded quotients of the
+	// blinding polynomials using [γαⁱ]G₁
+	BatchOpeningProof
+
+	// BlindingValues evaluations of the blinding polynomials at the point
+	BlindingValues []fr.Element
+}
+
+// NewHidingSRS returns a new hiding SRS using alpha and gamma as randomness
+// source. The hiding part of the proving key has hidingSize elements, so that
+// it can commit to blinding polynomials of hidingSize coefficients at most.
+//
+// In production, a SRS generated through MPC should be used.
+func NewHidingSRS(size, hidingSize uint64, bAlpha, bGamma *big.Int) (*HidingSRS, error) {
+	if hidingSize == 0 || hidingSize > size {
+		return nil, ErrInvalidHidingBound
+	}
+	srs, err := NewSRS(size, bAlpha)
+	if err != nil {
+		return nil, err
+	}
+
+	var res HidingSRS
+	res.Pk.ProvingKey = srs.Pk
+	res.Vk.VerifyingKey = srs.Vk
+
+	// [γαⁱ]G₁ = γ[αⁱ]G₁
+	var gamma fr.Element
+	var gammaBigInt big.Int
+	gamma.SetBigInt(bGamma).BigInt(&gammaBigInt)
+	res.Pk.HidingG1 = make([]bls24317.G1Affine, hidingSize)
+	parallel.Execute(int(hidingSize), func(start, end int) {
+		for i := start; i < end; i++ {
+			res.Pk.HidingG1[i].ScalarMultiplication(&srs.Pk.G1[i], &gammaBigInt)
+		}
+	})
+	res.Vk.HidingG1 = res.Pk.HidingG1[0]
+
+	return &res, nil
+}
+
+// CommitHiding commits to a polynomial, blinded with a random polynomial of
+// degree hidingBound which is returned with the commitment. The commitment
+// stays hiding after at most hidingBound openings.
+func CommitHiding(p []fr.Element, pk HidingProvingKey, hidingBound int, nbTasks ...int) (Digest, []fr.Element, error) {
+	if hidingBound < 0 || hidingBound >= len(pk.HidingG1) {
+		return Digest{}, nil, ErrInvalidHidingBound
+	}
+	blinding := make([]fr.Element, hidingBound+1)
+	for i := range blinding {
+		if _, err := blinding[i].SetRandom(); err != nil {
+			return Digest{}, nil, err
+		}
+	}
+
+	res, err := commitBlinded(p, blinding, pk, nbTasks...)
+	if err != nil {
+		return Digest{}, nil, err
+	}
+	return res, blinding, nil
+}
+
+// OpenHiding computes an opening proof at point of the polynomial p committed
+// with the blinding polynomial blinding.
+func OpenHiding(p, blinding []fr.Element, point fr.Element, pk HidingProvingKey) (HidingOpeningProof, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return HidingOpeningProof{}, ErrInvalidPolynomialSize
+	}
+	if len(blinding) == 0 || len(blinding) > len(pk.HidingG1) {
+		return HidingOpeningProof{}, ErrInvalidHidingBound
+	}
+
+	var res HidingOpeningProof
+	res.ClaimedValue = eval(p, point)
+	res.BlindingValue = eval(blinding, point)
+
+	var err error
+	res.H, err = blindedQuotient(p, blinding, res.ClaimedValue, res.BlindingValue, point, pk)
+	if err != nil {
+		return HidingOpeningProof{}, err
+	}
+	return res, nil
+}
+
+// VerifyHiding verifies a hiding KZG opening proof at a single point
+func VerifyHiding(commitment *Digest, proof *HidingOpeningProof, point fr.Element, vk HidingVerifyingKey) error {
+
+	// removing [γr(a)]G₁ from the commitment leaves a plain opening of
+	// f + γ(r - r(a)) at a
+	var blindingBigInt big.Int
+	var blindingCommit, unblinded Digest
+	proof.BlindingValue.BigInt(&blindingBigInt)
+	blindingCommit.ScalarMultiplication(&vk.HidingG1, &blindingBigInt)
+	unblinded.Sub(commitment, &blindingCommit)
+
+	return Verify(&unblinded, &proof.OpeningProof, point, vk.VerifyingKey)
+}
+
+// BatchOpenSinglePointHiding creates a hiding batch opening proof at point of a
+// list of polynomials, blinded with the corresponding blinding polynomials.
+// The polynomials are folded as in BatchOpenSinglePoint, the blinding values
+// being appended to dataTranscript to derive the challenge used for folding.
+func BatchOpenSinglePointHiding(polynomials, blindings [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk HidingProvingKey, dataTranscript ...[]byte) (HidingBatchOpeningProof, error) {
+	if len(blindings) != len(polynomials) {
+		return HidingBatchOpeningProof{}, ErrInvalidNbBlindings
+	}
+	largestBlinding := 0
+	for _, b := range blindings {
+		if len(b) == 0 || len(b) > len(pk.HidingG1) {
+			return HidingBatchOpeningProof{}, ErrInvalidHidingBound
+		}
+		largestBlinding = max(largestBlinding, len(b))
+	}
+
+	var res HidingBatchOpeningProof
+	res.BlindingValues = make([]fr.Element, len(blindings))
+	for i := range blindings {
+		res.BlindingValues[i] = eval(blindings[i], point)
+	}
+	dataTranscript = appendBlindingValues(dataTranscript, res.BlindingValues)
+
+	var err error
+	res.BatchOpeningProof, err = BatchOpenSinglePoint(polynomials, digests, point, hf, pk.ProvingKey, dataTranscript...)
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+
+	// fold the blinding polynomials with the same challenge
+	gamma, err := deriveGamma(point, digests, res.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+	foldedBlindings := make([]fr.Element, largestBlinding)
+	var foldedBlindingValue fr.Element
+	for i := len(blindings) - 1; i >= 0; i-- {
+		for j := range foldedBlindings {
+			foldedBlindings[j].Mul(&foldedBlindings[j], &gamma)
+		}
+		for j := range blindings[i] {
+			foldedBlindings[j].Add(&foldedBlindings[j], &blindings[i][j])
+		}
+		foldedBlindingValue.Mul(&foldedBlindingValue, &gamma).Add(&foldedBlindingValue, &res.BlindingValues[i])
+	}
+
+	h := dividePolyByXminusA(foldedBlindings, foldedBlindingValue, point)
+	if len(h) == 0 {
+		return res, nil
+	}
+	hCommit, err := Commit(h, ProvingKey{G1: pk.HidingG1})
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+	res.H.Add(&res.H, &hCommit)
+
+	return res, nil
+}
+
+// FoldHidingProof fold the digests and the proofs in batchOpeningProof using
+// Fiat Shamir to obtain a hiding opening proof at a single point.
+func FoldHidingProof(digests []Digest, batchOpeningProof *HidingBatchOpeningProof, point fr.Element, hf hash.Hash, dataTranscript ...[]byte) (HidingOpeningProof, Digest, error) {
+	if len(batchOpeningProof.BlindingValues) != len(batchOpeningProof.ClaimedValues) {
+		return HidingOpeningProof{}, Digest{}, ErrInvalidNbBlindings
+	}
+	dataTranscript = appendBlindingValues(dataTranscript, batchOpeningProof.BlindingValues)
+
+	foldedProof, foldedDigest, err := FoldProof(digests, &batchOpeningProof.BatchOpeningProof, point, hf, dataTranscript...)
+	if err != nil {
+		return HidingOpeningProof{}, Digest{}, err
+	}
+
+	gamma, err := deriveGamma(point, digests, batchOpeningProof.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return HidingOpeningProof{}, Digest{}, err
+	}
+	res := HidingOpeningProof{OpeningProof: foldedProof}
+	for i := len(batchOpeningProof.BlindingValues) - 1; i >= 0; i-- {
+		res.BlindingValue.Mul(&res.BlindingValue, &gamma).Add(&res.BlindingValue, &batchOpeningProof.BlindingValues[i])
+	}
+
+	return res, foldedDigest, nil
+}
+
+// BatchVerifySinglePointHiding verifies a hiding batched opening proof at a
+// single point of a list of polynomials.
+func BatchVerifySinglePointHiding(digests []Digest, batchOpeningProof *HidingBatchOpeningProof, point fr.Element, hf hash.Hash, vk HidingVerifyingKey, dataTranscript ...[]byte) error {
+
+	// fold the proof
+	foldedProof, foldedDigest, err := FoldHidingProof(digests, batchOpeningProof, point, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+
+	// verify the foldedProof against the foldedDigest
+	return VerifyHiding(&foldedDigest, &foldedProof, point, vk)
+}
+
+// commitBlinded returns [p(α)]G₁ + [γblinding(α)]G₁.
+func commitBlinded(p, blinding []fr.Element, pk HidingProvingKey, nbTasks ...int) (Digest, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	points := make([]bls24317.G1Affine, 0, len(p)+len(blinding))
+	points = append(points, pk.G1[:len(p)]...)
+	points = append(points, pk.HidingG1[:len(blinding)]...)
+	scalars := make([]fr.Element, 0, len(p)+len(blinding))
+	scalars = append(scalars, p...)
+	scalars = append(scalars, blinding...)
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	var res Digest
+	if _, err := res.MultiExp(points, scalars, config); err != nil {
+		return Digest{}, err
+	}
+	return res, nil
+}
+
+// blindedQuotient returns the commitment to (p - p(a))/(x-a), blinded with
+// (blinding - blinding(a))/(x-a).
+func blindedQuotient(p, blinding []fr.Element, pa, blindingA, a fr.Element, pk HidingProvingKey) (Digest, error) {
+	_p := make([]fr.Element, len(p))
+	copy(_p, p)
+	h := dividePolyByXminusA(_p, pa, a)
+	_blinding := make([]fr.Element, len(blinding))
+	copy(_blinding, blinding)
+	hBlinding := dividePolyByXminusA(_blinding, blindingA, a)
+
+	if len(h) == 0 {
+		// p is a constant
+		h = make([]fr.Element, 1)
+	}
+	return commitBlinded(h, hBlinding, pk)
+}
+
+// appendBlindingValues returns dataTranscript followed by the blinding values.
+func appendBlindingValues(dataTranscript [][]byte, blindingValues []fr.Element) [][]byte {
+	res := make([][]byte, 0, len(dataTranscript)+len(blindingValues))
+	res = append(res, dataTranscript...)
+	for i := range blindingValues {
+		res = append(res, blindingValues[i].Marshal())
+	}
+	return res
+}
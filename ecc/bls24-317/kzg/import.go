@@ -0,0 +1,113 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+var (
+	ErrInvalidSRSSize      = errors.New("requested SRS size is larger than the number of powers of τ")
+	ErrSRSNotInSubgroup    = errors.New("SRS point is not in the prime order subgroup")
+	ErrSRSInvalidGenerator = errors.New("SRS does not start with the curve generators")
+	ErrSRSInconsistent     = errors.New("SRS points are not consistent powers of τ")
+)
+
+// NewSRSFromPowers returns the SRS of the given size built from the powers of
+// τ of an external ceremony: g1 = [G₁, [τ]G₁, [τ²]G₁, …] and g2 = [G₂, [τ]G₂].
+// Extra powers in g1 are dropped.
+//
+// It checks that the points are in the prime order subgroups, start with the
+// curve generators, and are powers of the same τ, that is
+// e([τⁱ⁺¹]G₁, G₂) = e([τⁱ]G₁, [τ]G₂) for all i. The latter is batched with a
+// random linear combination.
+func NewSRSFromPowers(g1 []bls24317.G1Affine, g2 [2]bls24317.G2Affine, size uint64) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	if uint64(len(g1)) < size {
+		return nil, ErrInvalidSRSSize
+	}
+
+	var srs SRS
+	srs.Pk.G1 = make([]bls24317.G1Affine, size)
+	copy(srs.Pk.G1, g1)
+	srs.Vk.G1 = srs.Pk.G1[0]
+	srs.Vk.G2 = g2
+
+	if err := srs.check(); err != nil {
+		return nil, err
+	}
+
+	srs.Vk.Lines[0] = bls24317.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bls24317.PrecomputeLines(srs.Vk.G2[1])
+	return &srs, nil
+}
+
+// LagrangeProvingKey returns the ProvingKey in Lagrange form over the domain of
+// the given size, that is [L₀(τ)]G₁, …, [Lₙ₋₁(τ)]G₁ in natural order, to commit
+// to polynomials given by their evaluations on the domain. size must be a power
+// of 2 at most len(pk.G1).
+func LagrangeProvingKey(pk ProvingKey, size uint64) (ProvingKey, error) {
+	if size == 0 || bits.OnesCount64(size) != 1 {
+		return ProvingKey{}, ErrInvalidDomainSize
+	}
+	if uint64(len(pk.G1)) < size {
+		return ProvingKey{}, ErrInvalidSRSSize
+	}
+	lagrange, err := ToLagrangeG1(pk.G1[:size])
+	if err != nil {
+		return ProvingKey{}, err
+	}
+	return ProvingKey{G1: lagrange}, nil
+}
+
+// check checks the SRS points, see [NewSRSFromPowers].
+func (srs *SRS) check() error {
+	_, _, g1, g2 := bls24317.Generators()
+	if !srs.Pk.G1[0].Equal(&g1) || !srs.Vk.G2[0].Equal(&g2) {
+		return ErrSRSInvalidGenerator
+	}
+
+	// subgroup checks
+	if !srs.Vk.G2[1].IsInSubGroup() || !bls24317.BatchIsInSubGroupG1(srs.Pk.G1) {
+		return ErrSRSNotInSubgroup
+	}
+
+	// e(∑ rⁱ[τⁱ⁺¹]G₁, G₂) = e(∑ rⁱ[τⁱ]G₁, [τ]G₂)
+	var r fr.Element
+	if _, err := r.SetRandom(); err != nil {
+		return err
+	}
+	n := len(srs.Pk.G1) - 1
+	rPowers := make([]fr.Element, n)
+	rPowers[0].SetOne()
+	for i := 1; i < n; i++ {
+		rPowers[i].Mul(&rPowers[i-1], &r)
+	}
+	var lhs, rhs bls24317.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err := lhs.MultiExp(srs.Pk.G1[1:], rPowers, config); err != nil {
+		return err
+	}
+	if _, err := rhs.MultiExp(srs.Pk.G1[:n], rPowers, config); err != nil {
+		return err
+	}
+	rhs.Neg(&rhs)
+	ok, err := bls24317.PairingCheck([]bls24317.G1Affine{lhs, rhs}, srs.Vk.G2[:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSRSInconsistent
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/unsafe"
+)
+
+var ErrInvalidChunkSize = errors.New("chunk size must be positive")
+
+// DumpedProvingKey is a ProvingKey which stays in a SRS dump written by
+// SRS.WriteDump, its points being read by chunks when committing. It is meant
+// for polynomials whose SRS does not fit in memory.
+type DumpedProvingKey struct {
+	g1 *unsafe.SliceReaderAt[bls24317.G1Affine]
+}
+
+// NewDumpedProvingKey reads the VerifyingKey of a SRS dump written by
+// SRS.WriteDump, and returns it with the DumpedProvingKey reading the points
+// of the ProvingKey from r. r is typically the dump file, or a memory mapping
+// of it.
+//
+// As for SRS.ReadDump, the dump must have been written on the same
+// architecture, and the points are not checked.
+func NewDumpedProvingKey(r io.ReaderAt) (*DumpedProvingKey, VerifyingKey, error) {
+	var vk VerifyingKey
+	sr := io.NewSectionReader(r, 0, math.MaxInt64)
+	n, err := vk.ReadFrom(sr)
+	if err != nil {
+		return nil, VerifyingKey{}, err
+	}
+	if err := unsafe.ReadMarker(sr); err != nil {
+		return nil, VerifyingKey{}, err
+	}
+	g1, err := unsafe.NewSliceReaderAt[bls24317.G1Affine](r, n+8)
+	if err != nil {
+		return nil, VerifyingKey{}, err
+	}
+	return &DumpedProvingKey{g1: g1}, vk, nil
+}
+
+// Len returns the number of points of the proving key.
+func (pk *DumpedProvingKey) Len() int {
+	return pk.g1.Len()
+}
+
+// CommitStream commits to the polynomial whose coefficients are read from r, in
+// the format of fr.Vector.WriteTo. The coefficients and the points of the SRS
+// are processed by chunks of chunkSize, so that only one chunk of each is held
+// in memory at a time.
+func CommitStream(r io.Reader, pk *DumpedProvingKey, chunkSize int, nbTasks ...int) (Digest, error) {
+	n, err := readVectorLength(r, pk, chunkSize)
+	if err != nil {
+		return Digest{}, err
+	}
+
+	var res [1]bls24317.G1Jac
+	err = streamMultiExp(r, n, pk, chunkSize, res[:], func(coefficients []fr.Element, scalars [][]fr.Element) {
+		copy(scalars[0], coefficients)
+	}, nbTasks...)
+	if err != nil {
+		return Digest{}, err
+	}
+
+	var digest Digest
+	digest.FromJacobian(&res[0])
+	return digest, nil
+}
+
+// OpenStream computes an opening proof at point of the polynomial whose
+// coefficients are read from r, in the format of fr.Vector.WriteTo, processing
+// them by chunks of chunkSize as CommitStream.
+//
+// The value p(a) is not known before reading all the coefficients, so that
+// the quotient (p - p(a))/(x - a) = ∑ᵢ a⁻ⁱ⁻¹(p(a) - ∑_{j≤i} pⱼaʲ)xⁱ is committed
+// as p(a)[∑ᵢ a⁻ⁱ⁻¹αⁱ]G₁ - [∑ᵢ a⁻ⁱ⁻¹(∑_{j≤i} pⱼaʲ)αⁱ]G₁, that is, with two
+// multi-exponentiations instead of one.
+func OpenStream(r io.Reader, point fr.Element, pk *DumpedProvingKey, chunkSize int, nbTasks ...int) (OpeningProof, error) {
+	n, err := readVectorLength(r, pk, chunkSize)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	var res OpeningProof
+	if point.IsZero() {
+		// the quotient is ∑ᵢ pᵢ₊₁xⁱ
+		var first [fr.Bytes]byte
+		if _, err := io.ReadFull(r, first[:]); err != nil {
+			return OpeningProof{}, err
+		}
+		if res.ClaimedValue, err = fr.BigEndian.Element(&first); err != nil {
+			return OpeningProof{}, err
+		}
+		if n == 1 {
+			res.H.SetInfinity()
+			return res, nil
+		}
+		var h [1]bls24317.G1Jac
+		err = streamMultiExp(r, n-1, pk, chunkSize, h[:], func(coefficients []fr.Element, scalars [][]fr.Element) {
+			copy(scalars[0], coefficients)
+		}, nbTasks...)
+		if err != nil {
+			return OpeningProof{}, err
+		}
+		res.H.FromJacobian(&h[0])
+		return res, nil
+	}
+
+	// eval = ∑_{j≤i} pⱼaʲ, pointPower = aⁱ and pointInvPower = a⁻ⁱ⁻¹ for the
+	// next coefficient i
+	var eval, pointPower, pointInv, pointInvPower fr.Element
+	pointPower.SetOne()
+	pointInv.Inverse(&point)
+	pointInvPower.Set(&pointInv)
+
+	var sums [2]bls24317.G1Jac
+	err = streamMultiExp(r, n, pk, chunkSize, sums[:], func(coefficients []fr.Element, scalars [][]fr.Element) {
+		var t fr.Element
+		for i := range coefficients {
+			t.Mul(&coefficients[i], &pointPower)
+			eval.Add(&eval, &t)
+			scalars[0][i] = pointInvPower
+			scalars[1][i].Mul(&pointInvPower, &eval)
+			pointPower.Mul(&pointPower, &point)
+			pointInvPower.Mul(&pointInvPower, &pointInv)
+		}
+	}, nbTasks...)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// H = p(a)[∑ᵢ a⁻ⁱ⁻¹αⁱ]G₁ - [∑ᵢ a⁻ⁱ⁻¹(∑_{j≤i} pⱼaʲ)αⁱ]G₁
+	res.ClaimedValue = eval
+	var evalBigInt big.Int
+	sums[0].ScalarMultiplication(&sums[0], eval.BigInt(&evalBigInt)).
+		SubAssign(&sums[1])
+	res.H.FromJacobian(&sums[0])
+	return res, nil
+}
+
+// readVectorLength reads the length of a vector in the format of
+// fr.Vector.WriteTo and checks the parameters of a streaming commitment.
+func readVectorLength(r io.Reader, pk *DumpedProvingKey, chunkSize int) (int, error) {
+	if chunkSize <= 0 {
+		return 0, ErrInvalidChunkSize
+	}
+	var buf [4]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	n := int(binary.BigEndian.Uint32(buf[:]))
+	if n == 0 || n > pk.Len() {
+		return 0, ErrInvalidPolynomialSize
+	}
+	return n, nil
+}
+
+// streamMultiExp reads n coefficients from r by chunks of chunkSize. For each
+// chunk, computeScalars derives len(res) vectors of scalars from the
+// coefficients, whose multi-exponentiations with the corresponding points of
+// the SRS are added to res.
+func streamMultiExp(r io.Reader, n int, pk *DumpedProvingKey, chunkSize int, res []bls24317.G1Jac, computeScalars func(coefficients []fr.Element, scalars [][]fr.Element), nbTasks ...int) error {
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+
+	chunkSize = min(chunkSize, n)
+	points := make([]bls24317.G1Affine, chunkSize)
+	coefficients := make([]fr.Element, chunkSize)
+	bCoefficients := make([]byte, chunkSize*fr.Bytes)
+	s := make([][]fr.Element, len(res))
+	for i := range s {
+		s[i] = make([]fr.Element, chunkSize)
+	}
+	for i := range res {
+		res[i] = bls24317.G1Jac{} // infinity
+	}
+
+	for start := 0; start < n; start += chunkSize {
+		m := min(chunkSize, n-start)
+		if err := pk.g1.ReadAt(points[:m], start); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(r, bCoefficients[:m*fr.Bytes]); err != nil {
+			return err
+		}
+		var nbErrs uint64
+		parallel.Execute(m, func(start, end int) {
+			var err error
+			for i := start; i < end; i++ {
+				coefficients[i], err = fr.BigEndian.Element((*[fr.Bytes]byte)(bCoefficients[i*fr.Bytes : (i+1)*fr.Bytes]))
+				if err != nil {
+					atomic.AddUint64(&nbErrs, 1)
+				}
+			}
+		})
+		if nbErrs != 0 {
+			return errors.New("invalid coefficient: not in the field")
+		}
+
+		for i := range s {
+			s[i] = s[i][:m]
+		}
+		computeScalars(coefficients[:m], s)
+
+		var chunk bls24317.G1Jac
+		for i := range res {
+			if _, err := chunk.MultiExp(points[:m], s[i], config); err != nil {
+				return err
+			}
+			res[i].AddAssign(&chunk)
+		}
+	}
+	return nil
+}
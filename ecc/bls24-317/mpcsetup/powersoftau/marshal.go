@@ -0,0 +1,192 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package powersoftau
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/mpcsetup"
+)
+
+// transcriptMagic starts a transcript file.
+const transcriptMagic = "powersoftau/v1"
+
+// WriteTo implements io.WriterTo
+func (p *Phase1) WriteTo(w io.Writer) (int64, error) {
+	n, err := p.proofs.Tau.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	for _, proof := range []*mpcsetup.UpdateProof{&p.proofs.Alpha, &p.proofs.Beta} {
+		dn, err := proof.WriteTo(w)
+		n += dn
+		if err != nil {
+			return n, err
+		}
+	}
+
+	dn, err := p.parameters.WriteTo(w)
+	n += dn
+	if err != nil {
+		return n, err
+	}
+
+	enc := curve.NewEncoder(w)
+	err = enc.Encode(p.Challenge)
+	return n + enc.BytesWritten(), err
+}
+
+// ReadFrom implements io.ReaderFrom
+//
+// The points are not subgroup checked: [Phase1.Verify] does it.
+func (p *Phase1) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	for _, proof := range []*mpcsetup.UpdateProof{&p.proofs.Tau, &p.proofs.Alpha, &p.proofs.Beta} {
+		dn, err := proof.ReadFrom(r)
+		n += dn
+		if err != nil {
+			return n, err
+		}
+	}
+
+	dn, err := p.parameters.ReadFrom(r)
+	n += dn
+	if err != nil {
+		return n, err
+	}
+
+	if len(p.Challenge) != sha256.Size {
+		p.Challenge = make([]byte, sha256.Size)
+	}
+	dec := curve.NewDecoder(r)
+	err = dec.Decode(&p.Challenge)
+	return n + dec.BytesRead(), err
+}
+
+// WriteTo implements io.WriterTo
+func (c *SrsCommons) WriteTo(w io.Writer) (int64, error) {
+	enc := curve.NewEncoder(w)
+	toEncode := []any{
+		c.G1.Tau,
+		c.G1.AlphaTau,
+		c.G1.BetaTau,
+		c.G2.Tau,
+		&c.G2.Beta,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom implements io.ReaderFrom
+//
+// The points are not subgroup checked.
+func (c *SrsCommons) ReadFrom(r io.Reader) (int64, error) {
+	dec := curve.NewDecoder(r, curve.NoSubgroupChecks())
+	toDecode := []any{
+		&c.G1.Tau,
+		&c.G1.AlphaTau,
+		&c.G1.BetaTau,
+		&c.G2.Tau,
+		&c.G2.Beta,
+	}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+	if !c.hasSize(len(c.G2.Tau)) {
+		return dec.BytesRead(), errors.New("inconsistent sizes")
+	}
+	return dec.BytesRead(), nil
+}
+
+// TranscriptWriter writes the chain of contributions of a ceremony. The
+// transcript starts with the domain size, followed by the contributions in
+// order, as written by [Phase1.WriteTo].
+type TranscriptWriter struct {
+	w io.Writer
+	N int
+}
+
+// NewTranscriptWriter writes the header of a transcript for a domain of size N.
+func NewTranscriptWriter(w io.Writer, N int) (*TranscriptWriter, error) {
+	if _, err := io.WriteString(w, transcriptMagic); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint64(N)); err != nil {
+		return nil, err
+	}
+	return &TranscriptWriter{w: w, N: N}, nil
+}
+
+// Append writes the state of the ceremony after a contribution.
+func (t *TranscriptWriter) Append(p *Phase1) error {
+	if !p.parameters.hasSize(t.N) {
+		return errors.New("domain size mismatch")
+	}
+	_, err := p.WriteTo(t.w)
+	return err
+}
+
+// VerifyTranscript reads a transcript written with [TranscriptWriter] and
+// verifies the chain of contributions, starting from [InitializeSetup]. Only
+// two states are held in memory at a time.
+//
+// The domain size read from the header must be at most maxN, which is checked
+// before any allocation.
+//
+// It returns the state after the last contribution, to be sealed with
+// [Phase1.Seal], and the number of contributions.
+func VerifyTranscript(r io.Reader, maxN int) (*Phase1, int, error) {
+	br := bufio.NewReaderSize(r, 1<<20)
+
+	magic := make([]byte, len(transcriptMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, 0, err
+	}
+	if string(magic) != transcriptMagic {
+		return nil, 0, errors.New("not a powers of tau transcript")
+	}
+	var N uint64
+	if err := binary.Read(br, binary.BigEndian, &N); err != nil {
+		return nil, 0, err
+	}
+	if N < 2 || N > 1<<32 || N > uint64(maxN) {
+		return nil, 0, errors.New("invalid domain size")
+	}
+
+	prev := InitializeSetup(int(N))
+	var next Phase1
+	nbContributions := 0
+	for {
+		if _, err := br.Peek(1); err == io.EOF {
+			break
+		}
+		if _, err := next.ReadFrom(br); err != nil {
+			return nil, nbContributions, err
+		}
+		if err := prev.Verify(&next); err != nil {
+			return nil, nbContributions, fmt.Errorf("contribution %d: %w", nbContributions, err)
+		}
+		prev, next = next, prev
+		nbContributions++
+	}
+	if nbContributions == 0 {
+		return nil, 0, errors.New("empty transcript")
+	}
+
+	return &prev, nbContributions, nil
+}
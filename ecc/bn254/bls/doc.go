@@ -0,0 +1,24 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package bls provides BLS signatures on the bn254 curve.
+//
+// The implementation follows draft-irtf-cfrg-bls-signature-05 and supports
+// the basic, message augmentation and proof-of-possession schemes, each in
+// two variants:
+//   - minimal-pubkey-size (MinPk): public keys in G1, signatures in G2;
+//   - minimal-signature-size (MinSig): public keys in G2, signatures in G1.
+//
+// A Ciphersuite bundles a variant, a scheme and the matching domain
+// separation tags. Keys are bound to the ciphersuite they were created with;
+// the zero value of PublicKey and PrivateKey uses MinPkPop.
+//
+// Points are serialized in compressed form (see bn254.G1Affine.Bytes and
+// bn254.G2Affine.Bytes); decoding always checks subgroup membership.
+//
+// Documentation:
+//   - BLS signatures: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-05
+//   - Hashing to elliptic curves: https://www.rfc-editor.org/rfc/rfc9380.html
+package bls
@@ -0,0 +1,117 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFK20(t *testing.T) {
+	assert := require.New(t)
+
+	const domainSize = 32
+	domain := fft.NewDomain(domainSize)
+
+	for _, size := range []int{domainSize, 21} {
+		p := randomPolynomial(size)
+		for _, cosetSize := range []uint64{1, 4, domainSize} {
+			f, err := NewFK20(testSrs.Pk, domainSize, cosetSize)
+			assert.NoError(err)
+			proofs, err := f.Open(p)
+			assert.NoError(err)
+			assert.Len(proofs, int(domainSize/cosetSize))
+
+			// the i-th proof is the commitment to the quotient by Xᵏ - ωⁱᵏ
+			var c fr.Element
+			c.SetOne()
+			var ωk fr.Element
+			ωk.Exp(domain.Generator, new(big.Int).SetUint64(cosetSize))
+			for i := range proofs {
+				q := divideByXkMinusC(p, int(cosetSize), c)
+				expected, err := Commit(q, testSrs.Pk)
+				assert.NoError(err)
+				assert.True(expected.Equal(&proofs[i]), "size %d, coset size %d, coset %d", size, cosetSize, i)
+				c.Mul(&c, &ωk)
+			}
+		}
+	}
+
+	_, err := NewFK20(testSrs.Pk, domainSize, 3)
+	assert.ErrorIs(err, ErrInvalidCosetSize)
+	_, err = NewFK20(testSrs.Pk, 24, 1)
+	assert.ErrorIs(err, ErrInvalidDomainSize)
+	f, err := NewFK20(testSrs.Pk, domainSize, 2)
+	assert.NoError(err)
+	_, err = f.Open(randomPolynomial(domainSize + 1))
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestOpenAll(t *testing.T) {
+	assert := require.New(t)
+
+	const domainSize = 64
+	domain := fft.NewDomain(domainSize)
+	p := randomPolynomial(50)
+	digest, err := Commit(p, testSrs.Pk)
+	assert.NoError(err)
+
+	proofs, err := OpenAll(p, domain, testSrs.Pk)
+	assert.NoError(err)
+	assert.Len(proofs, domainSize)
+
+	var point fr.Element
+	point.SetOne()
+	for i := range proofs {
+		expected, err := Open(p, point, testSrs.Pk)
+		assert.NoError(err)
+		assert.Equal(expected, proofs[i])
+		if i%16 == 0 {
+			assert.NoError(Verify(&digest, &proofs[i], point, testSrs.Vk))
+		}
+		point.Mul(&point, &domain.Generator)
+	}
+}
+
+// divideByXkMinusC returns the quotient of the Euclidean division of p by Xᵏ - c.
+func divideByXkMinusC(p []fr.Element, k int, c fr.Element) []fr.Element {
+	if len(p) <= k {
+		return []fr.Element{{}}
+	}
+	r := make([]fr.Element, len(p))
+	copy(r, p)
+	q := make([]fr.Element, len(p)-k)
+	var tmp fr.Element
+	for i := len(p) - 1; i >= k; i-- {
+		q[i-k] = r[i]
+		tmp.Mul(&r[i], &c)
+		r[i-k].Add(&r[i-k], &tmp)
+	}
+	return q
+}
+
+func BenchmarkFK20(b *testing.B) {
+	const domainSize = 1 << 9
+	srs, err := NewSRS(domainSize, new(big.Int).SetInt64(42))
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := randomPolynomial(domainSize)
+
+	f, err := NewFK20(srs.Pk, domainSize, 1)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = f.Open(p)
+	}
+}
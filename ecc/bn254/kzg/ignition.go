@@ -0,0 +1,125 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+var ErrInvalidIgnition = errors.New("invalid Ignition transcript")
+
+// ignitionManifest is the header of an Ignition transcript, big-endian encoded.
+type ignitionManifest struct {
+	TranscriptNumber uint32
+	TotalTranscripts uint32
+	TotalG1Points    uint32
+	TotalG2Points    uint32
+	NumG1Points      uint32
+	NumG2Points      uint32
+	StartFrom        uint32
+}
+
+// ReadIgnition returns the SRS of the given size from the transcripts of the
+// Aztec Ignition ceremony (transcript00.dat, transcript01.dat, …), given in
+// order. Only the transcripts needed to reach size are read. The points are
+// checked as in [NewSRSFromPowers].
+//
+// The transcripts hold the powers [τ]G₁, [τ²]G₁, … (G₁ is prepended) and the
+// first one holds [τ]G₂. The coordinates are in Montgomery form, as four 64-bit
+// big-endian limbs from the least significant. The trailing checksums are not
+// verified.
+func ReadIgnition(transcripts []io.Reader, size uint64) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	g1 := make([]bn254.G1Affine, 1, size)
+	var g2 [2]bn254.G2Affine
+	_, _, g1[0], g2[0] = bn254.Generators()
+
+	var buf [4 * fp.Bytes]byte
+	for i := 0; i < len(transcripts) && uint64(len(g1)) < size; i++ {
+		r := bufio.NewReaderSize(transcripts[i], 1<<20)
+
+		var manifest ignitionManifest
+		if err := binary.Read(r, binary.BigEndian, &manifest); err != nil {
+			return nil, err
+		}
+		if manifest.TranscriptNumber != uint32(i) || uint64(manifest.StartFrom) != uint64(len(g1)-1) {
+			return nil, fmt.Errorf("%w: transcript %d out of order", ErrInvalidIgnition, manifest.TranscriptNumber)
+		}
+		if i == 0 && manifest.NumG2Points == 0 {
+			return nil, fmt.Errorf("%w: missing [τ]G₂", ErrInvalidIgnition)
+		}
+
+		nbPoints := min(uint64(manifest.NumG1Points), size-uint64(len(g1)))
+		for j := uint64(0); j < nbPoints; j++ {
+			var p bn254.G1Affine
+			if err := readIgnitionG1(r, buf[:], &p); err != nil {
+				return nil, err
+			}
+			g1 = append(g1, p)
+		}
+
+		if i == 0 {
+			// skip the remaining G1 points
+			remaining := int64(uint64(manifest.NumG1Points)-nbPoints) * 2 * fp.Bytes
+			if _, err := io.CopyN(io.Discard, r, remaining); err != nil {
+				return nil, err
+			}
+			if err := readIgnitionG2(r, buf[:], &g2[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if uint64(len(g1)) < size {
+		return nil, ErrInvalidSRSSize
+	}
+
+	return NewSRSFromPowers(g1, g2, size)
+}
+
+// readIgnitionG1 reads a G1 point as x, y.
+func readIgnitionG1(r io.Reader, buf []byte, p *bn254.G1Affine) error {
+	if _, err := io.ReadFull(r, buf[:2*fp.Bytes]); err != nil {
+		return err
+	}
+	var err error
+	if p.X, err = fromIgnitionLimbs(buf[:fp.Bytes]); err != nil {
+		return err
+	}
+	p.Y, err = fromIgnitionLimbs(buf[fp.Bytes : 2*fp.Bytes])
+	return err
+}
+
+// readIgnitionG2 reads a G2 point as x.A0, x.A1, y.A0, y.A1.
+func readIgnitionG2(r io.Reader, buf []byte, p *bn254.G2Affine) error {
+	if _, err := io.ReadFull(r, buf[:4*fp.Bytes]); err != nil {
+		return err
+	}
+	coordinates := []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1}
+	for i, c := range coordinates {
+		var err error
+		if *c, err = fromIgnitionLimbs(buf[i*fp.Bytes : (i+1)*fp.Bytes]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromIgnitionLimbs decodes the Montgomery form aR of a, written as four 64-bit
+// big-endian limbs from the least significant.
+func fromIgnitionLimbs(b []byte) (fp.Element, error) {
+	var le [fp.Bytes]byte
+	for i := 0; i < fp.Bytes; i += 8 {
+		binary.LittleEndian.PutUint64(le[i:i+8], binary.BigEndian.Uint64(b[i:i+8]))
+	}
+	return fromMontgomeryLE(le[:])
+}
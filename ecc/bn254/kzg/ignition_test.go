@@ -0,0 +1,128 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadIgnition(t *testing.T) {
+	assert := require.New(t)
+
+	// 3 transcripts of 40 powers of τ each
+	srs, err := NewSRS(121, big.NewInt(42))
+	assert.NoError(err)
+	powers := srs.Pk.G1[1:]
+	var transcripts [][]byte
+	for i := 0; i < 3; i++ {
+		transcripts = append(transcripts, writeIgnition(uint32(i), 3, powers[40*i:40*(i+1)], uint32(40*i), srs.Vk.G2[1]))
+	}
+	readers := func() []io.Reader {
+		res := make([]io.Reader, len(transcripts))
+		for i := range res {
+			res[i] = bytes.NewReader(transcripts[i])
+		}
+		return res
+	}
+
+	for _, size := range []uint64{2, 41, 42, 121} {
+		imported, err := ReadIgnition(readers(), size)
+		assert.NoError(err)
+		assert.Equal(srs.Pk.G1[:size], imported.Pk.G1)
+		assert.Equal(srs.Vk, imported.Vk)
+	}
+
+	_, err = ReadIgnition(readers(), 122)
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+
+	// transcripts out of order
+	r := readers()
+	r[1], r[2] = r[2], r[1]
+	_, err = ReadIgnition(r, 100)
+	assert.ErrorIs(err, ErrInvalidIgnition)
+
+	// wrong [τ]G₂
+	var g2 bn254.G2Affine
+	g2.Double(&srs.Vk.G2[1])
+	transcripts[0] = writeIgnition(0, 3, powers[:40], 0, g2)
+	_, err = ReadIgnition(readers(), 16)
+	assert.ErrorIs(err, ErrSRSInconsistent)
+}
+
+// TestIgnitionGenerators decodes the generators as written in the Ignition
+// transcripts, with the encodings computed independently of writeIgnition:
+// (1, 2) for G₁ and the generator of EIP-197 for G₂, in Montgomery form as
+// four big-endian limbs from the least significant.
+func TestIgnitionGenerators(t *testing.T) {
+	assert := require.New(t)
+
+	g1Bytes, err := hex.DecodeString("" +
+		"d35d438dc58f0d9d0a78eb28f5c70b3d666ea36f7879462c0e0a77c19a07df2f" +
+		"a6ba871b8b1e1b3a14f1d651eb8e167bccdd46def0f28c581c14ef83340fbe5e")
+	assert.NoError(err)
+	g2Bytes, err := hex.DecodeString("" +
+		"8e83b5d102bc2026dceb1935497b0172fbb8264797811adf19573841af96503b" +
+		"afb4737da84c61406043dd5a5802d8c409e950fc52a02f8614fef0833aea7b6b" +
+		"619dfa9d886be9f6fe7fd297f59e9b78ff9e1a62231b7dfe28fd7eebae9e4206" +
+		"64095b56c71856eedc57f922327d3cbb55f935be333510760da4a0e693fd6482")
+	assert.NoError(err)
+
+	var buf [4 * fp.Bytes]byte
+	var g1 bn254.G1Affine
+	var g2 bn254.G2Affine
+	assert.NoError(readIgnitionG1(bytes.NewReader(g1Bytes), buf[:], &g1))
+	assert.NoError(readIgnitionG2(bytes.NewReader(g2Bytes), buf[:], &g2))
+
+	_, _, expectedG1, expectedG2 := bn254.Generators()
+	assert.Equal(expectedG1, g1)
+	assert.Equal(expectedG2, g2)
+}
+
+// writeIgnition writes an Ignition transcript, without checksum.
+func writeIgnition(number, total uint32, g1 []bn254.G1Affine, startFrom uint32, g2 bn254.G2Affine) []byte {
+	var buf bytes.Buffer
+	manifest := ignitionManifest{
+		TranscriptNumber: number,
+		TotalTranscripts: total,
+		TotalG1Points:    uint32(len(g1)) * total,
+		TotalG2Points:    2,
+		NumG1Points:      uint32(len(g1)),
+		StartFrom:        startFrom,
+	}
+	if number == 0 {
+		manifest.NumG2Points = 2
+	}
+	_ = binary.Write(&buf, binary.BigEndian, &manifest)
+	for i := range g1 {
+		buf.Write(toIgnitionLimbs(g1[i].X))
+		buf.Write(toIgnitionLimbs(g1[i].Y))
+	}
+	if number == 0 {
+		for i := 0; i < 2; i++ {
+			buf.Write(toIgnitionLimbs(g2.X.A0))
+			buf.Write(toIgnitionLimbs(g2.X.A1))
+			buf.Write(toIgnitionLimbs(g2.Y.A0))
+			buf.Write(toIgnitionLimbs(g2.Y.A1))
+		}
+	}
+	return buf.Bytes()
+}
+
+func toIgnitionLimbs(a fp.Element) []byte {
+	le := toMontgomeryLE(a)
+	res := make([]byte, fp.Bytes)
+	for i := 0; i < fp.Bytes; i += 8 {
+		binary.BigEndian.PutUint64(res[i:i+8], binary.LittleEndian.Uint64(le[i:i+8]))
+	}
+	return res
+}
@@ -0,0 +1,175 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+var ErrInvalidPtau = errors.New("invalid ptau file")
+
+// ptau section types
+const (
+	ptauSectionHeader = 1
+	ptauSectionTauG1  = 2
+	ptauSectionTauG2  = 3
+)
+
+// ReadPtau returns the SRS of the given size from a snarkjs powers of tau
+// (.ptau) file, as produced by the Perpetual Powers of Tau ceremony. The
+// points are checked as in [NewSRSFromPowers].
+//
+// A .ptau file of power p holds 2ᵖ⁺¹-1 powers of τ in G₁, so that size must be
+// at most 2ᵖ⁺¹-1. Only the header and the [τⁱ]G₁, [τⁱ]G₂ sections are read;
+// they must come first, in this order.
+func ReadPtau(r io.Reader, size uint64) (*SRS, error) {
+	br := bufio.NewReaderSize(r, 1<<20)
+	var buf [4 * fp.Bytes]byte
+
+	// magic, version, number of sections
+	if _, err := io.ReadFull(br, buf[:12]); err != nil {
+		return nil, err
+	}
+	if string(buf[:4]) != "ptau" {
+		return nil, fmt.Errorf("%w: wrong magic number", ErrInvalidPtau)
+	}
+	nbSections := binary.LittleEndian.Uint32(buf[8:12])
+
+	var (
+		power uint32
+		g1    []bn254.G1Affine
+		g2    [2]bn254.G2Affine
+		hasG2 bool
+	)
+	for i := uint32(0); i < nbSections && (g1 == nil || !hasG2); i++ {
+		if _, err := io.ReadFull(br, buf[:12]); err != nil {
+			return nil, err
+		}
+		sectionType := binary.LittleEndian.Uint32(buf[:4])
+		sectionSize := int64(binary.LittleEndian.Uint64(buf[4:12]))
+		section := io.LimitReader(br, sectionSize)
+
+		switch sectionType {
+		case ptauSectionHeader:
+			var err error
+			if power, err = readPtauHeader(section); err != nil {
+				return nil, err
+			}
+		case ptauSectionTauG1:
+			if power == 0 {
+				return nil, fmt.Errorf("%w: missing header", ErrInvalidPtau)
+			}
+			if nbPoints := uint64(2)<<power - 1; size > nbPoints {
+				return nil, ErrInvalidSRSSize
+			}
+			g1 = make([]bn254.G1Affine, size)
+			for j := range g1 {
+				if err := readPtauG1(section, buf[:], &g1[j]); err != nil {
+					return nil, err
+				}
+			}
+		case ptauSectionTauG2:
+			for j := range g2 {
+				if err := readPtauG2(section, buf[:], &g2[j]); err != nil {
+					return nil, err
+				}
+			}
+			hasG2 = true
+		}
+
+		// skip the rest of the section
+		if _, err := io.Copy(io.Discard, section); err != nil {
+			return nil, err
+		}
+	}
+	if g1 == nil || !hasG2 {
+		return nil, fmt.Errorf("%w: missing powers of τ", ErrInvalidPtau)
+	}
+
+	return NewSRSFromPowers(g1, g2, size)
+}
+
+// readPtauHeader reads the header section and returns the power of the file.
+func readPtauHeader(r io.Reader) (uint32, error) {
+	var buf [4 + fp.Bytes + 4]byte
+	if _, err := io.ReadFull(r, buf[:4]); err != nil {
+		return 0, err
+	}
+	if n8 := binary.LittleEndian.Uint32(buf[:4]); n8 != fp.Bytes {
+		return 0, fmt.Errorf("%w: unexpected field size %d", ErrInvalidPtau, n8)
+	}
+	if _, err := io.ReadFull(r, buf[4:]); err != nil {
+		return 0, err
+	}
+	var q [fp.Bytes]byte
+	for i := range q {
+		q[i] = buf[4+fp.Bytes-1-i]
+	}
+	if new(big.Int).SetBytes(q[:]).Cmp(fp.Modulus()) != 0 {
+		return 0, fmt.Errorf("%w: not a BN254 file", ErrInvalidPtau)
+	}
+	power := binary.LittleEndian.Uint32(buf[4+fp.Bytes:])
+	if power == 0 || power > 28 {
+		return 0, fmt.Errorf("%w: unexpected power %d", ErrInvalidPtau, power)
+	}
+	return power, nil
+}
+
+// readPtauG1 reads a G1 point as x, y in little-endian Montgomery form.
+func readPtauG1(r io.Reader, buf []byte, p *bn254.G1Affine) error {
+	if _, err := io.ReadFull(r, buf[:2*fp.Bytes]); err != nil {
+		return err
+	}
+	var err error
+	if p.X, err = fromMontgomeryLE(buf[:fp.Bytes]); err != nil {
+		return err
+	}
+	p.Y, err = fromMontgomeryLE(buf[fp.Bytes : 2*fp.Bytes])
+	return err
+}
+
+// readPtauG2 reads a G2 point as x.A0, x.A1, y.A0, y.A1 in little-endian
+// Montgomery form.
+func readPtauG2(r io.Reader, buf []byte, p *bn254.G2Affine) error {
+	if _, err := io.ReadFull(r, buf[:4*fp.Bytes]); err != nil {
+		return err
+	}
+	coordinates := []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1}
+	for i, c := range coordinates {
+		var err error
+		if *c, err = fromMontgomeryLE(buf[i*fp.Bytes : (i+1)*fp.Bytes]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// montgomeryRInv is 2⁻²⁵⁶ mod q, the inverse of the Montgomery constant of the
+// snarkjs and barretenberg encodings.
+var montgomeryRInv = func() fp.Element {
+	var res fp.Element
+	res.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 8*fp.Bytes))
+	res.Inverse(&res)
+	return res
+}()
+
+// fromMontgomeryLE decodes the little-endian Montgomery form aR of a.
+func fromMontgomeryLE(b []byte) (fp.Element, error) {
+	var buf [fp.Bytes]byte
+	copy(buf[:], b)
+	res, err := fp.LittleEndian.Element(&buf)
+	if err != nil {
+		return res, err
+	}
+	res.Mul(&res, &montgomeryRInv)
+	return res, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPtau(t *testing.T) {
+	assert := require.New(t)
+
+	// power 7: 255 powers in G₁, 128 in G₂
+	const power = 7
+	srs, err := NewSRS(1<<(power+1), big.NewInt(42))
+	assert.NoError(err)
+	g2 := make([]bn254.G2Affine, 1<<power)
+	g2[0], g2[1] = srs.Vk.G2[0], srs.Vk.G2[1]
+	for i := 2; i < len(g2); i++ {
+		g2[i].ScalarMultiplication(&g2[i-1], big.NewInt(42))
+	}
+	ptau := writePtau(power, srs.Pk.G1[:len(srs.Pk.G1)-1], g2)
+
+	for _, size := range []uint64{2, 100, 255} {
+		imported, err := ReadPtau(bytes.NewReader(ptau), size)
+		assert.NoError(err)
+		assert.Equal(srs.Pk.G1[:size], imported.Pk.G1)
+		assert.Equal(srs.Vk, imported.Vk)
+	}
+
+	_, err = ReadPtau(bytes.NewReader(ptau), 256)
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+
+	// wrong magic number
+	tampered := bytes.Clone(ptau)
+	tampered[0] = 'x'
+	_, err = ReadPtau(bytes.NewReader(tampered), 16)
+	assert.ErrorIs(err, ErrInvalidPtau)
+
+	// [τ³]G₁ replaced by [τ⁴]G₁
+	g1 := slices.Clone(srs.Pk.G1[:255])
+	g1[3] = g1[4]
+	_, err = ReadPtau(bytes.NewReader(writePtau(power, g1, g2)), 16)
+	assert.ErrorIs(err, ErrSRSInconsistent)
+}
+
+// TestPtauGenerators decodes the generators as written by snarkjs, with the
+// encodings computed independently of writePtau: (1, 2) for G₁ and the
+// generator of EIP-197 for G₂, in little-endian Montgomery form.
+func TestPtauGenerators(t *testing.T) {
+	assert := require.New(t)
+
+	g1Bytes, err := hex.DecodeString("" +
+		"9d0d8fc58d435dd33d0bc7f528eb780a2c4679786fa36e662fdf079ac1770a0e" +
+		"3a1b1e8b1b87baa67b168eeb51d6f114588cf2f0de46ddcc5ebe0f3483ef141c")
+	assert.NoError(err)
+	g2Bytes, err := hex.DecodeString("" +
+		"2620bc02d1b5838e72017b493519ebdcdf1a81974726b8fb3b5096af41385719" +
+		"40614ca87d73b4afc4d802585add4360862fa052fc50e9096b7bea3a83f0fe14" +
+		"f6e96b889dfa9d61789b9ef597d27ffefe7d1b23621a9eff06429eaeeb7efd28" +
+		"ee5618c7565b0964bb3c7d3222f957dc76103533be35f9558264fd93e6a0a40d")
+	assert.NoError(err)
+
+	var buf [4 * fp.Bytes]byte
+	var g1 bn254.G1Affine
+	var g2 bn254.G2Affine
+	assert.NoError(readPtauG1(bytes.NewReader(g1Bytes), buf[:], &g1))
+	assert.NoError(readPtauG2(bytes.NewReader(g2Bytes), buf[:], &g2))
+
+	_, _, expectedG1, expectedG2 := bn254.Generators()
+	assert.Equal(expectedG1, g1)
+	assert.Equal(expectedG2, g2)
+}
+
+// writePtau writes the header and the powers of τ sections of a .ptau file,
+// followed by an empty section.
+func writePtau(power uint32, g1 []bn254.G1Affine, g2 []bn254.G2Affine) []byte {
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+
+	buf.WriteString("ptau")
+	_ = binary.Write(&buf, le, uint32(1))
+	_ = binary.Write(&buf, le, uint32(4))
+
+	// header
+	_ = binary.Write(&buf, le, uint32(ptauSectionHeader))
+	_ = binary.Write(&buf, le, uint64(4+fp.Bytes+8))
+	_ = binary.Write(&buf, le, uint32(fp.Bytes))
+	var q [fp.Bytes]byte
+	fp.Modulus().FillBytes(q[:])
+	for i := len(q) - 1; i >= 0; i-- {
+		buf.WriteByte(q[i])
+	}
+	_ = binary.Write(&buf, le, power)
+	_ = binary.Write(&buf, le, power)
+
+	// [τⁱ]G₁
+	_ = binary.Write(&buf, le, uint32(ptauSectionTauG1))
+	_ = binary.Write(&buf, le, uint64(len(g1)*2*fp.Bytes))
+	for i := range g1 {
+		buf.Write(toMontgomeryLE(g1[i].X))
+		buf.Write(toMontgomeryLE(g1[i].Y))
+	}
+
+	// [τⁱ]G₂
+	_ = binary.Write(&buf, le, uint32(ptauSectionTauG2))
+	_ = binary.Write(&buf, le, uint64(len(g2)*4*fp.Bytes))
+	for i := range g2 {
+		buf.Write(toMontgomeryLE(g2[i].X.A0))
+		buf.Write(toMontgomeryLE(g2[i].X.A1))
+		buf.Write(toMontgomeryLE(g2[i].Y.A0))
+		buf.Write(toMontgomeryLE(g2[i].Y.A1))
+	}
+
+	// [ατⁱ]G₁, empty
+	_ = binary.Write(&buf, le, uint32(4))
+	_ = binary.Write(&buf, le, uint64(0))
+
+	return buf.Bytes()
+}
+
+// toMontgomeryLE returns the little-endian Montgomery form aR of a.
+func toMontgomeryLE(a fp.Element) []byte {
+	var r fp.Element
+	r.Inverse(&montgomeryRInv)
+	a.Mul(&a, &r)
+	var b [fp.Bytes]byte
+	fp.LittleEndian.PutElement(&b, a)
+	return b[:]
+}
@@ -0,0 +1,100 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// dumpedTestSrs returns the proving key and the verifying key of a dump of the test SRS.
+func dumpedTestSrs(t *testing.T) (*DumpedProvingKey, VerifyingKey) {
+	var buf bytes.Buffer
+	require.NoError(t, testSrs.WriteDump(&buf))
+	pk, vk, err := NewDumpedProvingKey(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	return pk, vk
+}
+
+// vectorReader returns a reader on the encoding of p by fr.Vector.WriteTo.
+func vectorReader(t *testing.T, p []fr.Element) *bytes.Reader {
+	var buf bytes.Buffer
+	v := fr.Vector(p)
+	_, err := v.WriteTo(&buf)
+	require.NoError(t, err)
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestCommitStream(t *testing.T) {
+	assert := require.New(t)
+
+	pk, vk := dumpedTestSrs(t)
+	assert.Equal(len(testSrs.Pk.G1), pk.Len())
+	assert.Equal(testSrs.Vk, vk)
+
+	for _, size := range []int{1, 7, 60, len(testSrs.Pk.G1)} {
+		f := randomPolynomial(size)
+		expected, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+		for _, chunkSize := range []int{1, 7, 64, 1 << 20} {
+			digest, err := CommitStream(vectorReader(t, f), pk, chunkSize)
+			assert.NoError(err)
+			assert.True(digest.Equal(&expected), "size %d, chunk size %d", size, chunkSize)
+		}
+	}
+
+	_, err := CommitStream(vectorReader(t, randomPolynomial(len(testSrs.Pk.G1)+1)), pk, 10)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = CommitStream(vectorReader(t, randomPolynomial(10)), pk, 0)
+	assert.ErrorIs(err, ErrInvalidChunkSize)
+
+	// truncated stream
+	r := vectorReader(t, randomPolynomial(10))
+	b := make([]byte, r.Len()-1)
+	_, err = r.Read(b)
+	assert.NoError(err)
+	_, err = CommitStream(bytes.NewReader(b), pk, 3)
+	assert.Error(err)
+}
+
+func TestOpenStream(t *testing.T) {
+	assert := require.New(t)
+
+	pk, vk := dumpedTestSrs(t)
+	var point fr.Element
+	point.SetRandom()
+	for _, size := range []int{2, 7, 60} {
+		f := randomPolynomial(size)
+		digest, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+		expected, err := Open(f, point, testSrs.Pk)
+		assert.NoError(err)
+		for _, chunkSize := range []int{1, 5, 64} {
+			proof, err := OpenStream(vectorReader(t, f), point, pk, chunkSize)
+			assert.NoError(err)
+			assert.Equal(expected, proof, "size %d, chunk size %d", size, chunkSize)
+			assert.NoError(Verify(&digest, &proof, point, vk))
+		}
+
+		// at zero, the quotient is read with an offset
+		var zero fr.Element
+		expected, err = Open(f, zero, testSrs.Pk)
+		assert.NoError(err)
+		proof, err := OpenStream(vectorReader(t, f), zero, pk, 3)
+		assert.NoError(err)
+		assert.Equal(expected, proof)
+	}
+
+	// constant polynomial
+	f := randomPolynomial(1)
+	proof, err := OpenStream(vectorReader(t, f), point, pk, 3)
+	assert.NoError(err)
+	assert.True(proof.ClaimedValue.Equal(&f[0]))
+	assert.True(proof.H.IsInfinity())
+}
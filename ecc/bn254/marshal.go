@@ -261,11 +261,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		parallel.Execute(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
+					if err := (*t)[i].unsafeComputeY(false); err != nil {
 						atomic.AddUint64(&nbErrs, 1)
 					}
 				}
@@ -274,6 +270,9 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
+		if dec.subGroupCheck && !BatchIsInSubGroupG1(*t) {
+			return errors.New("invalid point: subgroup check failed")
+		}
 
 		return nil
 	case *[]G2Affine:
@@ -320,11 +319,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		parallel.Execute(len(compressed), func(start, end int) {
 			for i := start; i < end; i++ {
 				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
+					if err := (*t)[i].unsafeComputeY(false); err != nil {
 						atomic.AddUint64(&nbErrs, 1)
 					}
 				}
@@ -333,6 +328,9 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
+		if dec.subGroupCheck && !BatchIsInSubGroupG2(*t) {
+			return errors.New("invalid point: subgroup check failed")
+		}
 
 		return nil
 	default:
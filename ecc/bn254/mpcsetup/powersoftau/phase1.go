@@ -0,0 +1,243 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package powersoftau
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/mpcsetup"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// domain separation tags of the contribution proofs
+const (
+	dstTau byte = iota + 1
+	dstAlpha
+	dstBeta
+)
+
+const beaconDst = "Powers of Tau - Beacon"
+
+// SrsCommons are the outputs of the phase 1, common to all the circuits with
+// a domain of size at most N.
+type SrsCommons struct {
+	G1 struct {
+		Tau      []curve.G1Affine // {[τ⁰]₁, [τ¹]₁, [τ²]₁, …, [τ²ᴺ⁻²]₁}
+		AlphaTau []curve.G1Affine // {[ατ⁰]₁, [ατ¹]₁, …, [ατᴺ⁻¹]₁}
+		BetaTau  []curve.G1Affine // {[βτ⁰]₁, [βτ¹]₁, …, [βτᴺ⁻¹]₁}
+	}
+	G2 struct {
+		Tau  []curve.G2Affine // {[τ⁰]₂, [τ¹]₂, …, [τᴺ⁻¹]₂}
+		Beta curve.G2Affine   // [β]₂
+	}
+}
+
+// Phase1 is the state of the ceremony after a contribution: the parameters,
+// the proofs of the last contribution, and the hash of the previous state
+// the contribution was made on.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Phase1 struct {
+	proofs struct {
+		Tau, Alpha, Beta mpcsetup.UpdateProof
+	}
+	parameters SrsCommons
+	Challenge  []byte // hash of the previous state
+}
+
+// InitializeSetup returns the initial state of a ceremony for a domain of size
+// N, with τ = α = β = 1.
+func InitializeSetup(N int) Phase1 {
+	var p Phase1
+	p.parameters.setOne(N)
+	return p
+}
+
+// Parameters returns the parameters after the last contribution.
+func (p *Phase1) Parameters() *SrsCommons {
+	return &p.parameters
+}
+
+// Contribute updates the parameters with random contributions to τ, α and β,
+// and proves the update.
+func (p *Phase1) Contribute() {
+	p.Challenge = p.hash()
+
+	var tauContrib, alphaContrib, betaContrib fr.Element
+	p.proofs.Tau = mpcsetup.UpdateValues(&tauContrib, p.Challenge, dstTau)
+	p.proofs.Alpha = mpcsetup.UpdateValues(&alphaContrib, p.Challenge, dstAlpha)
+	p.proofs.Beta = mpcsetup.UpdateValues(&betaContrib, p.Challenge, dstBeta)
+
+	p.parameters.update(&tauContrib, &alphaContrib, &betaContrib)
+}
+
+// Verify checks that next is a valid contribution on top of p.
+func (p *Phase1) Verify(next *Phase1) error {
+	challenge := p.hash()
+	if len(next.Challenge) != 0 && !bytes.Equal(next.Challenge, challenge) {
+		return errors.New("the challenge does not match the previous contribution's hash")
+	}
+	next.Challenge = challenge
+
+	N := len(p.parameters.G2.Tau)
+	if !next.parameters.hasSize(N) {
+		return errors.New("domain size mismatch")
+	}
+	if err := next.parameters.checkPoints(); err != nil {
+		return err
+	}
+
+	// contributions to τ, α and β
+	if err := next.proofs.Tau.Verify(challenge, dstTau, mpcsetup.ValueUpdate{
+		Previous: &p.parameters.G1.Tau[1],
+		Next:     &next.parameters.G1.Tau[1],
+	}); err != nil {
+		return fmt.Errorf("failed to verify contribution to τ: %w", err)
+	}
+	if err := next.proofs.Alpha.Verify(challenge, dstAlpha, mpcsetup.ValueUpdate{
+		Previous: &p.parameters.G1.AlphaTau[0],
+		Next:     &next.parameters.G1.AlphaTau[0],
+	}); err != nil {
+		return fmt.Errorf("failed to verify contribution to α: %w", err)
+	}
+	if err := next.proofs.Beta.Verify(challenge, dstBeta, mpcsetup.ValueUpdate{
+		Previous: &p.parameters.G1.BetaTau[0],
+		Next:     &next.parameters.G1.BetaTau[0],
+	}, mpcsetup.ValueUpdate{
+		Previous: &p.parameters.G2.Beta,
+		Next:     &next.parameters.G2.Beta,
+	}); err != nil {
+		return fmt.Errorf("failed to verify contribution to β: %w", err)
+	}
+
+	// all the sequences are powers of the same τ
+	return mpcsetup.SameRatioMany(
+		next.parameters.G1.Tau,
+		next.parameters.G2.Tau,
+		next.parameters.G1.AlphaTau,
+		next.parameters.G1.BetaTau,
+	)
+}
+
+// Seal applies contributions derived from the beacon challenge, a public value
+// unpredictable before the end of the ceremony, and returns the final
+// parameters.
+func (p *Phase1) Seal(beaconChallenge []byte) SrsCommons {
+	contributions := mpcsetup.BeaconContributions(p.hash(), []byte(beaconDst), beaconChallenge, 3)
+	p.parameters.update(&contributions[0], &contributions[1], &contributions[2])
+	return p.parameters
+}
+
+func (p *Phase1) hash() []byte {
+	h := sha256.New()
+	if _, err := p.WriteTo(h); err != nil {
+		panic(err)
+	}
+	return h.Sum(nil)
+}
+
+// setOne sets the parameters to the generators, that is τ = α = β = 1.
+func (c *SrsCommons) setOne(N int) {
+	if N < 2 {
+		panic("N must be at least 2")
+	}
+	_, _, g1, g2 := curve.Generators()
+	c.G1.Tau = make([]curve.G1Affine, 2*N-1)
+	c.G1.AlphaTau = make([]curve.G1Affine, N)
+	c.G1.BetaTau = make([]curve.G1Affine, N)
+	c.G2.Tau = make([]curve.G2Affine, N)
+	for i := range c.G1.Tau {
+		c.G1.Tau[i] = g1
+	}
+	for i := 0; i < N; i++ {
+		c.G1.AlphaTau[i] = g1
+		c.G1.BetaTau[i] = g1
+		c.G2.Tau[i] = g2
+	}
+	c.G2.Beta = g2
+}
+
+// hasSize returns true if the lengths of the sequences match the domain size N.
+func (c *SrsCommons) hasSize(N int) bool {
+	return N >= 2 && len(c.G1.Tau) == 2*N-1 && len(c.G1.AlphaTau) == N && len(c.G1.BetaTau) == N && len(c.G2.Tau) == N
+}
+
+// checkPoints checks that the sequences start with the generators and that
+// the points are in the prime order subgroups.
+func (c *SrsCommons) checkPoints() error {
+	_, _, g1, g2 := curve.Generators()
+	if !c.G1.Tau[0].Equal(&g1) || !c.G2.Tau[0].Equal(&g2) {
+		return errors.New("[τ⁰] is not the generator")
+	}
+	if c.G1.Tau[1].IsInfinity() || c.G1.AlphaTau[0].IsInfinity() || c.G1.BetaTau[0].IsInfinity() {
+		return errors.New("zero contribution")
+	}
+
+	if !curve.BatchIsInSubGroupG1(c.G1.Tau[1:]) || !curve.BatchIsInSubGroupG1(c.G1.AlphaTau) || !curve.BatchIsInSubGroupG1(c.G1.BetaTau) ||
+		!curve.BatchIsInSubGroupG2(c.G2.Tau[1:]) || !c.G2.Beta.IsInSubGroup() {
+		return errors.New("point not in subgroup")
+	}
+	return nil
+}
+
+// update multiplies τ, α and β by the contributions:
+// [α'τ'ⁱ]₁ ← [(αα')(ττ')ⁱ]₁ and so on.
+func (c *SrsCommons) update(tauUpdate, alphaUpdate, betaUpdate *fr.Element) {
+	tauUpdates := powers(tauUpdate, len(c.G1.Tau))
+	scaleG1InPlace(c.G1.Tau[1:], tauUpdates[1:]) // [τ⁰]₁ remains the generator
+	scaleG2InPlace(c.G2.Tau[1:], tauUpdates[1:len(c.G2.Tau)])
+
+	alphaUpdates := make([]fr.Element, len(c.G1.AlphaTau))
+	betaUpdates := make([]fr.Element, len(c.G1.BetaTau))
+	for i := range alphaUpdates {
+		alphaUpdates[i].Mul(&tauUpdates[i], alphaUpdate)
+		betaUpdates[i].Mul(&tauUpdates[i], betaUpdate)
+	}
+	scaleG1InPlace(c.G1.AlphaTau, alphaUpdates)
+	scaleG1InPlace(c.G1.BetaTau, betaUpdates)
+
+	var betaUpdateI big.Int
+	betaUpdate.BigInt(&betaUpdateI)
+	c.G2.Beta.ScalarMultiplication(&c.G2.Beta, &betaUpdateI)
+}
+
+// powers returns 1, x, …, xⁿ⁻¹.
+func powers(x *fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], x)
+	}
+	return res
+}
+
+// scaleG1InPlace sets points[i] ← [scalars[i]]points[i].
+func scaleG1InPlace(points []curve.G1Affine, scalars []fr.Element) {
+	parallel.Execute(len(points), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			scalars[i].BigInt(&s)
+			points[i].ScalarMultiplication(&points[i], &s)
+		}
+	})
+}
+
+// scaleG2InPlace sets points[i] ← [scalars[i]]points[i].
+func scaleG2InPlace(points []curve.G2Affine, scalars []fr.Element) {
+	parallel.Execute(len(points), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			scalars[i].BigInt(&s)
+			points[i].ScalarMultiplication(&points[i], &s)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package powersoftau
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+// ptau section types
+const (
+	ptauSectionHeader = iota + 1
+	ptauSectionTauG1
+	ptauSectionTauG2
+	ptauSectionAlphaTauG1
+	ptauSectionBetaTauG1
+	ptauSectionBetaG2
+	ptauSectionContributions
+)
+
+// WritePtau writes the parameters in the snarkjs .ptau layout, so that they
+// can be used by snarkjs and the tools reading its files. N must be a power
+// of 2. The file holds no contributions: their proofs are in the transcript
+// of the ceremony.
+func (c *SrsCommons) WritePtau(w io.Writer) error {
+	N := len(c.G2.Tau)
+	if !c.hasSize(N) || bits.OnesCount(uint(N)) != 1 {
+		return errors.New("domain size must be a power of 2")
+	}
+	power := uint32(bits.TrailingZeros(uint(N)))
+
+	le := binary.LittleEndian
+	write := func(v any) error {
+		return binary.Write(w, le, v)
+	}
+	writeSectionHeader := func(sectionType uint32, size int) error {
+		if err := write(sectionType); err != nil {
+			return err
+		}
+		return write(uint64(size))
+	}
+	writeG1 := func(sectionType uint32, points []curve.G1Affine) error {
+		if err := writeSectionHeader(sectionType, len(points)*2*fp.Bytes); err != nil {
+			return err
+		}
+		buf := make([]byte, 2*fp.Bytes)
+		for i := range points {
+			toMontgomeryLE(buf[:fp.Bytes], &points[i].X)
+			toMontgomeryLE(buf[fp.Bytes:], &points[i].Y)
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	writeG2 := func(sectionType uint32, points []curve.G2Affine) error {
+		if err := writeSectionHeader(sectionType, len(points)*4*fp.Bytes); err != nil {
+			return err
+		}
+		buf := make([]byte, 4*fp.Bytes)
+		for i := range points {
+			toMontgomeryLE(buf[:fp.Bytes], &points[i].X.A0)
+			toMontgomeryLE(buf[fp.Bytes:], &points[i].X.A1)
+			toMontgomeryLE(buf[2*fp.Bytes:], &points[i].Y.A0)
+			toMontgomeryLE(buf[3*fp.Bytes:], &points[i].Y.A1)
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// magic, version, number of sections
+	if _, err := io.WriteString(w, "ptau"); err != nil {
+		return err
+	}
+	if err := write([]uint32{1, ptauSectionContributions}); err != nil {
+		return err
+	}
+
+	// header: field size, modulus, power and ceremony power
+	if err := writeSectionHeader(ptauSectionHeader, 4+fp.Bytes+8); err != nil {
+		return err
+	}
+	if err := write(uint32(fp.Bytes)); err != nil {
+		return err
+	}
+	q := make([]byte, fp.Bytes)
+	fp.Modulus().FillBytes(q)
+	for i, j := 0, len(q)-1; i < j; i, j = i+1, j-1 {
+		q[i], q[j] = q[j], q[i]
+	}
+	if _, err := w.Write(q); err != nil {
+		return err
+	}
+	if err := write([]uint32{power, power}); err != nil {
+		return err
+	}
+
+	if err := writeG1(ptauSectionTauG1, c.G1.Tau); err != nil {
+		return err
+	}
+	if err := writeG2(ptauSectionTauG2, c.G2.Tau); err != nil {
+		return err
+	}
+	if err := writeG1(ptauSectionAlphaTauG1, c.G1.AlphaTau); err != nil {
+		return err
+	}
+	if err := writeG1(ptauSectionBetaTauG1, c.G1.BetaTau); err != nil {
+		return err
+	}
+	if err := writeG2(ptauSectionBetaG2, []curve.G2Affine{c.G2.Beta}); err != nil {
+		return err
+	}
+
+	// no contributions
+	if err := writeSectionHeader(ptauSectionContributions, 4); err != nil {
+		return err
+	}
+	return write(uint32(0))
+}
+
+// montgomeryR is 2^(8⋅fp.Bytes) mod q, the Montgomery constant of snarkjs.
+var montgomeryR = func() fp.Element {
+	var res fp.Element
+	res.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 8*fp.Bytes))
+	return res
+}()
+
+// toMontgomeryLE writes the little-endian Montgomery form aR of a in buf.
+func toMontgomeryLE(buf []byte, a *fp.Element) {
+	var aR fp.Element
+	aR.Mul(a, &montgomeryR)
+	fp.LittleEndian.PutElement((*[fp.Bytes]byte)(buf[:fp.Bytes]), aR)
+}
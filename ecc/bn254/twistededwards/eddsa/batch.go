@@ -0,0 +1,146 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+var errBatchSize = errors.New("number of public keys, signatures and messages mismatch")
+
+// BatchVerifyError is returned by BatchVerify when some signatures of the
+// batch are invalid.
+type BatchVerifyError struct {
+	// Indices of the invalid signatures, in increasing order.
+	Indices []int
+}
+
+func (e *BatchVerifyError) Error() string {
+	return fmt.Sprintf("invalid signatures at indices %v", e.Indices)
+}
+
+// batchEntry holds a decoded signature of the batch and its random coefficient.
+type batchEntry struct {
+	A, R       twistededwards.PointAffine
+	S, hram, z big.Int
+}
+
+// BatchVerify verifies the eddsa signatures sigs[i] of msgs[i] by pubs[i].
+//
+// For random 128-bit zᵢ it checks with a single multi-scalar multiplication that
+//
+//	cofactor⋅((∑ zᵢ⋅Sᵢ)⋅Base - ∑ zᵢ⋅Rᵢ - ∑ (zᵢ⋅H(Rᵢ,Aᵢ,Mᵢ))⋅Aᵢ) = 0
+//
+// which holds for a batch containing an invalid signature with probability at
+// most 2⁻¹²⁸. If the check fails, the batch is bisected to isolate the invalid
+// signatures, whose indices are returned in a *BatchVerifyError.
+func BatchVerify(pubs []PublicKey, sigs [][]byte, msgs [][]byte, hFunc hash.Hash) (bool, error) {
+
+	// hFunc cannot be nil.
+	// We need a hash function for the Fiat-Shamir.
+	if hFunc == nil {
+		return false, errHashNeeded
+	}
+	if len(pubs) != len(sigs) || len(pubs) != len(msgs) {
+		return false, errBatchSize
+	}
+
+	entries := make([]batchEntry, len(pubs))
+	wellFormed := make([]int, 0, len(pubs))
+	var invalid []int
+	var zBytes [16]byte
+	for i := range pubs {
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil || !pubs[i].A.IsOnCurve() {
+			invalid = append(invalid, i)
+			continue
+		}
+		e := &entries[i]
+		e.A.Set(&pubs[i].A)
+		e.R.Set(&sig.R)
+		e.S.SetBytes(sig.S[:])
+		if err := computeHRAM(&e.hram, hFunc, &e.R, &e.A, msgs[i]); err != nil {
+			return false, err
+		}
+		for e.z.Sign() == 0 {
+			if _, err := rand.Read(zBytes[:]); err != nil {
+				return false, err
+			}
+			e.z.SetBytes(zBytes[:])
+		}
+		wellFormed = append(wellFormed, i)
+	}
+
+	invalid = append(invalid, findInvalid(entries, wellFormed)...)
+	if len(invalid) == 0 {
+		return true, nil
+	}
+	sort.Ints(invalid)
+	return false, &BatchVerifyError{Indices: invalid}
+}
+
+// findInvalid returns the indices in subset of the invalid signatures, halving
+// the subset as long as its random linear combination does not verify.
+func findInvalid(entries []batchEntry, subset []int) []int {
+	if len(subset) == 0 || verifyCombination(entries, subset) {
+		return nil
+	}
+	if len(subset) == 1 {
+		return []int{subset[0]}
+	}
+	mid := len(subset) / 2
+	return append(findInvalid(entries, subset[:mid]), findInvalid(entries, subset[mid:])...)
+}
+
+// verifyCombination checks the random linear combination of the signatures in subset.
+func verifyCombination(entries []batchEntry, subset []int) bool {
+	curveParams := twistededwards.GetEdwardsCurve()
+	order := &curveParams.Order
+
+	points := make([]twistededwards.PointAffine, 0, 2*len(subset)+1)
+	scalars := make([]fr.Element, 2*len(subset)+1)
+
+	var sumS, tmp big.Int
+	for k, i := range subset {
+		e := &entries[i]
+		sumS.Add(&sumS, tmp.Mul(&e.z, &e.S))
+
+		// -zᵢ⋅Rᵢ
+		points = append(points, e.R)
+		tmp.Neg(&e.z).Mod(&tmp, order)
+		scalars[2*k].SetBigInt(&tmp)
+
+		// -(zᵢ⋅H(Rᵢ,Aᵢ,Mᵢ))⋅Aᵢ
+		points = append(points, e.A)
+		tmp.Mul(&e.z, &e.hram).Neg(&tmp).Mod(&tmp, order)
+		scalars[2*k+1].SetBigInt(&tmp)
+	}
+	points = append(points, curveParams.Base)
+	tmp.Mod(&sumS, order)
+	scalars[2*len(subset)].SetBigInt(&tmp)
+
+	// the scalars are reduced modulo the order of the subgroup, which is smaller
+	// than the modulus of fr, so the multi-exponentiation is exact.
+	var res twistededwards.PointExtended
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false
+	}
+
+	var bCofactor big.Int
+	curveParams.Cofactor.BigInt(&bCofactor)
+	res.ScalarMultiplication(&res, &bCofactor)
+
+	return res.IsZero()
+}
@@ -0,0 +1,535 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidPolynomialSize = errors.New("invalid polynomial size (not a power of 2, < 2 or larger than the SRS)")
+	ErrInvalidNbVars         = errors.New("number of variables doesn't match the verifying key")
+	ErrInvalidPointSize      = errors.New("number of coordinates of the point is not the number of variables")
+	ErrInvalidNbDigests      = errors.New("number of digests is not the same as the number of polynomials")
+	ErrZeroNbDigests         = errors.New("number of digests is zero")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// VerifyingKey used to verify opening proofs of polynomials in NbVars variables.
+type VerifyingKey struct {
+	kzg.VerifyingKey
+
+	// NbVars is the number of variables n of the committed polynomials.
+	NbVars int
+
+	// G2Shift is [τᴺ⁻²ⁿ]₂, where N is the number of powers of τ in the proving key.
+	// The degree check relies on the prover not knowing [τⁱ]₁ for i ≥ N, so that
+	// N must be the size of the whole SRS; if it is 2ⁿ, G2Shift is G₂.
+	G2Shift bn254.G2Affine
+}
+
+// SRS must be computed through MPC and comprises the ProvingKey and the VerifyingKey
+type SRS struct {
+	Pk kzg.ProvingKey
+	Vk VerifyingKey
+}
+
+// NewSRS returns a new SRS for polynomials in nbVars variables, using alpha as
+// randomness source. The proving key has 2ⁿᵇᵛᵃʳˢ powers of alpha.
+//
+// In production, a SRS generated through MPC should be used.
+func NewSRS(nbVars int, bAlpha *big.Int) (*SRS, error) {
+	if nbVars < 1 {
+		return nil, ErrInvalidPolynomialSize
+	}
+	srs, err := kzg.NewSRS(1<<nbVars, bAlpha)
+	if err != nil {
+		return nil, err
+	}
+	return &SRS{
+		Pk: srs.Pk,
+		Vk: VerifyingKey{
+			VerifyingKey: srs.Vk,
+			NbVars:       nbVars,
+			G2Shift:      srs.Vk.G2[0],
+		},
+	}, nil
+}
+
+// OpeningProof proves that a multilinear polynomial f in n variables evaluates to
+// ClaimedValue at a point (u₁, ..., uₙ), following Zeromorph
+// (https://eprint.iacr.org/2023/917).
+//
+// The polynomial f is committed as the univariate polynomial ∑ᵢ f[i]Xⁱ, so that
+// the commitment is the KZG commitment of its evaluations on the hypercube. We
+// write f - v = ∑ₖ (Xₙ₋ₖ - uₙ₋ₖ)qₖ where the quotient qₖ only depends on the k
+// variables Xₙ₋ₖ₊₁, ..., Xₙ, i.e. the k least significant bits of the index.
+//
+// implements io.ReaderFrom and io.WriterTo
+type OpeningProof struct {
+	// Quotients[k] is the commitment to qₖ, for k < n
+	Quotients []kzg.Digest
+
+	// BatchedQuotient is the commitment to ∑ₖ yᵏXᴺ⁻²ᵏqₖ, where N = 2ⁿ
+	BatchedQuotient kzg.Digest
+
+	// H is the commitment to Xˢ(ζₓ + zZₓ)/(X - x), where ζₓ and Zₓ vanish at x
+	// and s is the shift of the verifying key
+	H kzg.Digest
+
+	// ClaimedValue is f(u₁, ..., uₙ)
+	ClaimedValue fr.Element
+}
+
+// BatchOpeningProof proves the evaluations of several multilinear polynomials fᵢ
+// at the same point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchOpeningProof struct {
+	// Proof is the opening proof of ∑ᵢ γⁱfᵢ
+	Proof OpeningProof
+
+	// ClaimedValues[i] is fᵢ(u₁, ..., uₙ)
+	ClaimedValues []fr.Element
+}
+
+// Commit commits to a multilinear polynomial, that is, to its evaluations on the
+// hypercube seen as the coefficients of a univariate polynomial.
+func Commit(p polynomial.MultiLin, pk kzg.ProvingKey, nbTasks ...int) (kzg.Digest, error) {
+	if _, err := nbVars(p, pk); err != nil {
+		return kzg.Digest{}, err
+	}
+	return kzg.Commit(p, pk, nbTasks...)
+}
+
+// Open computes an opening proof of p at point, where point[i] is the value of
+// Xᵢ₊₁ as in polynomial.MultiLin.Evaluate.
+//
+// dataTranscript is some extra data that might be needed for Fiat Shamir, and is
+// appended at the end of the original transcript.
+func Open(p polynomial.MultiLin, digest kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (OpeningProof, error) {
+	var proof OpeningProof
+	n, err := nbVars(p, pk)
+	if err != nil {
+		return proof, err
+	}
+	if len(point) != n {
+		return proof, ErrInvalidPointSize
+	}
+
+	quotients, claimedValue := computeQuotients(p, point)
+	proof.ClaimedValue = claimedValue
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindOpening(fs, "y", []kzg.Digest{digest}, point, []fr.Element{claimedValue}, dataTranscript); err != nil {
+		return proof, err
+	}
+	if err := prove(&proof, p, quotients, point, fs, pk); err != nil {
+		return proof, err
+	}
+	return proof, nil
+}
+
+// Verify checks that proof opens digest to proof.ClaimedValue at point.
+func Verify(digest *kzg.Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	if len(point) != vk.NbVars {
+		return ErrInvalidPointSize
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindOpening(fs, "y", []kzg.Digest{*digest}, point, []fr.Element{proof.ClaimedValue}, dataTranscript); err != nil {
+		return err
+	}
+	return verify(digest, proof, point, fs, vk)
+}
+
+// BatchOpen computes a proof of the evaluations of several multilinear
+// polynomials, with the same number of variables, at the same point. The
+// polynomials are folded into ∑ᵢ γⁱfᵢ, which is opened with a single proof.
+//
+// dataTranscript is some extra data that might be needed for Fiat Shamir, and is
+// appended at the end of the original transcript.
+func BatchOpen(polynomials []polynomial.MultiLin, digests []kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	var res BatchOpeningProof
+	if len(polynomials) != len(digests) {
+		return res, ErrInvalidNbDigests
+	}
+	if len(polynomials) == 0 {
+		return res, ErrZeroNbDigests
+	}
+	n, err := nbVars(polynomials[0], pk)
+	if err != nil {
+		return res, err
+	}
+	for i := 1; i < len(polynomials); i++ {
+		if len(polynomials[i]) != len(polynomials[0]) {
+			return res, ErrInvalidPolynomialSize
+		}
+	}
+	if len(point) != n {
+		return res, ErrInvalidPointSize
+	}
+
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	parallel.Execute(len(polynomials), func(start, end int) {
+		for i := start; i < end; i++ {
+			res.ClaimedValues[i] = polynomials[i].Evaluate(point, nil)
+		}
+	})
+
+	fs := fiatshamir.NewTranscript(hf, "gamma", "y", "x", "z")
+	if err := bindOpening(fs, "gamma", digests, point, res.ClaimedValues, dataTranscript); err != nil {
+		return res, err
+	}
+	gamma, err := deriveChallenge(fs, "gamma")
+	if err != nil {
+		return res, err
+	}
+
+	// f = ∑ᵢ γⁱfᵢ
+	f := polynomials[len(polynomials)-1].Clone()
+	for i := len(polynomials) - 2; i >= 0; i-- {
+		parallel.Execute(len(f), func(start, end int) {
+			for j := start; j < end; j++ {
+				f[j].Mul(&f[j], &gamma).Add(&f[j], &polynomials[i][j])
+			}
+		})
+	}
+
+	quotients, claimedValue := computeQuotients(f, point)
+	res.Proof.ClaimedValue = claimedValue
+	if err := prove(&res.Proof, f, quotients, point, fs, pk); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// BatchVerify checks that proof opens the i-th digest to proof.ClaimedValues[i]
+// at point.
+func BatchVerify(digests []kzg.Digest, proof *BatchOpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	if len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNbDigests
+	}
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+	if len(point) != vk.NbVars {
+		return ErrInvalidPointSize
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "gamma", "y", "x", "z")
+	if err := bindOpening(fs, "gamma", digests, point, proof.ClaimedValues, dataTranscript); err != nil {
+		return err
+	}
+	gamma, err := deriveChallenge(fs, "gamma")
+	if err != nil {
+		return err
+	}
+
+	// ∑ᵢ γⁱvᵢ must be the claimed value of the folded polynomial
+	var claimedValue fr.Element
+	for i := len(digests) - 1; i >= 0; i-- {
+		claimedValue.Mul(&claimedValue, &gamma).Add(&claimedValue, &proof.ClaimedValues[i])
+	}
+	if !claimedValue.Equal(&proof.Proof.ClaimedValue) {
+		return ErrVerifyOpeningProof
+	}
+
+	// ∑ᵢ γⁱ[fᵢ]
+	gammas := make([]fr.Element, len(digests))
+	gammas[0].SetOne()
+	for i := 1; i < len(gammas); i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+	var digest kzg.Digest
+	if _, err := digest.MultiExp(digests, gammas, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	return verify(&digest, &proof.Proof, point, fs, vk)
+}
+
+// nbVars returns the number of variables of p, checking that its size is a
+// power of 2 fitting in the proving key.
+func nbVars(p polynomial.MultiLin, pk kzg.ProvingKey) (int, error) {
+	if len(p) < 2 || len(p)&(len(p)-1) != 0 || len(p) > len(pk.G1) {
+		return 0, ErrInvalidPolynomialSize
+	}
+	return p.NumVars(), nil
+}
+
+// computeQuotients returns the quotients qₖ, k < n, such that
+// f - f(u) = ∑ₖ (Xₙ₋ₖ - uₙ₋ₖ)qₖ, and f(u).
+func computeQuotients(f polynomial.MultiLin, point []fr.Element) ([][]fr.Element, fr.Element) {
+	n := len(point)
+	quotients := make([][]fr.Element, n)
+	g := f.Clone()
+	for i := range point {
+		// q = g(1, X₂, ...) - g(0, X₂, ...), then g ← g(uᵢ₊₁, X₂, ...)
+		mid := len(g) / 2
+		q := make([]fr.Element, mid)
+		parallel.Execute(mid, func(start, end int) {
+			var t fr.Element
+			for j := start; j < end; j++ {
+				q[j].Sub(&g[mid+j], &g[j])
+				t.Mul(&q[j], &point[i])
+				g[j].Add(&g[j], &t)
+			}
+		})
+		g = g[:mid]
+		quotients[n-1-i] = q
+	}
+	return quotients, g[0]
+}
+
+// prove fills the commitments of proof, the claimed value being set and bound to
+// the transcript.
+func prove(proof *OpeningProof, f polynomial.MultiLin, quotients [][]fr.Element, point []fr.Element, fs *fiatshamir.Transcript, pk kzg.ProvingKey) error {
+	n := len(quotients)
+	size := len(f)
+	shift := len(pk.G1) - size
+
+	var err error
+	proof.Quotients = make([]kzg.Digest, n)
+	for k := range quotients {
+		if proof.Quotients[k], err = kzg.Commit(quotients[k], pk); err != nil {
+			return err
+		}
+		if err := fs.Bind("y", proof.Quotients[k].Marshal()); err != nil {
+			return err
+		}
+	}
+	y, err := deriveChallenge(fs, "y")
+	if err != nil {
+		return err
+	}
+
+	// q̂ = ∑ₖ yᵏXᴺ⁻²ᵏqₖ, which is supported on [N/2, N)
+	batchedQuotient := make([]fr.Element, size)
+	var yk fr.Element
+	yk.SetOne()
+	for k := range quotients {
+		offset := size - len(quotients[k])
+		parallel.Execute(len(quotients[k]), func(start, end int) {
+			var t fr.Element
+			for i := start; i < end; i++ {
+				t.Mul(&quotients[k][i], &yk)
+				batchedQuotient[offset+i].Add(&batchedQuotient[offset+i], &t)
+			}
+		})
+		yk.Mul(&yk, &y)
+	}
+	proof.BatchedQuotient, err = kzg.Commit(batchedQuotient[size/2:], kzg.ProvingKey{G1: pk.G1[size/2 : size]})
+	if err != nil {
+		return err
+	}
+	if err := fs.Bind("x", proof.BatchedQuotient.Marshal()); err != nil {
+		return err
+	}
+	x, err := deriveChallenge(fs, "x")
+	if err != nil {
+		return err
+	}
+	z, err := deriveChallenge(fs, "z")
+	if err != nil {
+		return err
+	}
+
+	// h = q̂ + z(f - vΦₙ(x)) - ∑ₖ cₖqₖ, which vanishes at x
+	constant, coefficients := evaluationCoefficients(point, x, y, z, &proof.ClaimedValue)
+	h := batchedQuotient
+	parallel.Execute(size, func(start, end int) {
+		var t fr.Element
+		for i := start; i < end; i++ {
+			t.Mul(&f[i], &z)
+			h[i].Add(&h[i], &t)
+		}
+	})
+	h[0].Sub(&h[0], &constant)
+	for k := range quotients {
+		parallel.Execute(len(quotients[k]), func(start, end int) {
+			var t fr.Element
+			for i := start; i < end; i++ {
+				t.Mul(&quotients[k][i], &coefficients[k])
+				h[i].Sub(&h[i], &t)
+			}
+		})
+	}
+
+	// h/(X-x), committed with the powers shifted by N_max - N
+	for i := size - 2; i >= 0; i-- {
+		var t fr.Element
+		t.Mul(&h[i+1], &x)
+		h[i].Add(&h[i], &t)
+	}
+	proof.H, err = kzg.Commit(h[1:], kzg.ProvingKey{G1: pk.G1[shift : shift+size-1]})
+	return err
+}
+
+// verify checks proof, the claimed value being bound to the transcript.
+func verify(digest *kzg.Digest, proof *OpeningProof, point []fr.Element, fs *fiatshamir.Transcript, vk VerifyingKey) error {
+	n := vk.NbVars
+	if len(proof.Quotients) != n {
+		return ErrInvalidNbVars
+	}
+
+	for k := range proof.Quotients {
+		if err := fs.Bind("y", proof.Quotients[k].Marshal()); err != nil {
+			return err
+		}
+	}
+	y, err := deriveChallenge(fs, "y")
+	if err != nil {
+		return err
+	}
+	if err := fs.Bind("x", proof.BatchedQuotient.Marshal()); err != nil {
+		return err
+	}
+	x, err := deriveChallenge(fs, "x")
+	if err != nil {
+		return err
+	}
+	z, err := deriveChallenge(fs, "z")
+	if err != nil {
+		return err
+	}
+
+	// [h] = [q̂] + z[f] - zvΦₙ(x)[1] - ∑ₖ cₖ[qₖ]
+	constant, coefficients := evaluationCoefficients(point, x, y, z, &proof.ClaimedValue)
+	points := make([]bn254.G1Affine, 0, n+3)
+	scalars := make([]fr.Element, 0, n+3)
+	points = append(points, proof.BatchedQuotient, *digest, vk.G1)
+	scalars = append(scalars, fr.One(), z, fr.Element{})
+	scalars[2].Neg(&constant)
+	for k := range proof.Quotients {
+		points = append(points, proof.Quotients[k])
+		scalars = append(scalars, fr.Element{})
+		scalars[3+k].Neg(&coefficients[k])
+	}
+	var h kzg.Digest
+	if _, err := h.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// e([h], [τˢ]₂) = e([H], [τ - x]₂)
+	var xH, negH kzg.Digest
+	var xBigInt big.Int
+	xH.ScalarMultiplication(&proof.H, x.BigInt(&xBigInt))
+	negH.Neg(&proof.H)
+	var check bool
+	if vk.G2Shift.Equal(&vk.G2[0]) {
+		h.Add(&h, &xH)
+		check, err = bn254.PairingCheckFixedQ(
+			[]bn254.G1Affine{h, negH},
+			vk.Lines[:],
+		)
+	} else {
+		check, err = bn254.PairingCheck(
+			[]bn254.G1Affine{h, xH, negH},
+			[]bn254.G2Affine{vk.G2Shift, vk.G2[0], vk.G2[1]},
+		)
+	}
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// evaluationCoefficients returns zvΦₙ(x) and the coefficients
+// cₖ = yᵏxᴺ⁻²ᵏ + z(x²ᵏΦₙ₋ₖ₋₁(x²ᵏ⁺¹) - uₙ₋ₖΦₙ₋ₖ(x²ᵏ)) of the quotients in
+// h = q̂ + z(f - vΦₙ(x)) - ∑ₖ cₖqₖ, where Φₘ(X) = ∑_{i<2ᵐ} Xⁱ = ∏_{j<m} (1 + X²ʲ).
+func evaluationCoefficients(point []fr.Element, x, y, z fr.Element, claimedValue *fr.Element) (fr.Element, []fr.Element) {
+	n := len(point)
+
+	// squares[k] = x²ᵏ
+	squares := make([]fr.Element, n)
+	squares[0] = x
+	for k := 1; k < n; k++ {
+		squares[k].Square(&squares[k-1])
+	}
+
+	// yPowers[k] = yᵏ
+	yPowers := make([]fr.Element, n)
+	yPowers[0].SetOne()
+	for k := 1; k < n; k++ {
+		yPowers[k].Mul(&yPowers[k-1], &y)
+	}
+
+	// from k = n-1 down to 0, phi = Φₙ₋ₖ(x²ᵏ) and power = xᴺ⁻²ᵏ
+	coefficients := make([]fr.Element, n)
+	var phi, phiNext, power, t fr.Element
+	phiNext.SetOne()
+	power.SetOne()
+	for k := n - 1; k >= 0; k-- {
+		power.Mul(&power, &squares[k])
+		phi.SetOne()
+		phi.Add(&phi, &squares[k]).Mul(&phi, &phiNext)
+
+		// z(x²ᵏΦₙ₋ₖ₋₁(x²ᵏ⁺¹) - uₙ₋ₖΦₙ₋ₖ(x²ᵏ))
+		coefficients[k].Mul(&squares[k], &phiNext)
+		t.Mul(&point[n-1-k], &phi)
+		coefficients[k].Sub(&coefficients[k], &t).Mul(&coefficients[k], &z)
+
+		// + yᵏxᴺ⁻²ᵏ
+		t.Mul(&yPowers[k], &power)
+		coefficients[k].Add(&coefficients[k], &t)
+
+		phiNext = phi
+	}
+
+	var constant fr.Element
+	constant.Mul(claimedValue, &phiNext).Mul(&constant, &z)
+	return constant, coefficients
+}
+
+// bindOpening binds the statement to the transcript under the given challenge.
+func bindOpening(fs *fiatshamir.Transcript, challenge string, digests []kzg.Digest, point, claimedValues []fr.Element, dataTranscript [][]byte) error {
+	for i := range digests {
+		if err := fs.Bind(challenge, digests[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind(challenge, point[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	for i := range claimedValues {
+		if err := fs.Bind(challenge, claimedValues[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind(challenge, dataTranscript[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveChallenge returns the challenge as a field element.
+func deriveChallenge(fs *fiatshamir.Transcript, challenge string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(challenge)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Test SRS re-used across tests of the Zeromorph scheme
+var (
+	testSrs *SRS
+	bAlpha  *big.Int
+)
+
+const testNbVars = 6
+
+func init() {
+	bAlpha = new(big.Int).SetInt64(42)
+	var err error
+	testSrs, err = NewSRS(testNbVars, bAlpha)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	p := make(polynomial.MultiLin, 1<<nbVars)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	point := make([]fr.Element, nbVars)
+	for i := range point {
+		point[i].SetRandom()
+	}
+	return point
+}
+
+func TestOpening(t *testing.T) {
+	assert := require.New(t)
+
+	for nbVars := 1; nbVars <= testNbVars; nbVars++ {
+		srs, err := NewSRS(nbVars, bAlpha)
+		assert.NoError(err)
+
+		p := randomMultiLin(nbVars)
+		digest, err := Commit(p, srs.Pk)
+		assert.NoError(err)
+
+		// the commitment is the univariate commitment of the evaluations
+		expected, err := kzg.Commit(p, srs.Pk)
+		assert.NoError(err)
+		assert.True(digest.Equal(&expected))
+
+		point := randomPoint(nbVars)
+		hf := sha256.New()
+		proof, err := Open(p, digest, point, hf, srs.Pk)
+		assert.NoError(err)
+		expectedValue := p.Evaluate(point, nil)
+		assert.True(proof.ClaimedValue.Equal(&expectedValue), "wrong claimed value")
+		assert.NoError(Verify(&digest, &proof, point, hf, srs.Vk))
+
+		// wrong claimed value
+		tampered := proof
+		tampered.ClaimedValue.SetRandom()
+		assert.Error(Verify(&digest, &tampered, point, hf, srs.Vk))
+
+		// wrong point
+		wrongPoint := randomPoint(nbVars)
+		assert.Error(Verify(&digest, &proof, wrongPoint, hf, srs.Vk))
+
+		// wrong digest
+		wrongDigest, err := Commit(randomMultiLin(nbVars), srs.Pk)
+		assert.NoError(err)
+		assert.Error(Verify(&wrongDigest, &proof, point, hf, srs.Vk))
+
+		// wrong quotient
+		tampered = proof
+		tampered.Quotients = append([]kzg.Digest{}, proof.Quotients...)
+		tampered.Quotients[0].Add(&tampered.Quotients[0], &srs.Vk.G1)
+		assert.Error(Verify(&digest, &tampered, point, hf, srs.Vk))
+
+		// wrong transcript data
+		assert.Error(Verify(&digest, &proof, point, hf, srs.Vk, []byte("data")))
+	}
+}
+
+func TestOpeningLargerSRS(t *testing.T) {
+	assert := require.New(t)
+
+	// the proving key has 2ⁿ + shift powers of α, the verifier uses [αˢʰⁱᶠᵗ]₂
+	const nbVars, shift = 4, 7
+	srs, err := kzg.NewSRS((1<<nbVars)+shift, bAlpha)
+	assert.NoError(err)
+	var alphaShift big.Int
+	alphaShift.Exp(bAlpha, big.NewInt(shift), fr.Modulus())
+	vk := VerifyingKey{VerifyingKey: srs.Vk, NbVars: nbVars}
+	vk.G2Shift.ScalarMultiplication(&srs.Vk.G2[0], &alphaShift)
+
+	p := randomMultiLin(nbVars)
+	digest, err := Commit(p, srs.Pk)
+	assert.NoError(err)
+	point := randomPoint(nbVars)
+	hf := sha256.New()
+	proof, err := Open(p, digest, point, hf, srs.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, hf, vk))
+
+	// a proof computed with a smaller proving key has the wrong shift
+	proof, err = Open(p, digest, point, hf, kzg.ProvingKey{G1: srs.Pk.G1[:1<<nbVars]})
+	assert.NoError(err)
+	assert.Error(Verify(&digest, &proof, point, hf, vk))
+}
+
+func TestBatchOpening(t *testing.T) {
+	assert := require.New(t)
+
+	const nbPolynomials = 5
+	polynomials := make([]polynomial.MultiLin, nbPolynomials)
+	digests := make([]kzg.Digest, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomMultiLin(testNbVars)
+		var err error
+		digests[i], err = Commit(polynomials[i], testSrs.Pk)
+		assert.NoError(err)
+	}
+	point := randomPoint(testNbVars)
+	hf := sha256.New()
+
+	proof, err := BatchOpen(polynomials, digests, point, hf, testSrs.Pk)
+	assert.NoError(err)
+	for i := range polynomials {
+		expected := polynomials[i].Evaluate(point, nil)
+		assert.True(proof.ClaimedValues[i].Equal(&expected), "wrong claimed value")
+	}
+	assert.NoError(BatchVerify(digests, &proof, point, hf, testSrs.Vk))
+
+	// wrong claimed value
+	proof.ClaimedValues[1].SetRandom()
+	assert.Error(BatchVerify(digests, &proof, point, hf, testSrs.Vk))
+	proof.ClaimedValues[1] = polynomials[1].Evaluate(point, nil)
+
+	// swapped digests
+	digests[0], digests[1] = digests[1], digests[0]
+	assert.Error(BatchVerify(digests, &proof, point, hf, testSrs.Vk))
+	digests[0], digests[1] = digests[1], digests[0]
+
+	// wrong number of digests
+	assert.ErrorIs(BatchVerify(digests[1:], &proof, point, hf, testSrs.Vk), ErrInvalidNbDigests)
+	_, err = BatchOpen(polynomials, digests[1:], point, hf, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+}
+
+func TestInvalidSizes(t *testing.T) {
+	assert := require.New(t)
+
+	hf := sha256.New()
+	_, err := Commit(make(polynomial.MultiLin, 3), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Commit(make(polynomial.MultiLin, 2<<testNbVars), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	p := randomMultiLin(3)
+	digest, err := Commit(p, testSrs.Pk)
+	assert.NoError(err)
+	_, err = Open(p, digest, randomPoint(2), hf, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPointSize)
+
+	// the verifying key is for testNbVars variables
+	proof, err := Open(p, digest, randomPoint(3), hf, testSrs.Pk)
+	assert.NoError(err)
+	assert.ErrorIs(Verify(&digest, &proof, randomPoint(3), hf, testSrs.Vk), ErrInvalidPointSize)
+}
+
+func TestSerialization(t *testing.T) {
+	p := randomMultiLin(testNbVars)
+	digest, err := Commit(p, testSrs.Pk)
+	require.NoError(t, err)
+	proof, err := Open(p, digest, randomPoint(testNbVars), sha256.New(), testSrs.Pk)
+	require.NoError(t, err)
+	t.Run("opening proof round trip", testutils.SerializationRoundTrip(&proof))
+
+	_, _, g, _ := bn254.Generators()
+	batchProof := BatchOpeningProof{Proof: proof, ClaimedValues: make([]fr.Element, 3)}
+	batchProof.Proof.H.Set(&g)
+	for i := range batchProof.ClaimedValues {
+		batchProof.ClaimedValues[i].SetRandom()
+	}
+	t.Run("batch opening proof round trip", testutils.SerializationRoundTrip(&batchProof))
+	t.Run("srs round trip", testutils.SerializationRoundTrip(testSrs))
+	t.Run("verifying key raw round trip", testutils.SerializationRoundTripRaw(&testSrs.Vk))
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const nbVars = 16
+	srs, err := NewSRS(nbVars, new(big.Int).SetInt64(-1))
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := randomMultiLin(nbVars)
+	digest, err := Commit(p, srs.Pk)
+	if err != nil {
+		b.Fatal(err)
+	}
+	point := randomPoint(nbVars)
+	hf := sha256.New()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Open(p, digest, point, hf, srs.Pk); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	const nbVars = 16
+	srs, err := NewSRS(nbVars, new(big.Int).SetInt64(-1))
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := randomMultiLin(nbVars)
+	digest, err := Commit(p, srs.Pk)
+	if err != nil {
+		b.Fatal(err)
+	}
+	point := randomPoint(nbVars)
+	hf := sha256.New()
+	proof, err := Open(p, digest, point, hf, srs.Pk)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Verify(&digest, &proof, point, hf, srs.Vk); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
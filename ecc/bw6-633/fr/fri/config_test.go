@@ -0,0 +1,76 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConfigCheck(t *testing.T) {
+
+	if err := DefaultConfig().Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, config := range []Config{
+		{BlowupFactor: 1, FoldingFactor: 2, NbQueries: 1},
+		{BlowupFactor: 6, FoldingFactor: 2, NbQueries: 1},
+		{BlowupFactor: 2, FoldingFactor: 3, NbQueries: 1},
+		{BlowupFactor: 2, FoldingFactor: 32, NbQueries: 1},
+		{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 0},
+		{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 1, GrindingBits: 33},
+	} {
+		if err := config.Check(); !errors.Is(err, ErrInvalidConfig) {
+			t.Fatalf("%+v should be invalid", config)
+		}
+	}
+}
+
+func TestFoldingArities(t *testing.T) {
+
+	config := Config{BlowupFactor: 2, FoldingFactor: 8, NbQueries: 1, FinalDegree: 3}
+	arities := foldingArities(1<<10, finalSize(1<<10, config), config)
+	expected := []int{8, 8, 4}
+	if len(arities) != len(expected) {
+		t.Fatal("wrong number of steps")
+	}
+	for i := range arities {
+		if arities[i] != expected[i] {
+			t.Fatal("wrong arities")
+		}
+	}
+
+	// at least one folding
+	config.FinalDegree = 1 << 20
+	if finalSize(1<<10, config) != 1<<9 {
+		t.Fatal("wrong final size")
+	}
+}
+
+func TestSecurityBits(t *testing.T) {
+
+	const size = 1 << 20
+	config := DefaultConfig()
+	conjectured := config.ConjecturedSecurityBits(size)
+	proven := config.ProvenSecurityBits(size)
+	if conjectured != 102 {
+		t.Fatalf("conjectured security of the default config is %f bits", conjectured)
+	}
+	if proven >= conjectured {
+		t.Fatal("proven security should be lower than the conjectured one")
+	}
+
+	// grinding adds bits, and more queries add bits
+	config.GrindingBits = 16
+	if config.ConjecturedSecurityBits(size) != conjectured+16 || config.ProvenSecurityBits(size) != proven+16 {
+		t.Fatal("grinding bits should be added")
+	}
+	config.NbQueries *= 2
+	if config.ProvenSecurityBits(size) <= proven+16 {
+		t.Fatal("more queries should increase the security")
+	}
+}
@@ -149,22 +149,10 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 	}
 
 	// compute H(R, A, M), all parameters in data are in Montgomery form
-	hFunc.Reset()
-
-	resRX := res.R.X.Bytes()
-	resRY := res.R.Y.Bytes()
-	resAX := privKey.PublicKey.A.X.Bytes()
-	resAY := privKey.PublicKey.A.Y.Bytes()
-	toWrite := [][]byte{resRX[:], resRY[:], resAX[:], resAY[:], message}
-	for _, bytes := range toWrite {
-		if _, err := hFunc.Write(bytes); err != nil {
-			return nil, err
-		}
-	}
-
 	var hramInt big.Int
-	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
+	if err := computeHRAM(&hramInt, hFunc, &res.R, &privKey.PublicKey.A, message); err != nil {
+		return nil, err
+	}
 
 	// Compute s = randScalarInt + H(R,A,M)*S
 	// going with big int to do ops mod curve order
@@ -183,6 +171,25 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 	return res.Bytes(), nil
 }
 
+// computeHRAM sets res to H(R, A, M) where R, A are given by their coordinates.
+func computeHRAM(res *big.Int, hFunc hash.Hash, R, A *twistededwards.PointAffine, message []byte) error {
+	hFunc.Reset()
+
+	RX := R.X.Bytes()
+	RY := R.Y.Bytes()
+	AX := A.X.Bytes()
+	AY := A.Y.Bytes()
+	toWrite := [][]byte{RX[:], RY[:], AX[:], AY[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return err
+		}
+	}
+
+	res.SetBytes(hFunc.Sum(nil))
+	return nil
+}
+
 // Verify verifies an eddsa signature
 func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
 
@@ -206,24 +213,10 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 	}
 
 	// compute H(R, A, M), all parameters in data are in Montgomery form
-
-	hFunc.Reset()
-
-	sigRX := sig.R.X.Bytes()
-	sigRY := sig.R.Y.Bytes()
-	sigAX := pub.A.X.Bytes()
-	sigAY := pub.A.Y.Bytes()
-
-	toWrite := [][]byte{sigRX[:], sigRY[:], sigAX[:], sigAY[:], message}
-	for _, bytes := range toWrite {
-		if _, err := hFunc.Write(bytes); err != nil {
-			return false, err
-		}
-	}
-
 	var hramInt big.Int
-	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
+	if err := computeHRAM(&hramInt, hFunc, &sig.R, &pub.A, message); err != nil {
+		return false, err
+	}
 
 	// lhs = cofactor*S*Base
 	var lhs twistededwards.PointAffine
@@ -0,0 +1,134 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+)
+
+func generateAggregate(t *testing.T, cs *Ciphersuite, n int, sameMessage bool) ([]PublicKey, [][]byte, []byte) {
+	pks := make([]PublicKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := cs.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pks[i] = privKey.PublicKey
+		if sameMessage {
+			msgs[i] = []byte("testing BLS aggregation")
+		} else {
+			msgs[i] = []byte(fmt.Sprintf("testing BLS aggregation %d", i))
+		}
+		if sigs[i], err = privKey.Sign(msgs[i], sha256.New()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sig, err := cs.Aggregate(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pks, msgs, sig
+}
+
+func TestAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	const n = 5
+	for _, cs := range ciphersuites {
+		pks, msgs, sig := generateAggregate(t, cs, n, false)
+
+		ok, err := cs.AggregateVerify(pks, msgs, sig, sha256.New())
+		if err != nil || !ok {
+			t.Fatal("valid aggregate signature should verify in " + cs.ID())
+		}
+
+		msgs[n-1] = []byte("tampered")
+		if ok, _ := cs.AggregateVerify(pks, msgs, sig, sha256.New()); ok {
+			t.Fatal("tampered aggregate signature should not verify in " + cs.ID())
+		}
+
+		if _, err := cs.AggregateVerify(pks[1:], msgs, sig, sha256.New()); err != ErrInvalidNbMessages {
+			t.Fatal("mismatched number of messages should be rejected in " + cs.ID())
+		}
+	}
+}
+
+func TestAggregateSameMessage(t *testing.T) {
+	t.Parallel()
+
+	const n = 4
+	for _, cs := range ciphersuites {
+		pks, msgs, sig := generateAggregate(t, cs, n, true)
+
+		ok, err := cs.AggregateVerify(pks, msgs, sig, sha256.New())
+		switch cs.Scheme() {
+		case Basic:
+			if err != ErrDuplicateMessages {
+				t.Fatal("duplicate messages should be rejected in " + cs.ID())
+			}
+		default:
+			if err != nil || !ok {
+				t.Fatal("valid aggregate signature should verify in " + cs.ID())
+			}
+		}
+
+		ok, err = cs.FastAggregateVerify(pks, msgs[0], sig, sha256.New())
+		if cs.Scheme() != ProofOfPossession {
+			if err != ErrNotProofOfPossession {
+				t.Fatal("FastAggregateVerify should only be available in the proof-of-possession scheme")
+			}
+			continue
+		}
+		if err != nil || !ok {
+			t.Fatal("valid aggregate signature should fast-verify in " + cs.ID())
+		}
+		if ok, _ := cs.FastAggregateVerify(pks[1:], msgs[0], sig, sha256.New()); ok {
+			t.Fatal("aggregate signature should not fast-verify with a missing key in " + cs.ID())
+		}
+	}
+}
+
+func TestAggregateCiphersuiteMismatch(t *testing.T) {
+	t.Parallel()
+
+	pks, msgs, sig := generateAggregate(t, MinPkPop, 2, false)
+	if _, err := MinPkAug.AggregateVerify(pks, msgs, sig, nil); err != ErrCiphersuiteMismatch {
+		t.Fatal("keys of another ciphersuite should be rejected")
+	}
+	if _, err := MinPkPop.Aggregate(nil); err != ErrEmptyAggregate {
+		t.Fatal("empty aggregate should be rejected")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkAggregateVerify(b *testing.B) {
+	const n = 64
+	for _, cs := range []*Ciphersuite{MinPkPop, MinSigPop} {
+		pks := make([]PublicKey, n)
+		msgs := make([][]byte, n)
+		sigs := make([][]byte, n)
+		for i := 0; i < n; i++ {
+			privKey, _ := cs.GenerateKey(rand.Reader)
+			pks[i] = privKey.PublicKey
+			msgs[i] = []byte(fmt.Sprintf("benchmarking BLS aggregation %d", i))
+			sigs[i], _ = privKey.Sign(msgs[i], nil)
+		}
+		sig, _ := cs.Aggregate(sigs)
+		b.Run(cs.ID(), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cs.AggregateVerify(pks, msgs, sig, nil)
+			}
+		})
+	}
+}
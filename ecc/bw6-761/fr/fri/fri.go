@@ -7,6 +7,7 @@ package fri
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"hash"
@@ -21,40 +22,47 @@ import (
 )
 
 var (
-	ErrLowDegree            = errors.New("the fully folded polynomial in not of degree 1")
+	ErrLowDegree            = errors.New("the fully folded polynomial is not of the expected degree")
 	ErrProximityTestFolding = errors.New("one round of interaction failed")
 	ErrOddSize              = errors.New("the size should be even")
 	ErrMerkleRoot           = errors.New("merkle roots of the opening and the proof of proximity don't coincide")
 	ErrMerklePath           = errors.New("merkle path proof is wrong")
 	ErrRangePosition        = errors.New("the asked opening position is out of range")
+	ErrClaimedValue         = errors.New("the claimed value does not match the opened leaf")
+	ErrProofOfWork          = errors.New("the proof of work nonce is wrong")
+	ErrProofShape           = errors.New("the proof of proximity does not match the parameters")
+	ErrUnknownIOPP          = errors.New("iopp name is not recognized")
 )
 
+// rho and nbRounds are the blowup factor and the number of queries of the
+// IOPP built with New.
 const rho = 8
 
 const nbRounds = 1
 
+// GetRho returns the factor ρ = size_code_word/size_polynomial
+//
+// Deprecated: it is the blowup factor of the IOPP built with New, use
+// NewWithConfig and Config.BlowupFactor instead.
+func GetRho() int {
+	return rho
+}
+
 // 2^{-1}, used several times
 var twoInv fr.Element
 
 // Digest commitment of a polynomial.
 type Digest []byte
 
-// merkleProof helper structure to build the merkle proof
-// At each round, two contiguous values from the evaluated polynomial
-// are queried. For one value, the full Merkle path will be provided.
-// For the neighbor value, only the leaf is provided (so ProofSet will
-// be empty), since the Merkle path is the same as for the first value.
+// MerkleProof opening of a leaf of one of the Merkle trees committing to the
+// successive folded polynomials. A leaf stores the evaluations of the folded
+// polynomial on a fiber of x -> xᵏ, where k is the folding factor of the step,
+// so that a single Merkle path is needed per query and per step.
 type MerkleProof struct {
 
-	// Merkle root
-	MerkleRoot []byte
-
-	// ProofSet stores [leaf ∥ node_1 ∥ .. ∥ merkleRoot ], where the leaf is not
+	// ProofSet stores [leaf ∥ node_1 ∥ .. ∥ node_n], where the leaf is not
 	// hashed.
 	ProofSet [][]byte
-
-	// number of leaves of the tree.
-	numLeaves uint64
 }
 
 // MerkleProof used to open a polynomial
@@ -78,32 +86,21 @@ type OpeningProof struct {
 type IOPP uint
 
 const (
-	// Multiplicative version of FRI, using the map x->x², on a
+	// Multiplicative version of FRI, using the map x->xᵏ, on a
 	// power of 2 subgroup of Fr^{*}.
 	RADIX_2_FRI IOPP = iota
 )
 
-// round contains the data corresponding to a single round
-// of fri.
-// It consists of a list of Interactions between the prover and the verifier,
-// where each interaction contains a challenge provided by the verifier, as
-// well as MerkleProofs for the queries of the verifier. The Merkle proofs
-// correspond to the openings of the i-th folded polynomial at 2 points that
-// belong to the same fiber of x -> x².
+// Round contains the data corresponding to a single query of the verifier.
+//
+// It consists of the openings of the successive folded polynomials at the
+// fibers containing the query. The fiber opened at step i+1 contains the
+// value obtained by folding the fiber opened at step i.
 type Round struct {
 
-	// stores the Interactions between the prover and the verifier.
-	// Each interaction results in a set or merkle proofs, corresponding
-	// to the queries of the verifier.
-	Interactions [][2]MerkleProof
-
-	// evaluation stores the evaluation of the fully folded polynomial.
-	// The fully folded polynomial is constant, and is evaluated on a
-	// a set of size \rho. Since the polynomial is supposed to be constant,
-	// only one evaluation, corresponding to the polynomial, is given. Since
-	// the prover cannot know in advance which entry the verifier will query,
-	// providing a single evaluation
-	Evaluation fr.Element
+	// Interactions[i] opens the leaf of the i-th Merkle tree containing the
+	// query.
+	Interactions []MerkleProof
 }
 
 // ProofOfProximity proof of proximity, attesting that
@@ -117,8 +114,20 @@ type ProofOfProximity struct {
 	// from the proof of proximity.
 	ID []byte
 
-	// round contains the data corresponding to a single round
-	// of fri. There are nbRounds rounds of Interactions.
+	// Roots of the Merkle trees committing to the initial codeword and to the
+	// successive folded codewords, one per folding step.
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the fully folded polynomial, sent in
+	// clear instead of being committed.
+	FinalPolynomial []fr.Element
+
+	// Nonce solution of the proof of work, of Config.GrindingBits bits,
+	// computed before deriving the queries.
+	Nonce uint64
+
+	// Rounds contains the data corresponding to each query of the
+	// verifier. There are Config.NbQueries rounds.
 	Rounds []Round
 }
 
@@ -140,25 +149,39 @@ type Iopp interface {
 	VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error
 }
 
-// GetRho returns the factor ρ = size_code_word/size_polynomial
-func GetRho() int {
-	return rho
-}
-
 func init() {
 	twoInv.SetUint64(2).Inverse(&twoInv)
 }
 
 // New creates a new IOPP capable to handle degree(size) polynomials.
+//
+// It uses a blowup factor of rho, folds by 2 down to a constant polynomial,
+// and the verifier makes nbRounds query, which gives a low soundness: use
+// NewWithConfig to choose the parameters.
 func (iopp IOPP) New(size uint64, h hash.Hash) Iopp {
 	switch iopp {
 	case RADIX_2_FRI:
-		return newRadixTwoFri(size, h)
+		return newRadixTwoFri(size, h, Config{BlowupFactor: rho, FoldingFactor: 2, NbQueries: nbRounds})
 	default:
 		panic("iopp name is not recognized")
 	}
 }
 
+// NewWithConfig creates a new IOPP capable to handle degree(size) polynomials,
+// with the parameters given in config (see DefaultConfig). It returns an error
+// if the config is invalid (see Config.Check).
+func (iopp IOPP) NewWithConfig(size uint64, h hash.Hash, config Config) (Iopp, error) {
+	if err := config.Check(); err != nil {
+		return nil, err
+	}
+	switch iopp {
+	case RADIX_2_FRI:
+		return newRadixTwoFri(size, h, config), nil
+	default:
+		return nil, ErrUnknownIOPP
+	}
+}
+
 // radixTwoFri empty structs implementing compressionFunction for
 // the squaring function.
 type radixTwoFri struct {
@@ -167,82 +190,95 @@ type radixTwoFri struct {
 	// the oracles.
 	h hash.Hash
 
-	// nbSteps number of Interactions between the prover and the verifier
-	nbSteps int
+	// config parameters of the protocol
+	config Config
+
+	// arities folding factor of each step, the number of steps being
+	// len(arities)
+	arities []int
+
+	// finalSize number of coefficients of the fully folded polynomial
+	finalSize int
 
 	// domain used to build the Reed Solomon code from the given polynomial.
-	// The size of the domain is ρ*size_polynomial.
+	// The size of the domain is ρ⁻¹*size_polynomial.
 	domain *fft.Domain
 }
 
-func newRadixTwoFri(size uint64, h hash.Hash) radixTwoFri {
+func newRadixTwoFri(size uint64, h hash.Hash, config Config) radixTwoFri {
 
 	var res radixTwoFri
+	res.config = config
+	res.h = h
 
-	// computing the number of steps
-	n := ecc.NextPowerOfTwo(size)
-	nbSteps := bits.TrailingZeros(uint(n))
-	res.nbSteps = nbSteps
+	// the folding steps
+	n := max(ecc.NextPowerOfTwo(size), 2)
+	res.finalSize = finalSize(n, config)
+	res.arities = foldingArities(n, res.finalSize, config)
 
 	// extending the domain
-	n = n * rho
-
-	// building the domains
-	res.domain = fft.NewDomain(n)
-
-	// hash function
-	res.h = h
+	res.domain = fft.NewDomain(n * config.BlowupFactor)
 
 	return res
 }
 
-// convertCanonicalSorted convert the index i, an entry in a
-// sorted polynomial, to the corresponding entry in canonical
-// representation. n is the size of the polynomial.
-func convertCanonicalSorted(i, n int) int {
+// finalSize returns the number of coefficients of the fully folded polynomial,
+// when folding a polynomial of size n. At least one folding step is done.
+func finalSize(n uint64, config Config) int {
+	return int(min(ecc.NextPowerOfTwo(config.FinalDegree+1), n/2))
+}
 
-	if i < n/2 {
-		return 2 * i
-	} else {
-		l := n - (i + 1)
-		l = 2 * l
-		return n - l - 1
+// foldingArities returns the folding factors of the steps folding a polynomial
+// of size n to a polynomial of size finalSize. All the steps use
+// config.FoldingFactor, except possibly the last one.
+func foldingArities(n uint64, finalSize int, config Config) []int {
+	var res []int
+	for m := int(n); m > finalSize; {
+		k := min(int(config.FoldingFactor), m/finalSize)
+		res = append(res, k)
+		m /= k
 	}
-
+	return res
 }
 
-// deriveQueriesPositions derives the indices of the oracle
-// function that the verifier has to pick, in sorted form.
-// * pos is the initial position, i.e. the logarithm of the first challenge
-// * size is the size of the initial polynomial
-// * The result is a slice of []int, where each entry is a tuple (iₖ), such that
-// the verifier needs to evaluate ∑ₖ oracle(iₖ)xᵏ to build
-// the folded function.
-func (s radixTwoFri) deriveQueriesPositions(pos int, size int) []int {
-
-	_s := size / 2
-	res := make([]int, s.nbSteps)
-	res[0] = pos
-	for i := 1; i < s.nbSteps; i++ {
-		t := (res[i-1] - (res[i-1] % 2)) / 2
-		res[i] = convertCanonicalSorted(t, _s)
-		_s = _s / 2
+// leaves returns the leaves of the Merkle tree committing to evaluations, on
+// a domain of size m = len(evaluations), for a folding factor k. Leaf i
+// contains the evaluations at gⁱ, g^{i+m/k}, .., g^{i+(k-1)m/k}, that is the
+// fiber of g^{ik} by x -> xᵏ.
+func leaves(evaluations []fr.Element, k int) [][]byte {
+	nbLeaves := len(evaluations) / k
+	res := make([][]byte, nbLeaves)
+	for i := range res {
+		res[i] = make([]byte, 0, k*fr.Bytes)
+		for t := 0; t < k; t++ {
+			b := evaluations[i+t*nbLeaves].Bytes()
+			res[i] = append(res[i], b[:]...)
+		}
 	}
-
 	return res
 }
 
-// sort orders the evaluation of a polynomial on a domain
-// such that contiguous entries are in the same fiber:
-// {q(g⁰), q(g^{n/2}), q(g¹), q(g^{1+n/2}),...,q(g^{n/2-1}), q(gⁿ⁻¹)}
-func sort(evaluations []fr.Element) []fr.Element {
-	q := make([]fr.Element, len(evaluations))
-	n := len(evaluations) / 2
-	for i := 0; i < n; i++ {
-		q[2*i].Set(&evaluations[i])
-		q[2*i+1].Set(&evaluations[i+n])
-	}
-	return q
+// parseLeaf returns the k evaluations stored in leaf.
+func parseLeaf(leaf []byte, k int) ([]fr.Element, error) {
+	if len(leaf) != k*fr.Bytes {
+		return nil, ErrMerklePath
+	}
+	res := make([]fr.Element, k)
+	for t := range res {
+		if err := res[t].SetBytesCanonical(leaf[t*fr.Bytes : (t+1)*fr.Bytes]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// evaluate returns the evaluations of p on the domain, in natural order.
+func (s radixTwoFri) evaluate(p []fr.Element) []fr.Element {
+	res := make([]fr.Element, s.domain.Cardinality)
+	copy(res, p)
+	s.domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res
 }
 
 // Opens a polynomial at gⁱ where i = position.
@@ -253,32 +289,18 @@ func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error)
 		return OpeningProof{}, ErrRangePosition
 	}
 
-	// put q in evaluation form
-	q := make([]fr.Element, s.domain.Cardinality)
-	copy(q, p)
-	s.domain.FFT(q, fft.DIF)
-	fft.BitReverse(q)
-
-	// sort q to have fibers in contiguous entries. The goal is to have one
-	// Merkle path for both openings of entries which are in the same fiber.
-	q = sort(q)
+	// the Merkle tree is the one of the first round of the proof of proximity
+	q := s.evaluate(p)
+	l := leaves(q, s.arities[0])
+	nbLeaves := uint64(len(l))
+	tree := newMerkleTree(s.h, l)
 
-	// build the Merkle proof, we the position is converted to fit the sorted polynomial
-	pos := convertCanonicalSorted(int(position), len(q))
-
-	tree := merkletree.New(s.h)
-	err := tree.SetIndex(uint64(pos))
-	if err != nil {
-		return OpeningProof{}, err
-	}
-	for i := 0; i < len(q); i++ {
-		tree.Push(q[i].Marshal())
-	}
 	var res OpeningProof
-	res.merkleRoot, res.ProofSet, res.index, res.numLeaves = tree.Prove()
-
-	// set the claimed value, which is the first entry of the Merkle proof
-	res.ClaimedValue.SetBytes(res.ProofSet[0])
+	res.merkleRoot = tree.root()
+	res.ProofSet = tree.prove(int(position % nbLeaves))
+	res.numLeaves = nbLeaves
+	res.index = position % nbLeaves
+	res.ClaimedValue.Set(&q[position])
 
 	return res, nil
 }
@@ -292,29 +314,30 @@ func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error)
 // those should be equal, if not an error is raised.
 func (s radixTwoFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
 
-	// To query the Merkle path, we look at the first series of Interactions, and check whether it's the point
-	// at 'position' or its neighbor that contains the full Merkle path.
-	var fullMerkleProof int
-	if len(pp.Rounds[0].Interactions[0][0].ProofSet) > len(pp.Rounds[0].Interactions[0][1].ProofSet) {
-		fullMerkleProof = 0
-	} else {
-		fullMerkleProof = 1
+	if position >= s.domain.Cardinality {
+		return ErrRangePosition
 	}
 
 	// check that the merkle roots coincide
-	if !bytes.Equal(openingProof.merkleRoot, pp.Rounds[0].Interactions[0][fullMerkleProof].MerkleRoot) {
+	if len(pp.Roots) == 0 || !bytes.Equal(openingProof.merkleRoot, pp.Roots[0]) {
 		return ErrMerkleRoot
 	}
 
-	// convert position to the sorted version
-	sizePoly := s.domain.Cardinality
-	pos := convertCanonicalSorted(int(position), int(sizePoly))
-
-	// check the Merkle proof
-	res := merkletree.VerifyProof(s.h, openingProof.merkleRoot, openingProof.ProofSet, uint64(pos), openingProof.numLeaves)
+	// check the Merkle proof of the leaf containing position
+	nbLeaves := s.domain.Cardinality / uint64(s.arities[0])
+	res := merkletree.VerifyProof(s.h, openingProof.merkleRoot, openingProof.ProofSet, position%nbLeaves, nbLeaves)
 	if !res {
 		return ErrMerklePath
 	}
+
+	// check the claimed value
+	fiber, err := parseLeaf(openingProof.ProofSet[0], s.arities[0])
+	if err != nil {
+		return err
+	}
+	if !fiber[position/nbLeaves].Equal(&openingProof.ClaimedValue) {
+		return ErrClaimedValue
+	}
 	return nil
 
 }
@@ -326,25 +349,25 @@ func (s radixTwoFri) VerifyOpening(position uint64, openingProof OpeningProof, p
 // p₁, p₂ of p in Fᵣ[Y]/(Y^{n/2}-1), expressed in Lagrange basis. Finally, it computes
 // p₁ + x*p₂ and returns it.
 //
-// * p is the polynomial to fold, in Lagrange basis, sorted like this: p = [p(1),p(-1),p(g),p(-g),p(g²),p(-g²),...]
-// * g is a generator of the subgroup of Fᵣ^{*} of size len(p)
+// * p is the polynomial to fold, in Lagrange basis, in natural order: p = [p(1),p(g),p(g²),...]
+// * gInv is the inverse of a generator of the subgroup of Fᵣ^{*} of size len(p)
 // * x is the folding challenge x, used to return p₁+x*p₂
-func foldPolynomialLagrangeBasis(pSorted []fr.Element, gInv, x fr.Element) []fr.Element {
+func foldPolynomialLagrangeBasis(p []fr.Element, gInv, x fr.Element) []fr.Element {
 
 	// we have the following system
 	// p₁(g²ⁱ)+gⁱp₂(g²ⁱ) = p(gⁱ)
 	// p₁(g²ⁱ)-gⁱp₂(g²ⁱ) = p(-gⁱ)
 	// we solve the system for p₁(g²ⁱ),p₂(g²ⁱ)
-	s := len(pSorted)
-	res := make([]fr.Element, s/2)
+	s := len(p) / 2
+	res := make([]fr.Element, s)
 
 	var p1, p2, acc fr.Element
 	acc.SetOne()
 
-	for i := 0; i < s/2; i++ {
+	for i := 0; i < s; i++ {
 
-		p1.Add(&pSorted[2*i], &pSorted[2*i+1])
-		p2.Sub(&pSorted[2*i], &pSorted[2*i+1]).Mul(&p2, &acc)
+		p1.Add(&p[i], &p[i+s])
+		p2.Sub(&p[i], &p[i+s]).Mul(&p2, &acc)
 		res[i].Mul(&p2, &x).Add(&res[i], &p1).Mul(&res[i], &twoInv)
 
 		acc.Mul(&acc, &gInv)
@@ -354,334 +377,299 @@ func foldPolynomialLagrangeBasis(pSorted []fr.Element, gInv, x fr.Element) []fr.
 	return res
 }
 
-// buildProofOfProximitySingleRound generates a proof that a function, given as an oracle from
-// the verifier point of view, is in fact δ-close to a polynomial.
-// * salt is a variable for multi rounds, it allows to generate different challenges using Fiat Shamir
-// * p is in evaluation form
-func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.Element) (Round, error) {
-
-	// the proof will contain nbSteps Interactions
-	var res Round
-	res.Interactions = make([][2]MerkleProof, s.nbSteps)
-
-	// Fiat Shamir transcript to derive the challenges. The xᵢ are used to fold the
-	// polynomials.
-	// During the i-th round, the prover has a polynomial P of degree n. The verifier sends
-	// xᵢ∈ Fᵣ to the prover. The prover expresses F in Fᵣ[X,Y]/<Y-X²> as
-	// P₀(Y)+X P₁(Y) where P₀, P₁ are of degree n/2, and he then folds the polynomial
-	// by replacing x by xᵢ.
-	xis := make([]string, s.nbSteps+1)
-	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
-	}
-	xis[s.nbSteps] = "s0"
-	fs := fiatshamir.NewTranscript(s.h, xis...)
-
-	// the salt is binded to the first challenge, to ensure the challenges
-	// are different at each round.
-	err := fs.Bind(xis[0], salt.Marshal())
-	if err != nil {
-		return Round{}, err
+// foldFiber folds the evaluations of a polynomial on a fiber of x -> xᵏ, as
+// stored in a leaf, where k = len(fiber). The fiber is {g^{i+tm/k}}ₜ, where m
+// is the size of the domain and gInv is the inverse of its generator g. The
+// result is the evaluation at g^{ik} of the polynomial folded with x.
+//
+// Folding by k is done as log(k) successive foldings by 2 with the challenges
+// x, x², x⁴, .., as in the prover. fiber is modified.
+func foldFiber(fiber []fr.Element, i, m int, gInv, x fr.Element) fr.Element {
+
+	// gInvI = g⁻ⁱ, zetaInv = g^{-m/k}
+	var gInvI, zetaInv, w, p1, p2 fr.Element
+	gInvI.Exp(gInv, big.NewInt(int64(i)))
+	zetaInv.Exp(gInv, big.NewInt(int64(m/len(fiber))))
+
+	for k := len(fiber); k > 1; k /= 2 {
+		half := k / 2
+		w.Set(&gInvI)
+		for t := 0; t < half; t++ {
+			p1.Add(&fiber[t], &fiber[t+half])
+			p2.Sub(&fiber[t], &fiber[t+half]).Mul(&p2, &w)
+			fiber[t].Mul(&p2, &x).Add(&fiber[t], &p1).Mul(&fiber[t], &twoInv)
+			w.Mul(&w, &zetaInv)
+		}
+		gInvI.Square(&gInvI)
+		zetaInv.Square(&zetaInv)
+		x.Square(&x)
 	}
 
-	// step 1 : fold the polynomial using the xi
-
-	// evalsAtRound stores the list of the nbSteps polynomial evaluations, each evaluation
-	// corresponds to the evaluation o the folded polynomial at round i.
-	evalsAtRound := make([][]fr.Element, s.nbSteps)
-
-	// evaluate p and sort the result
-	_p := make([]fr.Element, s.domain.Cardinality)
-	copy(_p, p)
-
-	// gInv inverse of the generator of the cyclic group of size the size of the polynomial.
-	// The size of the cyclic group is ρ*s.domainSize, and not s.domainSize.
-	var gInv fr.Element
-	gInv.Set(&s.domain.GeneratorInv)
-
-	for i := 0; i < s.nbSteps; i++ {
+	return fiber[0]
+}
 
-		evalsAtRound[i] = sort(_p)
+// newTranscript returns the Fiat Shamir transcript used to derive the folding
+// challenges x₀, x₁, .., the proof of work challenge and the queries.
+func (s radixTwoFri) newTranscript() *fiatshamir.Transcript {
+	xis := make([]string, len(s.arities)+2)
+	for i := range s.arities {
+		xis[i] = fmt.Sprintf("x%d", i)
+	}
+	xis[len(s.arities)] = "pow"
+	xis[len(s.arities)+1] = "queries"
+	return fiatshamir.NewTranscript(s.h, xis...)
+}
 
-		// compute the root hash, needed to derive xi
-		t := merkletree.New(s.h)
-		for k := 0; k < len(_p); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
-		}
-		rh := t.Root()
-		err := fs.Bind(xis[i], rh)
-		if err != nil {
-			return res, err
+// deriveChallenge binds data to the challenge name and returns it as a field
+// element.
+func deriveChallenge(fs *fiatshamir.Transcript, name string, data ...[]byte) (fr.Element, error) {
+	for i := range data {
+		if err := fs.Bind(name, data[i]); err != nil {
+			return fr.Element{}, err
 		}
+	}
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
 
-		// derive the challenge
-		bxi, err := fs.ComputeChallenge(xis[i])
-		if err != nil {
-			return res, err
+// powChallenge binds the fully folded polynomial to the transcript, and
+// returns the challenge of the proof of work.
+func powChallenge(fs *fiatshamir.Transcript, finalPolynomial []fr.Element) ([]byte, error) {
+	for i := range finalPolynomial {
+		if err := fs.Bind("pow", finalPolynomial[i].Marshal()); err != nil {
+			return nil, err
 		}
-		var xi fr.Element
-		xi.SetBytes(bxi)
-
-		// fold _p, reusing its memory
-		_p = foldPolynomialLagrangeBasis(evalsAtRound[i], gInv, xi)
+	}
+	return fs.ComputeChallenge("pow")
+}
 
-		// g <- g²
-		gInv.Square(&gInv)
+// grind returns the smallest nonce solving the proof of work for challenge.
+func (s radixTwoFri) grind(challenge []byte) uint64 {
+	nonce := uint64(0)
+	for !s.checkProofOfWork(challenge, nonce) {
+		nonce++
+	}
+	return nonce
+}
 
+// checkProofOfWork returns true if H(challenge ∥ nonce) starts with
+// Config.GrindingBits zero bits. Without grinding, the nonce must be 0.
+func (s radixTwoFri) checkProofOfWork(challenge []byte, nonce uint64) bool {
+	if s.config.GrindingBits == 0 {
+		return nonce == 0
 	}
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	s.h.Reset()
+	s.h.Write(challenge)
+	s.h.Write(bNonce[:])
+	return leadingZeros(s.h.Sum(nil)) >= s.config.GrindingBits
+}
 
-	// last round, provide the evaluation. The fully folded polynomial is of size rho. It should
-	// correspond to the evaluation of a polynomial of degree 1 on ρ points, so those points
-	// are supposed to be on a line.
-	res.Evaluation.Set(&_p[0])
+// leadingZeros returns the number of leading zero bits of b.
+func leadingZeros(b []byte) int {
+	for i := range b {
+		if b[i] != 0 {
+			return 8*i + bits.LeadingZeros8(b[i])
+		}
+	}
+	return 8 * len(b)
+}
 
-	// step 2: provide the Merkle proofs of the queries
+// deriveQueries checks the proof of work nonce and derives the positions of
+// the verifier queries in the initial domain.
+func (s radixTwoFri) deriveQueries(fs *fiatshamir.Transcript, challenge []byte, nonce uint64) ([]uint64, error) {
 
-	// derive the verifier queries
-	err = fs.Bind(xis[s.nbSteps], res.Evaluation.Marshal())
-	if err != nil {
-		return res, err
+	if !s.checkProofOfWork(challenge, nonce) {
+		return nil, ErrProofOfWork
 	}
-	binSeed, err := fs.ComputeChallenge(xis[s.nbSteps])
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	if err := fs.Bind("queries", bNonce[:]); err != nil {
+		return nil, err
+	}
+	seed, err := fs.ComputeChallenge("queries")
 	if err != nil {
-		return res, err
+		return nil, err
 	}
-	var bPos, bCardinality big.Int
-	bPos.SetBytes(binSeed)
-	bCardinality.SetUint64(s.domain.Cardinality)
-	bPos.Mod(&bPos, &bCardinality)
-	si := s.deriveQueriesPositions(int(bPos.Uint64()), int(s.domain.Cardinality))
-
-	for i := 0; i < s.nbSteps; i++ {
 
-		// build proofs of queries at s[i]
-		t := merkletree.New(s.h)
-		err := t.SetIndex(uint64(si[i]))
-		if err != nil {
-			return res, err
-		}
-		for k := 0; k < len(evalsAtRound[i]); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
-		}
-		mr, ProofSet, _, numLeaves := t.Prove()
-
-		// c denotes the entry that contains the full Merkle proof. The entry 1-c will
-		// only contain 2 elements, which are the neighbor point, and the hash of the
-		// first point. The remaining of the Merkle path is common to both the original
-		// point and its neighbor.
-		c := si[i] % 2
-		res.Interactions[i][c] = MerkleProof{mr, ProofSet, numLeaves}
-		res.Interactions[i][1-c] = MerkleProof{
-			mr,
-			make([][]byte, 2),
-			numLeaves,
-		}
-		res.Interactions[i][1-c].ProofSet[0] = evalsAtRound[i][si[i]+1-2*c].Marshal()
+	// the domain size is a power of 2, so that the queries are uniform
+	res := make([]uint64, s.config.NbQueries)
+	var bi [4]byte
+	for i := range res {
+		binary.BigEndian.PutUint32(bi[:], uint32(i))
 		s.h.Reset()
-		_, err = s.h.Write(res.Interactions[i][c].ProofSet[0])
-		if err != nil {
-			return res, err
-		}
-		res.Interactions[i][1-c].ProofSet[1] = s.h.Sum(nil)
-
+		s.h.Write(seed)
+		s.h.Write(bi[:])
+		res[i] = binary.BigEndian.Uint64(s.h.Sum(nil)[:8]) & (s.domain.Cardinality - 1)
 	}
-
 	return res, nil
-
 }
 
 // BuildProofOfProximity generates a proof that a function, given as an oracle from
 // the verifier point of view, is in fact δ-close to a polynomial.
+//
+// During the i-th step, the prover has a polynomial P of degree n. The verifier sends
+// xᵢ∈ Fᵣ to the prover. The prover expresses P as ∑ⱼ XʲPⱼ(Xᵏ), where the Pⱼ are of
+// degree n/k, and he then folds the polynomial into ∑ⱼ xᵢʲPⱼ. The fully folded
+// polynomial is sent in clear, the queries being derived after a proof of work.
 func (s radixTwoFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, error) {
 
-	// the proof will contain nbSteps Interactions
 	var proof ProofOfProximity
-	proof.Rounds = make([]Round, nbRounds)
+	nbSteps := len(s.arities)
+	proof.Roots = make([][]byte, nbSteps)
+	trees := make([]*merkleTree, nbSteps)
 
-	// evaluate p
-	// evaluate p and sort the result
-	_p := make([]fr.Element, s.domain.Cardinality)
-	copy(_p, p)
-	s.domain.FFT(_p, fft.DIF)
-	fft.BitReverse(_p)
+	fs := s.newTranscript()
+
+	// step 1 : fold the polynomial using the xi
+	_p := s.evaluate(p)
+
+	// gInv inverse of the generator of the cyclic group of size the size of the
+	// evaluated polynomial, that is ρ⁻¹*size_polynomial.
+	var gInv fr.Element
+	gInv.Set(&s.domain.GeneratorInv)
+
+	for i, k := range s.arities {
 
-	var err error
-	var salt, one fr.Element
-	one.SetOne()
-	for i := 0; i < nbRounds; i++ {
-		proof.Rounds[i], err = s.buildProofOfProximitySingleRound(salt, _p)
+		// commit to the evaluations, and derive xi
+		trees[i] = newMerkleTree(s.h, leaves(_p, k))
+		proof.Roots[i] = trees[i].root()
+		xi, err := deriveChallenge(fs, fmt.Sprintf("x%d", i), proof.Roots[i])
 		if err != nil {
 			return proof, err
 		}
-		salt.Add(&salt, &one)
+
+		// fold _p by k, with x, x², x⁴, ...
+		for ; k > 1; k /= 2 {
+			_p = foldPolynomialLagrangeBasis(_p, gInv, xi)
+			gInv.Square(&gInv)
+			xi.Square(&xi)
+		}
 	}
 
-	return proof, nil
-}
+	// step 2: send the fully folded polynomial in canonical form
+	finalDomain := fft.NewDomain(uint64(len(_p)))
+	finalDomain.FFTInverse(_p, fft.DIF)
+	fft.BitReverse(_p)
+	proof.FinalPolynomial = _p[:s.finalSize]
 
-// verifyProofOfProximitySingleRound verifies the proof of proximity. It returns an error if the
-// verification fails.
-func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Round) error {
+	// step 3: grind, and provide the Merkle proofs of the queries
+	challenge, err := powChallenge(fs, proof.FinalPolynomial)
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = s.grind(challenge)
+	queries, err := s.deriveQueries(fs, challenge, proof.Nonce)
+	if err != nil {
+		return proof, err
+	}
 
-	// Fiat Shamir transcript to derive the challenges
-	xis := make([]string, s.nbSteps+1)
-	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
+	proof.Rounds = make([]Round, len(queries))
+	for q, pos := range queries {
+		proof.Rounds[q].Interactions = make([]MerkleProof, nbSteps)
+		for i := range trees {
+			pos %= uint64(trees[i].nbLeaves())
+			proof.Rounds[q].Interactions[i].ProofSet = trees[i].prove(int(pos))
+		}
 	}
-	xis[s.nbSteps] = "s0"
-	fs := fiatshamir.NewTranscript(s.h, xis...)
 
-	xi := make([]fr.Element, s.nbSteps)
+	return proof, nil
+}
+
+// VerifyProofOfProximity verifies the proof, by checking for each query the
+// Merkle proofs and the consistency of the successive foldings, and that the
+// fully folded polynomial is of the expected degree.
+func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
 
-	// the salt is binded to the first challenge, to ensure the challenges
-	// are different at each round.
-	err := fs.Bind(xis[0], salt.Marshal())
-	if err != nil {
-		return err
+	nbSteps := len(s.arities)
+	if len(proof.Roots) != nbSteps || len(proof.Rounds) != s.config.NbQueries {
+		return ErrProofShape
+	}
+	if len(proof.FinalPolynomial) != s.finalSize {
+		return ErrLowDegree
 	}
 
-	for i := 0; i < s.nbSteps; i++ {
-		err := fs.Bind(xis[i], proof.Interactions[i][0].MerkleRoot)
-		if err != nil {
-			return err
-		}
-		bxi, err := fs.ComputeChallenge(xis[i])
+	// Fiat Shamir transcript to derive the challenges
+	fs := s.newTranscript()
+	xi := make([]fr.Element, nbSteps)
+	for i := range xi {
+		var err error
+		xi[i], err = deriveChallenge(fs, fmt.Sprintf("x%d", i), proof.Roots[i])
 		if err != nil {
 			return err
 		}
-		xi[i].SetBytes(bxi)
 	}
-
-	// derive the verifier queries
-	// for i := 0; i < len(proof.evaluation); i++ {
-	// 	err := fs.Bind(xis[s.nbSteps], proof.evaluation[i].Marshal())
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// }
-	err = fs.Bind(xis[s.nbSteps], proof.Evaluation.Marshal())
+	challenge, err := powChallenge(fs, proof.FinalPolynomial)
 	if err != nil {
 		return err
 	}
-	binSeed, err := fs.ComputeChallenge(xis[s.nbSteps])
+	queries, err := s.deriveQueries(fs, challenge, proof.Nonce)
 	if err != nil {
 		return err
 	}
-	var bPos, bCardinality big.Int
-	bPos.SetBytes(binSeed)
-	bCardinality.SetUint64(s.domain.Cardinality)
-	bPos.Mod(&bPos, &bCardinality)
-	si := s.deriveQueriesPositions(int(bPos.Uint64()), int(s.domain.Cardinality))
-
-	// for each round check the Merkle proof and the correctness of the folding
-
-	// current size of the polynomial
-	var accGInv fr.Element
-	accGInv.Set(&s.domain.GeneratorInv)
-	for i := 0; i < s.nbSteps; i++ {
-
-		// correctness of Merkle proof
-		// c is the entry containing the full Merkle proof.
-		c := si[i] % 2
-		res := merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][c].MerkleRoot,
-			proof.Interactions[i][c].ProofSet,
-			uint64(si[i]),
-			proof.Interactions[i][c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
-		}
 
-		// we verify the Merkle proof for the neighbor query, to do that we have
-		// to pick the full Merkle proof of the first entry, stripped off of the leaf and
-		// the first node. We replace the leaf and the first node by the leaf and the first
-		// node of the partial Merkle proof, since the leaf and the first node of both proofs
-		// are the only entries that differ.
-		ProofSet := make([][]byte, len(proof.Interactions[i][c].ProofSet))
-		copy(ProofSet[2:], proof.Interactions[i][c].ProofSet[2:])
-		ProofSet[0] = proof.Interactions[i][1-c].ProofSet[0]
-		ProofSet[1] = proof.Interactions[i][1-c].ProofSet[1]
-		res = merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][1-c].MerkleRoot,
-			ProofSet,
-			uint64(si[i]+1-2*c),
-			proof.Interactions[i][1-c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
+	// inverse generators of the successive domains, and generator of the
+	// final one
+	gInvs := make([]fr.Element, nbSteps)
+	gInvs[0].Set(&s.domain.GeneratorInv)
+	var gFinal fr.Element
+	gFinal.Set(&s.domain.Generator)
+	for i := range s.arities {
+		if i > 0 {
+			gInvs[i].Exp(gInvs[i-1], big.NewInt(int64(s.arities[i-1])))
 		}
+		gFinal.Exp(gFinal, big.NewInt(int64(s.arities[i])))
+	}
 
-		// correctness of the folding
-		if i < s.nbSteps-1 {
-
-			var fe, fo, l, r, fn fr.Element
+	for q, pos := range queries {
 
-			// l = P(gⁱ), r = P(g^{i+n/2})
-			l.SetBytes(proof.Interactions[i][0].ProofSet[0])
-			r.SetBytes(proof.Interactions[i][1].ProofSet[0])
+		if len(proof.Rounds[q].Interactions) != nbSteps {
+			return ErrProofShape
+		}
 
-			// (g^{si[i]}, g^{si[i]+1}) is the fiber of g^{2*si[i]}. The system to solve
-			// (for P₀(g^{2si[i]}), P₀(g^{2si[i]}) ) is:
-			// P(g^{si[i]}) = P₀(g^{2si[i]}) +  g^{si[i]/2}*P₀(g^{2si[i]})
-			// P(g^{si[i]+1}) = P₀(g^{2si[i]}) -  g^{si[i]/2}*P₀(g^{2si[i]})
-			bm := big.NewInt(int64(si[i] / 2))
-			var ginv fr.Element
-			ginv.Exp(accGInv, bm)
-			fe.Add(&l, &r)                                      // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Sub(&l, &r).Mul(&fo, &ginv)                      // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Mul(&fo, &xi[i]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
+		// for each step check the Merkle proof and the correctness of the folding
+		var folded fr.Element
+		m := s.domain.Cardinality
+		for i, k := range s.arities {
 
-			fn.SetBytes(proof.Interactions[i+1][si[i+1]%2].ProofSet[0])
+			// correctness of Merkle proof
+			nbLeaves := m / uint64(k)
+			proofSet := proof.Rounds[q].Interactions[i].ProofSet
+			if !merkletree.VerifyProof(s.h, proof.Roots[i], proofSet, pos%nbLeaves, nbLeaves) {
+				return ErrMerklePath
+			}
+			fiber, err := parseLeaf(proofSet[0], k)
+			if err != nil {
+				return err
+			}
 
-			if !fo.Equal(&fn) {
+			// the fiber contains the value obtained by folding the previous one
+			if i > 0 && !fiber[pos/nbLeaves].Equal(&folded) {
 				return ErrProximityTestFolding
 			}
 
-			// next inverse generator
-			accGInv.Square(&accGInv)
+			pos %= nbLeaves
+			folded = foldFiber(fiber, int(pos), int(m), gInvs[i], xi[i])
+			m = nbLeaves
 		}
 
-	}
-
-	// last transition
-	var fe, fo, l, r fr.Element
-
-	l.SetBytes(proof.Interactions[s.nbSteps-1][0].ProofSet[0])
-	r.SetBytes(proof.Interactions[s.nbSteps-1][1].ProofSet[0])
-
-	_si := si[s.nbSteps-1] / 2
-
-	accGInv.Exp(accGInv, big.NewInt(int64(_si)))
-
-	fe.Add(&l, &r)                                                // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Sub(&l, &r).Mul(&fo, &accGInv)                             // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Mul(&fo, &xi[s.nbSteps-1]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
-
-	// Last step: the final evaluation should be the evaluation of a degree 0 polynomial,
-	// so it must be constant.
-	if !fo.Equal(&proof.Evaluation) {
-		return ErrProximityTestFolding
-	}
-
-	return nil
-}
-
-// VerifyProofOfProximity verifies the proof, by checking each interaction one
-// by one.
-func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
-
-	var salt, one fr.Element
-	one.SetOne()
-	for i := 0; i < nbRounds; i++ {
-		err := s.verifyProofOfProximitySingleRound(salt, proof.Rounds[i])
-		if err != nil {
-			return err
+		// the last folded value is the evaluation of the final polynomial
+		var x, eval fr.Element
+		x.Exp(gFinal, new(big.Int).SetUint64(pos))
+		for i := len(proof.FinalPolynomial) - 1; i >= 0; i-- {
+			eval.Mul(&eval, &x).Add(&eval, &proof.FinalPolynomial[i])
+		}
+		if !eval.Equal(&folded) {
+			return ErrProximityTestFolding
 		}
-		salt.Add(&salt, &one)
 	}
+
 	return nil
 
 }
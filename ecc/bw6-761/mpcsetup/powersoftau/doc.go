@@ -0,0 +1,18 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package powersoftau implements the phase 1 of a multiparty setup ceremony,
+// producing the powers of τ of the Groth16 setup, as in the Perpetual Powers
+// of Tau ceremony:
+//
+//   - [τⁱ]₁ for i < 2N-1
+//   - [τⁱ]₂, [ατⁱ]₁ and [βτⁱ]₁ for i < N
+//   - [β]₂
+//
+// Each contribution is proven with mpcsetup.UpdateValues. A transcript
+// holds the whole chain of contributions, and can be verified while reading it.
+//
+// See https://eprint.iacr.org/2017/1050.pdf (MMORPG).
+package powersoftau
@@ -0,0 +1,56 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ed25519
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fp"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fp.Element
+	Cofactor fp.Element
+	Order    big.Int
+	Base     PointAffine
+}
+
+// GetEdwardsCurve returns the twisted Edwards curve on ed25519/Fp
+func GetEdwardsCurve() CurveParams {
+	initOnce.Do(initCurveParams)
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&curveParams.A)
+	res.D.Set(&curveParams.D)
+	res.Cofactor.Set(&curveParams.Cofactor)
+	res.Order.Set(&curveParams.Order)
+	res.Base.Set(&curveParams.Base)
+
+	return res
+}
+
+var (
+	initOnce    sync.Once
+	curveParams CurveParams
+)
+
+func initCurveParams() {
+	curveParams.A.SetString("-1")
+	curveParams.D.SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555")
+	curveParams.Cofactor.SetString("8")
+	curveParams.Order.SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+
+	curveParams.Base.X.SetString("15112221349535400772501151409588531511454012693041857206046113283949847762202")
+	curveParams.Base.Y.SetString("46316835694926478169428394003475163141307993866256225615783033603165251855960")
+}
+
+// mulByA multiplies fp.Element by curveParams.A
+func mulByA(x *fp.Element) {
+	x.Neg(x)
+}
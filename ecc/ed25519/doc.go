@@ -0,0 +1,11 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ed25519 provides the edwards25519 twisted Edwards curve, birationally
+// equivalent to Curve25519, defined on GF(2²⁵⁵-19).
+//
+// The points follow the API of the twisted Edwards "companion curves" and are
+// encoded as in https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2.
+package ed25519
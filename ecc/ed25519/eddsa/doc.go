@@ -0,0 +1,15 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package eddsa provides the Ed25519, Ed25519ph and Ed25519ctx signature schemes
+// of RFC 8032 on the edwards25519 curve.
+//
+// Keys and signatures use the encodings of RFC 8032, so they interoperate with
+// other Ed25519 implementations (e.g. crypto/ed25519). A private key is derived
+// from a 32 bytes seed; decoding of points and scalars is strict and the
+// cofactored verification equation [8][S]B = [8]R + [8][k]A is used.
+//
+// # See also
+//
+// https://www.rfc-editor.org/rfc/rfc8032
+package eddsa
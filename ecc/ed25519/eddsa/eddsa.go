@@ -0,0 +1,315 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eddsa
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var (
+	errInvalidSeed    = errors.New("seed must be 32 bytes long")
+	errInvalidContext = errors.New("invalid context for the Ed25519 variant")
+	errInvalidVariant = errors.New("unknown Ed25519 variant")
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizePublicKey  = fp.Bytes
+	sizeSignature  = sizePublicKey + sizeFr
+	SeedSize       = 32
+	sizePrivateKey = sizePublicKey + SeedSize
+	maxContextSize = 255
+)
+
+// Variant of the Ed25519 signature scheme, see https://www.rfc-editor.org/rfc/rfc8032#section-5.1
+type Variant uint8
+
+const (
+	// Ed25519 is the pure variant, without context.
+	Ed25519 Variant = iota
+	// Ed25519ph signs the SHA-512 hash of the message, with an optional context.
+	Ed25519ph
+	// Ed25519ctx signs the message with a non-empty context.
+	Ed25519ctx
+)
+
+// Options selects the variant of the scheme and its context.
+type Options struct {
+	Variant Variant
+	// Context is at most 255 bytes long. It must be empty for Ed25519 and
+	// non-empty for Ed25519ctx.
+	Context []byte
+}
+
+// PublicKey eddsa signature object
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type PublicKey struct {
+	A ed25519.PointAffine
+}
+
+// PrivateKey private key of an eddsa instance
+type PrivateKey struct {
+	PublicKey PublicKey             // copy of the associated public key
+	seed      [SeedSize]byte        // private key of RFC 8032
+	scalar    [sizeFr]byte          // pruned secret scalar, in little Endian
+	prefix    [sha512.Size / 2]byte // second half of SHA-512(seed), used to derive the nonces
+}
+
+// Signature represents an eddsa signature
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type Signature struct {
+	R ed25519.PointAffine
+	S [sizeFr]byte // in little Endian
+}
+
+// GenerateKey generates a public and private key pair from a random seed.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var seed [SeedSize]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives the private key from the 32 bytes seed, as in
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.5.
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return nil, errInvalidSeed
+	}
+	var priv PrivateKey
+	copy(priv.seed[:], seed)
+
+	h := sha512.Sum512(seed)
+	copy(priv.prefix[:], h[sizeFr:])
+
+	// prune the key
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+	copy(priv.scalar[:], h[:sizeFr])
+
+	var bScalar big.Int
+	bScalar.SetBytes(reverse(priv.scalar[:]))
+	base := ed25519.GetEdwardsCurve().Base
+	priv.PublicKey.A.ScalarMultiplication(&base, &bScalar)
+
+	return &priv, nil
+}
+
+// Seed returns the 32 bytes seed the private key is derived from.
+func (privKey *PrivateKey) Seed() []byte {
+	res := make([]byte, SeedSize)
+	copy(res, privKey.seed[:])
+	return res
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs the message with Ed25519.
+// If hFunc is not nil, the message is hashed with hFunc before being signed;
+// Ed25519ph is available through SignWithOptions.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.SignWithOptions(m, &Options{})
+}
+
+// SignWithOptions signs the message with the variant of Ed25519 selected by opts
+//
+//	r = SHA-512(dom2(F, C) ∥ prefix ∥ PH(M)) (mod ℓ)
+//	R = r⋅B
+//	k = SHA-512(dom2(F, C) ∥ R ∥ A ∥ PH(M)) (mod ℓ)
+//	S = r + k⋅s (mod ℓ)
+//	signature = R ∥ S
+//
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.6
+func (privKey *PrivateKey) SignWithOptions(message []byte, opts *Options) ([]byte, error) {
+	dom, m, err := opts.prepare(message)
+	if err != nil {
+		return nil, err
+	}
+
+	// r = SHA-512(dom2(F, C) ∥ prefix ∥ PH(M)) (mod ℓ)
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(privKey.prefix[:])
+	h.Write(m)
+	var r fr.Element
+	r.SetBytes(reverse(h.Sum(nil)))
+
+	// R = r⋅B
+	var sig Signature
+	var bR big.Int
+	r.BigInt(&bR)
+	base := ed25519.GetEdwardsCurve().Base
+	sig.R.ScalarMultiplication(&base, &bR)
+	RBin := sig.R.Bytes()
+	ABin := privKey.PublicKey.A.Bytes()
+
+	// S = r + k⋅s (mod ℓ)
+	k := computeChallenge(dom, RBin[:], ABin[:], m)
+	var s, S fr.Element
+	s.SetBytes(reverse(privKey.scalar[:]))
+	S.Mul(&k, &s).Add(&S, &r)
+	fr.LittleEndian.PutElement(&sig.S, S)
+
+	return sig.Bytes(), nil
+}
+
+// Verify verifies an Ed25519 signature.
+// If hFunc is not nil, the message is hashed with hFunc before being verified;
+// Ed25519ph is available through VerifyWithOptions.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	return pub.VerifyWithOptions(sigBin, m, &Options{})
+}
+
+// VerifyWithOptions verifies a signature of the message with the variant of
+// Ed25519 selected by opts, checking
+//
+//	[8][S]B = [8]R + [8][k]A
+//
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.7
+func (pub *PublicKey) VerifyWithOptions(sigBin, message []byte, opts *Options) (bool, error) {
+	dom, m, err := opts.prepare(message)
+	if err != nil {
+		return false, err
+	}
+
+	// the encoding of R and the range of S are checked by SetBytes
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	if !pub.A.IsOnCurve() {
+		return false, ed25519.ErrInvalidEncoding
+	}
+
+	ABin := pub.A.Bytes()
+	k := computeChallenge(dom, sigBin[:sizePublicKey], ABin[:], m)
+	k.Neg(&k)
+
+	var S fr.Element
+	S, _ = fr.LittleEndian.Element(&sig.S)
+
+	// [S]B - [k]A - R
+	base := ed25519.GetEdwardsCurve().Base
+	var bS, bk big.Int
+	var sB, kA, R, res ed25519.PointExtended
+	sB.FromAffine(&base)
+	sB.ScalarMultiplication(&sB, S.BigInt(&bS))
+	kA.FromAffine(&pub.A)
+	kA.ScalarMultiplication(&kA, k.BigInt(&bk))
+	R.FromAffine(&sig.R)
+	R.Neg(&R)
+	res.Add(&sB, &kA).Add(&res, &R)
+
+	// multiply by the cofactor
+	res.Double(&res).Double(&res).Double(&res)
+
+	return res.IsZero(), nil
+}
+
+// prepare returns dom2(F, C) and PH(M) for the variant selected by opts.
+// A nil opts selects Ed25519.
+func (opts *Options) prepare(message []byte) (dom, m []byte, err error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if len(opts.Context) > maxContextSize {
+		return nil, nil, errInvalidContext
+	}
+	switch opts.Variant {
+	case Ed25519:
+		if len(opts.Context) != 0 {
+			return nil, nil, errInvalidContext
+		}
+		return nil, message, nil
+	case Ed25519ph:
+		h := sha512.Sum512(message)
+		return dom2(1, opts.Context), h[:], nil
+	case Ed25519ctx:
+		if len(opts.Context) == 0 {
+			return nil, nil, errInvalidContext
+		}
+		return dom2(0, opts.Context), message, nil
+	default:
+		return nil, nil, errInvalidVariant
+	}
+}
+
+// dom2 returns "SigEd25519 no Ed25519 collisions" ∥ octet(phflag) ∥ octet(len(context)) ∥ context
+func dom2(phflag byte, context []byte) []byte {
+	const prefix = "SigEd25519 no Ed25519 collisions"
+	res := make([]byte, 0, len(prefix)+2+len(context))
+	res = append(res, prefix...)
+	res = append(res, phflag, byte(len(context)))
+	return append(res, context...)
+}
+
+// computeChallenge returns SHA-512(dom ∥ R ∥ A ∥ m) (mod ℓ)
+func computeChallenge(dom, R, A, m []byte) fr.Element {
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(R)
+	h.Write(A)
+	h.Write(m)
+	var k fr.Element
+	k.SetBytes(reverse(h.Sum(nil)))
+	return k
+}
+
+// hashMessage returns the message hashed with hFunc, or the message itself if hFunc is nil.
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
+// reverse returns a reversed copy of b, to convert little endian encodings
+// to big endian ones.
+func reverse(b []byte) []byte {
+	res := make([]byte, len(b))
+	for i := range b {
+		res[len(b)-1-i] = b[i]
+	}
+	return res
+}
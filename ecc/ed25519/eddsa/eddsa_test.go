@@ -0,0 +1,275 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eddsa
+
+import (
+	"bytes"
+	"crypto"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 100
+)
+
+// test vectors of https://www.rfc-editor.org/rfc/rfc8032#section-7
+var rfc8032Vectors = []struct {
+	variant                        Variant
+	seed, pk, msg, context, sigBin string
+}{
+	// 7.1, TEST 1
+	{Ed25519, "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60", "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a", "", "",
+		"e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b"},
+	// 7.1, TEST 2
+	{Ed25519, "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb", "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c", "72", "",
+		"92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00"},
+	// 7.1, TEST 3
+	{Ed25519, "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7", "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025", "af82", "",
+		"6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a"},
+	// 7.2, foo
+	{Ed25519ctx, "0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6", "dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292", "f726936d19c800494e3fdaff20b276a8", "666f6f",
+		"55a4cc2f70a54e04288c5f4cd1e45a7bb520b36292911876cada7323198dd87a8b36950b95130022907a7fb7c4e9b2d5f6cca685a587b4b21f4b888e4e7edb0d"},
+	// 7.3, TEST abc
+	{Ed25519ph, "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42", "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf", "616263", "",
+		"98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406"},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	t.Parallel()
+
+	for i, v := range rfc8032Vectors {
+		privKey, err := NewKeyFromSeed(decodeHex(t, v.seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), decodeHex(t, v.pk)) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+
+		opts := &Options{Variant: v.variant, Context: decodeHex(t, v.context)}
+		msg := decodeHex(t, v.msg)
+		sig, err := privKey.SignWithOptions(msg, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, decodeHex(t, v.sigBin)) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+
+		var pub PublicKey
+		if _, err := pub.SetBytes(decodeHex(t, v.pk)); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := pub.VerifyWithOptions(sig, msg, opts); err != nil || !ok {
+			t.Fatalf("vector %d: signature should verify", i)
+		}
+
+		// the variants are domain separated
+		for _, other := range []Options{{Variant: Ed25519}, {Variant: Ed25519ph}, {Variant: Ed25519ctx, Context: []byte("bar")}} {
+			if other.Variant == v.variant {
+				continue
+			}
+			if ok, _ := pub.VerifyWithOptions(sig, msg, &other); ok {
+				t.Fatalf("vector %d: signature should not verify with another variant", i)
+			}
+		}
+	}
+}
+
+func TestCompatibility(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[ED25519] signatures should match crypto/ed25519", prop.ForAll(
+		func(msg []byte) bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			stdKey := stded25519.NewKeyFromSeed(privKey.Seed())
+
+			sig, _ := privKey.Sign(msg, nil)
+			if !bytes.Equal(sig, stded25519.Sign(stdKey, msg)) {
+				return false
+			}
+			return stded25519.Verify(stdKey.Public().(stded25519.PublicKey), msg, sig)
+		},
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			msg := make([]byte, genParams.Rng.Intn(200))
+			genParams.Rng.Read(msg)
+			return gopter.NewGenResult(msg, gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[ED25519] Ed25519ph and Ed25519ctx signatures should verify with crypto/ed25519", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			stdPub := stded25519.PublicKey(privKey.PublicKey.Bytes())
+			msg := []byte("testing Ed25519")
+
+			sigPh, _ := privKey.SignWithOptions(msg, &Options{Variant: Ed25519ph, Context: []byte("ctx")})
+			digest := sha512.Sum512(msg)
+			errPh := stded25519.VerifyWithOptions(stdPub, digest[:], sigPh, &stded25519.Options{Hash: crypto.SHA512, Context: "ctx"})
+
+			sigCtx, _ := privKey.SignWithOptions(msg, &Options{Variant: Ed25519ctx, Context: []byte("ctx")})
+			errCtx := stded25519.VerifyWithOptions(stdPub, msg, sigCtx, &stded25519.Options{Context: "ctx"})
+
+			return errPh == nil && errCtx == nil
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestEddsaSHA256(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	pubKey := privKey.Public()
+	hFunc := sha256.New()
+
+	msg := []byte("testing Ed25519 with a pre-hash")
+	sig, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pubKey.Verify(sig, msg, hFunc); err != nil || !ok {
+		t.Fatal("signature should verify")
+	}
+	if ok, _ := pubKey.Verify(sig, []byte("another message"), hFunc); ok {
+		t.Fatal("signature of another message should not verify")
+	}
+	if ok, _ := pubKey.Verify(sig, msg, nil); ok {
+		t.Fatal("signature should not verify without the pre-hash")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("testing Ed25519")
+	sig, _ := privKey.Sign(msg, nil)
+
+	// S + ℓ verifies the same equation but is rejected
+	// ℓ = 2²⁵² + 27742317777372353535851937790883648493, in little endian
+	l := decodeHex(t, "edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010")
+	bad := make([]byte, sizeSignature)
+	copy(bad, sig)
+	var carry uint16
+	for i := 0; i < sizeFr; i++ {
+		carry += uint16(bad[sizePublicKey+i]) + uint16(l[i])
+		bad[sizePublicKey+i] = byte(carry)
+		carry >>= 8
+	}
+	if ok, err := privKey.PublicKey.Verify(bad, msg, nil); ok || err == nil {
+		t.Fatal("non reduced S should be rejected")
+	}
+
+	// non canonical encoding of R: y + p, when y + p < 2²⁵⁵
+	var nonCanonical [sizePublicKey]byte
+	nonCanonical[0] = 0xee // 2²⁵⁵-19 + 1, that is y = 1 (the identity)
+	for i := 1; i < sizePublicKey-1; i++ {
+		nonCanonical[i] = 0xff
+	}
+	nonCanonical[sizePublicKey-1] = 0x7f
+	copy(bad, nonCanonical[:])
+	copy(bad[sizePublicKey:], sig[sizePublicKey:])
+	if ok, err := privKey.PublicKey.Verify(bad, msg, nil); ok || err == nil {
+		t.Fatal("non canonical R should be rejected")
+	}
+}
+
+func TestInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("testing Ed25519")
+	for _, opts := range []Options{
+		{Variant: Ed25519, Context: []byte("ctx")},
+		{Variant: Ed25519ctx},
+		{Variant: Ed25519ph, Context: make([]byte, maxContextSize+1)},
+		{Variant: 3},
+	} {
+		if _, err := privKey.SignWithOptions(msg, &opts); err == nil {
+			t.Fatal("invalid options should be rejected")
+		}
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[ED25519] Eddsa serialization: SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+
+			var end PrivateKey
+			n, err := end.SetBytes(privKey.Bytes())
+			if err != nil || n != sizePrivateKey {
+				return false
+			}
+			if !end.PublicKey.Equal(&privKey.PublicKey) || end != *privKey {
+				return false
+			}
+
+			var sig Signature
+			sigBin, _ := privKey.Sign([]byte("message"), nil)
+			if _, err := sig.SetBytes(sigBin); err != nil {
+				return false
+			}
+			return bytes.Equal(sig.Bytes(), sigBin)
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Ed25519 sign()")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.Sign(msg, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Ed25519 sign()")
+	sig, _ := privKey.Sign(msg, nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.PublicKey.Verify(sig, msg, nil)
+	}
+}
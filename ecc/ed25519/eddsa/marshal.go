@@ -0,0 +1,107 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+)
+
+var errWrongSize = errors.New("wrong size buffer")
+var errSBiggerThanRMod = errors.New("s >= r_mod")
+var errPublicKeyMismatch = errors.New("public key does not match the seed")
+
+// Bytes returns the binary representation of the public key
+// follows https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2
+// and returns a compressed representation of the point (x,y)
+//
+// y is stored in little endian and the most significant bit
+// of the last byte is the parity of x.
+func (pk *PublicKey) Bytes() []byte {
+	var res [sizePublicKey]byte
+	pkBin := pk.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets pk from its compressed binary representation in buf.
+// The encoding must be canonical.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.A.SetBytes(buf[:sizePublicKey])
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array publicKey||seed
+// where publicKey is as publicKey.Bytes(), and
+// seed is the 32 bytes private key of RFC 8032.
+// This is the layout of crypto/ed25519 private keys, up to the order of
+// the two halves.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizePublicKey], pubkBin[:])
+	subtle.ConstantTimeCopy(1, res[sizePublicKey:], privKey.seed[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as  publicKey||seed
+// where publicKey is as publicKey.Bytes(), and
+// seed is the 32 bytes private key of RFC 8032.
+// The key is derived from the seed and must match publicKey.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	priv, err := NewKeyFromSeed(buf[sizePublicKey:sizePrivateKey])
+	if err != nil {
+		return 0, err
+	}
+	if subtle.ConstantTimeCompare(priv.PublicKey.Bytes(), buf[:sizePublicKey]) != 1 {
+		return 0, errPublicKeyMismatch
+	}
+	*privKey = *priv
+	return sizePrivateKey, nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size 64 R||S, where R is the compressed point
+// and S is in little endian.
+func (sig *Signature) Bytes() []byte {
+	var res [sizeSignature]byte
+	sigRBin := sig.R.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizePublicKey], sigRBin[:])
+	subtle.ConstantTimeCopy(1, res[sizePublicKey:], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary.
+// buf is read interpreted as R||S, R must be the canonical encoding of a point
+// and S must be smaller than the order ℓ of the group.
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != sizeSignature {
+		return 0, errWrongSize
+	}
+
+	var S [sizeFr]byte
+	copy(S[:], buf[sizePublicKey:])
+	if _, err := fr.LittleEndian.Element(&S); err != nil {
+		return 0, errSBiggerThanRMod
+	}
+
+	if _, err := sig.R.SetBytes(buf[:sizePublicKey]); err != nil {
+		return 0, err
+	}
+	sig.S = S
+	return sizeSignature, nil
+}
@@ -0,0 +1,14 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package ristretto255 provides the prime order group ristretto255 built on top
+// of the edwards25519 curve.
+//
+// An Element is an equivalence class of edwards25519 points modulo the 8-torsion;
+// it has a unique 32 bytes encoding, and decoding rejects every non-canonical
+// input, so the cofactor of the curve never leaks to the protocols using the group.
+//
+// # See also
+//
+// https://www.rfc-editor.org/rfc/rfc9496
+package ristretto255
@@ -0,0 +1,344 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ristretto255
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+)
+
+const (
+	// Bytes is the size of the encoding of an Element
+	Bytes = fp.Bytes
+	// UniformBytes is the size of the input of SetUniformBytes
+	UniformBytes = 2 * fp.Bytes
+)
+
+// ErrInvalidEncoding is returned by SetBytes when the buffer is not the
+// canonical encoding of an Element.
+var ErrInvalidEncoding = errors.New("invalid ristretto255 encoding")
+
+var errWrongSize = errors.New("wrong size buffer")
+
+// constants of https://www.rfc-editor.org/rfc/rfc9496#section-4.1
+var (
+	sqrtM1          fp.Element // √-1
+	sqrtADMinusOne  fp.Element // √(a⋅d-1)
+	invSqrtAMinusD  fp.Element // 1/√(a-d)
+	oneMinusDSq     fp.Element // 1-d²
+	dMinusOneSq     fp.Element // (d-1)²
+	sqrtRatioExp    big.Int    // (p-5)/8
+	edwardsD        fp.Element
+	generatorPoint  ed25519.PointExtended
+	identityElement Element
+)
+
+func init() {
+	sqrtM1.SetString("19681161376707505956807079304988542015446066515923890162744021073123829784752")
+	sqrtADMinusOne.SetString("25063068953384623474111414158702152701244531502492656460079210482610430750235")
+	invSqrtAMinusD.SetString("54469307008909316920995813868745141605393597292927456921205312896311721017578")
+	oneMinusDSq.SetString("1159843021668779879193775521855586647937357759715417654439879720876111806838")
+	dMinusOneSq.SetString("40440834346308536858101042469323190826248399146238708352240133220865137265952")
+
+	sqrtRatioExp.Sub(fp.Modulus(), big.NewInt(5))
+	sqrtRatioExp.Rsh(&sqrtRatioExp, 3)
+
+	curve := ed25519.GetEdwardsCurve()
+	edwardsD.Set(&curve.D)
+	generatorPoint.FromAffine(&curve.Base)
+	identityElement.inner.X.SetZero()
+	identityElement.inner.Y.SetOne()
+	identityElement.inner.Z.SetOne()
+	identityElement.inner.T.SetZero()
+}
+
+// Element is an element of the ristretto255 group.
+// The zero value is not a valid Element, use Identity or Generator to initialize it.
+type Element struct {
+	inner ed25519.PointExtended
+}
+
+// Identity sets e to the identity of the group and returns it.
+func (e *Element) Identity() *Element {
+	e.inner.Set(&identityElement.inner)
+	return e
+}
+
+// Generator sets e to the canonical generator of the group, the class of
+// the base point of edwards25519, and returns it.
+func (e *Element) Generator() *Element {
+	e.inner.Set(&generatorPoint)
+	return e
+}
+
+// Set sets e to p and returns it.
+func (e *Element) Set(p *Element) *Element {
+	e.inner.Set(&p.inner)
+	return e
+}
+
+// Equal returns true if e and p are the same group element, that is if their
+// representatives differ by a point of the 4-torsion.
+func (e *Element) Equal(p *Element) bool {
+	var x1y2, y1x2, y1y2, x1x2 fp.Element
+	x1y2.Mul(&e.inner.X, &p.inner.Y)
+	y1x2.Mul(&e.inner.Y, &p.inner.X)
+	y1y2.Mul(&e.inner.Y, &p.inner.Y)
+	x1x2.Mul(&e.inner.X, &p.inner.X)
+	return x1y2.Equal(&y1x2) || y1y2.Equal(&x1x2)
+}
+
+// Add sets e = p + q and returns it.
+func (e *Element) Add(p, q *Element) *Element {
+	e.inner.Add(&p.inner, &q.inner)
+	return e
+}
+
+// Sub sets e = p - q and returns it.
+func (e *Element) Sub(p, q *Element) *Element {
+	var negQ ed25519.PointExtended
+	negQ.Neg(&q.inner)
+	e.inner.Add(&p.inner, &negQ)
+	return e
+}
+
+// Neg sets e = -p and returns it.
+func (e *Element) Neg(p *Element) *Element {
+	e.inner.Neg(&p.inner)
+	return e
+}
+
+// ScalarMultiplication sets e = [s]p and returns it.
+func (e *Element) ScalarMultiplication(p *Element, s *fr.Element) *Element {
+	var bs big.Int
+	s.BigInt(&bs)
+	e.inner.ScalarMultiplication(&p.inner, &bs)
+	return e
+}
+
+// ScalarBaseMultiplication sets e = [s]G where G is the generator and returns it.
+func (e *Element) ScalarBaseMultiplication(s *fr.Element) *Element {
+	var g Element
+	g.Generator()
+	return e.ScalarMultiplication(&g, s)
+}
+
+// Bytes returns the canonical encoding of e
+// https://www.rfc-editor.org/rfc/rfc9496#section-4.3.2
+func (e *Element) Bytes() (res [Bytes]byte) {
+	X, Y, Z, T := &e.inner.X, &e.inner.Y, &e.inner.Z, &e.inner.T
+
+	// u1 = (Z+Y)⋅(Z-Y), u2 = X⋅Y
+	var u1, u2, tmp fp.Element
+	u1.Add(Z, Y)
+	tmp.Sub(Z, Y)
+	u1.Mul(&u1, &tmp)
+	u2.Mul(X, Y)
+
+	// invsqrt = 1/√(u1⋅u2²)
+	var one, invSqrt fp.Element
+	one.SetOne()
+	tmp.Square(&u2).Mul(&tmp, &u1)
+	sqrtRatioM1(&invSqrt, &one, &tmp)
+
+	var den1, den2, zInv fp.Element
+	den1.Mul(&invSqrt, &u1)
+	den2.Mul(&invSqrt, &u2)
+	zInv.Mul(&den1, &den2).Mul(&zInv, T)
+
+	var ix0, iy0, enchantedDenominator fp.Element
+	ix0.Mul(X, &sqrtM1)
+	iy0.Mul(Y, &sqrtM1)
+	enchantedDenominator.Mul(&den1, &invSqrtAMinusD)
+
+	tmp.Mul(T, &zInv)
+	rotate := isNegative(&tmp)
+	var x, y, denInv fp.Element
+	x.Select(rotate, X, &iy0)
+	y.Select(rotate, Y, &ix0)
+	denInv.Select(rotate, &den2, &enchantedDenominator)
+
+	tmp.Mul(&x, &zInv)
+	condNeg(&y, isNegative(&tmp))
+
+	// s = |den_inv⋅(Z-y)|
+	var s fp.Element
+	s.Sub(Z, &y).Mul(&s, &denInv)
+	condNeg(&s, isNegative(&s))
+
+	fp.LittleEndian.PutElement(&res, s)
+	return
+}
+
+// SetBytes sets e from its canonical encoding in buf.
+// https://www.rfc-editor.org/rfc/rfc9496#section-4.3.1
+// It returns the number of bytes read from buf, or ErrInvalidEncoding if
+// buf is not the encoding of an Element.
+func (e *Element) SetBytes(buf []byte) (int, error) {
+	if len(buf) < Bytes {
+		return 0, errWrongSize
+	}
+
+	// s must be canonical and non-negative
+	var sBin [Bytes]byte
+	copy(sBin[:], buf[:Bytes])
+	s, err := fp.LittleEndian.Element(&sBin)
+	if err != nil || isNegative(&s) == 1 {
+		return 0, ErrInvalidEncoding
+	}
+
+	// u1 = 1 + a⋅s², u2 = 1 - a⋅s² with a = -1
+	var one, ss, u1, u2, u2Sq fp.Element
+	one.SetOne()
+	ss.Square(&s)
+	u1.Sub(&one, &ss)
+	u2.Add(&one, &ss)
+	u2Sq.Square(&u2)
+
+	// v = -(d⋅u1²) - u2²
+	var v, tmp fp.Element
+	v.Square(&u1).Mul(&v, &edwardsD).Neg(&v).Sub(&v, &u2Sq)
+
+	var invSqrt fp.Element
+	tmp.Mul(&v, &u2Sq)
+	wasSquare := sqrtRatioM1(&invSqrt, &one, &tmp)
+
+	var denX, denY fp.Element
+	denX.Mul(&invSqrt, &u2)
+	denY.Mul(&invSqrt, &denX).Mul(&denY, &v)
+
+	var x, y, t fp.Element
+	x.Double(&s).Mul(&x, &denX)
+	condNeg(&x, isNegative(&x))
+	y.Mul(&u1, &denY)
+	t.Mul(&x, &y)
+
+	if wasSquare == 0 || isNegative(&t) == 1 || y.IsZero() {
+		return 0, ErrInvalidEncoding
+	}
+
+	e.inner.X.Set(&x)
+	e.inner.Y.Set(&y)
+	e.inner.Z.SetOne()
+	e.inner.T.Set(&t)
+	return Bytes, nil
+}
+
+// SetUniformBytes sets e to the element derived from 64 uniformly random bytes,
+// for instance the output of SHA-512, and returns it.
+// https://www.rfc-editor.org/rfc/rfc9496#section-4.3.4
+func (e *Element) SetUniformBytes(b []byte) (*Element, error) {
+	if len(b) != UniformBytes {
+		return nil, errWrongSize
+	}
+	var p1, p2 ed25519.PointExtended
+	mapToPoint(&p1, b[:Bytes])
+	mapToPoint(&p2, b[Bytes:])
+	e.inner.Add(&p1, &p2)
+	return e, nil
+}
+
+// mapToPoint sets p to MAP(t) where t is read from the 32 bytes b, with the
+// most significant bit masked.
+func mapToPoint(p *ed25519.PointExtended, b []byte) {
+	var tBin [Bytes]byte
+	copy(tBin[:], b)
+	tBin[Bytes-1] &= 0x7f
+	var tBig big.Int
+	for i := 0; i < Bytes/2; i++ {
+		tBin[i], tBin[Bytes-1-i] = tBin[Bytes-1-i], tBin[i]
+	}
+	tBig.SetBytes(tBin[:])
+	var t fp.Element
+	t.SetBigInt(&tBig)
+
+	var one, r, u, v, tmp fp.Element
+	one.SetOne()
+
+	// r = √-1⋅t², u = (r+1)⋅(1-d²), v = (-1-r⋅d)⋅(r+d)
+	r.Square(&t).Mul(&r, &sqrtM1)
+	u.Add(&r, &one).Mul(&u, &oneMinusDSq)
+	v.Mul(&r, &edwardsD).Add(&v, &one).Neg(&v)
+	tmp.Add(&r, &edwardsD)
+	v.Mul(&v, &tmp)
+
+	var s, sPrime, c fp.Element
+	wasSquare := sqrtRatioM1(&s, &u, &v)
+	sPrime.Mul(&s, &t)
+	condNeg(&sPrime, isNegative(&sPrime))
+	sPrime.Neg(&sPrime)
+	s.Select(wasSquare, &sPrime, &s)
+	c.Neg(&one)
+	c.Select(wasSquare, &r, &c)
+
+	// N = c⋅(r-1)⋅(d-1)² - v
+	var N fp.Element
+	N.Sub(&r, &one).Mul(&N, &c).Mul(&N, &dMinusOneSq).Sub(&N, &v)
+
+	var sSq, w0, w1, w2, w3 fp.Element
+	sSq.Square(&s)
+	w0.Double(&s).Mul(&w0, &v)
+	w1.Mul(&N, &sqrtADMinusOne)
+	w2.Sub(&one, &sSq)
+	w3.Add(&one, &sSq)
+
+	p.X.Mul(&w0, &w3)
+	p.Y.Mul(&w2, &w1)
+	p.Z.Mul(&w1, &w3)
+	p.T.Mul(&w0, &w2)
+}
+
+// sqrtRatioM1 sets z to √(u/v) if it exists, or to √(√-1⋅u/v) otherwise,
+// choosing the non-negative root. It returns 1 if u/v is a square.
+// https://www.rfc-editor.org/rfc/rfc9496#section-4.2
+func sqrtRatioM1(z, u, v *fp.Element) int {
+	var v3, v7, r, check, tmp fp.Element
+	v3.Square(v).Mul(&v3, v)
+	v7.Square(&v3).Mul(&v7, v)
+
+	// r = (u⋅v³)⋅(u⋅v⁷)^((p-5)/8)
+	tmp.Mul(u, &v7)
+	r.Exp(tmp, &sqrtRatioExp)
+	tmp.Mul(u, &v3)
+	r.Mul(&r, &tmp)
+	check.Square(&r).Mul(&check, v)
+
+	var uNeg, uNegI fp.Element
+	uNeg.Neg(u)
+	uNegI.Mul(&uNeg, &sqrtM1)
+	correctSignSqrt := check.Equal(u)
+	flippedSignSqrt := check.Equal(&uNeg)
+	flippedSignSqrtI := check.Equal(&uNegI)
+
+	tmp.Mul(&r, &sqrtM1)
+	r.Select(toInt(flippedSignSqrt || flippedSignSqrtI), &r, &tmp)
+	condNeg(&r, isNegative(&r))
+	z.Set(&r)
+
+	return toInt(correctSignSqrt || flippedSignSqrt)
+}
+
+// isNegative returns 1 if the canonical representative of x is odd, 0 otherwise.
+func isNegative(x *fp.Element) int {
+	b := x.Bytes()
+	return int(b[fp.Bytes-1] & 1)
+}
+
+// condNeg sets x to -x if c == 1.
+func condNeg(x *fp.Element, c int) {
+	var neg fp.Element
+	neg.Neg(x)
+	x.Select(c, x, &neg)
+}
+
+func toInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,214 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ristretto255
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 100
+)
+
+// multiples of the generator, https://www.rfc-editor.org/rfc/rfc9496#appendix-A.1
+var generatorMultiples = []string{
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+	"6a493210f7499cd17fecb510ae0cea23a110e8d5b901f8acadd3095c73a3b919",
+	"94741f5d5d52755ece4f23f044ee27d5d1ea1e2bd196b462166b16152a9d0259",
+	"da80862773358b466ffadfe0b3293ab3d9fd53c5ea6c955358f568322daf6a57",
+	"e882b131016b52c1d3337080187cf768423efccbb517bb495ab812c4160ff44e",
+	"f64746d3c92b13050ed8d80236a7f0007c3b3f962f5ba793d19a601ebb1df403",
+	"44f53520926ec81fbd5a387845beb7df85a96a24ece18738bdcfa6a7822a176d",
+	"903293d8f2287ebe10e2374dc1a53e0bc887e592699f02d077d5263cdd55601c",
+	"02622ace8f7303a31cafc63f8fc48fdc16e1c8c8d234b2f0d6685282a9076031",
+	"20706fd788b2720a1ed2a5dad4952b01f413bcf0e7564de8cdc816689e2db95f",
+	"bce83f8ba5dd2fa572864c24ba1810f9522bc6004afe95877ac73241cafdab42",
+	"e4549ee16b9aa03099ca208c67adafcafa4c3f3e4e5303de6026e3ca8ff84460",
+	"aa52e000df2e16f55fb1032fc33bc42742dad6bd5a8fc0be0167436c5948501f",
+	"46376b80f409b29dc2b5f6f0c52591990896e5716f41477cd30085ab7f10301e",
+	"e0c418f7c8d9c4cdd7395b93ea124f3ad99021bb681dfc3302a9d99a2e53e64e",
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestGeneratorMultiples(t *testing.T) {
+	t.Parallel()
+
+	var p, g Element
+	p.Identity()
+	g.Generator()
+	for i, v := range generatorMultiples {
+		expected := decodeHex(t, v)
+		b := p.Bytes()
+		if hex.EncodeToString(b[:]) != v {
+			t.Fatalf("[%d]G: wrong encoding", i)
+		}
+
+		var q Element
+		if _, err := q.SetBytes(expected); err != nil {
+			t.Fatalf("[%d]G: %v", i, err)
+		}
+		if !q.Equal(&p) {
+			t.Fatalf("[%d]G: SetBytes(Bytes()) should be the same element", i)
+		}
+
+		var s fr.Element
+		s.SetUint64(uint64(i))
+		q.ScalarBaseMultiplication(&s)
+		if !q.Equal(&p) {
+			t.Fatalf("[%d]G: scalar multiplication and additions disagree", i)
+		}
+
+		p.Add(&p, &g)
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	t.Parallel()
+
+	// https://www.rfc-editor.org/rfc/rfc9496#appendix-A.2
+	for _, v := range []string{
+		// non-canonical field encodings
+		"00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"f3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// negative field elements
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"01ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// non-square x²
+		"26948d35ca62e643e26a83177332e6b6afeb9d08e4268b650f1f5bbd8d81d371",
+		"4eac077a713c57b4f4397629a4145982c661f48044dd3f96427d40b147d9742f",
+		"de6a7b00deadc788eb6b6c8d20c0ae96c2f2019078fa604fee5b87d6e989ad7b",
+	} {
+		var e Element
+		if _, err := e.SetBytes(decodeHex(t, v)); err != ErrInvalidEncoding {
+			t.Fatalf("%s should be rejected", v)
+		}
+	}
+
+	var e Element
+	if _, err := e.SetBytes(make([]byte, Bytes-1)); err == nil {
+		t.Fatal("short buffer should be rejected")
+	}
+}
+
+func TestSetUniformBytes(t *testing.T) {
+	t.Parallel()
+
+	// https://www.rfc-editor.org/rfc/rfc9496#appendix-A.3
+	for _, v := range []struct{ msg, encoding string }{
+		{"Ristretto is traditionally a short shot of espresso coffee", "3066f82a1a747d45120d1740f14358531a8f04bbffe6a819f86dfe50f44a0a46"},
+		{"made with the normal amount of ground coffee but extracted with", "f26e5b6f7d362d2d2a94c5d0e7602cb4773c95a2e5c31a64f133189fa76ed61b"},
+	} {
+		h := sha512.Sum512([]byte(v.msg))
+		var e Element
+		if _, err := e.SetUniformBytes(h[:]); err != nil {
+			t.Fatal(err)
+		}
+		b := e.Bytes()
+		if hex.EncodeToString(b[:]) != v.encoding {
+			t.Fatalf("wrong element for %q", v.msg)
+		}
+	}
+
+	var e Element
+	if _, err := e.SetUniformBytes(make([]byte, UniformBytes-1)); err == nil {
+		t.Fatal("wrong input size should be rejected")
+	}
+}
+
+func TestOps(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genS := gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s fr.Element
+		s.SetRandom()
+		return gopter.NewGenResult(s, gopter.NoShrinker)
+	})
+
+	properties.Property("[RISTRETTO255] SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func(s fr.Element) bool {
+			var p, q Element
+			p.ScalarBaseMultiplication(&s)
+			b := p.Bytes()
+			if _, err := q.SetBytes(b[:]); err != nil {
+				return false
+			}
+			c := q.Bytes()
+			return q.Equal(&p) && b == c
+		},
+		genS,
+	))
+
+	properties.Property("[RISTRETTO255] [a]G + [b]G - [a+b]G should be the identity", prop.ForAll(
+		func(a, b fr.Element) bool {
+			var pa, pb, pab, id Element
+			var ab fr.Element
+			ab.Add(&a, &b)
+			pa.ScalarBaseMultiplication(&a)
+			pb.ScalarBaseMultiplication(&b)
+			pab.ScalarBaseMultiplication(&ab)
+			pa.Add(&pa, &pb).Sub(&pa, &pab)
+			id.Identity()
+			return pa.Equal(&id) && pa.Bytes() == id.Bytes()
+		},
+		genS,
+		genS,
+	))
+
+	properties.Property("[RISTRETTO255] P + (-P) should be the identity", prop.ForAll(
+		func(s fr.Element) bool {
+			var p, q, id Element
+			p.ScalarBaseMultiplication(&s)
+			q.Neg(&p).Add(&q, &p)
+			id.Identity()
+			return q.Equal(&id)
+		},
+		genS,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkBytes(b *testing.B) {
+	var p Element
+	p.Generator()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Bytes()
+	}
+}
+
+func BenchmarkSetBytes(b *testing.B) {
+	var p Element
+	p.Generator()
+	buf := p.Bytes()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.SetBytes(buf[:])
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/grumpkin/fr"
+)
+
+// Test SRS re-used across tests of the IPA scheme
+var testSrs *SRS
+
+const srsSize = 230
+
+func init() {
+	testSrs, _ = NewSRS(srsSize)
+}
+
+func randomPolynomial(size int) []fr.Element {
+	f := make([]fr.Element, size)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	return f
+}
+
+// eval returns p(point) where p is interpreted as a polynomial
+// ∑_{i<len(p)}p[i]Xⁱ
+func eval(p []fr.Element, point fr.Element) fr.Element {
+	var res fr.Element
+	n := len(p)
+	res.Set(&p[n-1])
+	for i := n - 2; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &p[i])
+	}
+	return res
+}
+
+func TestNewSRS(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal(256, len(testSrs.Basis), "srs size should be rounded up to a power of two")
+
+	// the srs is deterministic
+	srs, err := NewSRS(3)
+	assert.NoError(err)
+	assert.Equal(4, len(srs.Basis))
+	for i := range srs.Basis {
+		assert.True(srs.Basis[i].Equal(&testSrs.Basis[i]))
+		assert.True(srs.Basis[i].IsInSubGroup())
+	}
+	assert.True(srs.U.Equal(&testSrs.U))
+
+	_, err = NewSRS(1)
+	assert.ErrorIs(err, ErrMinSRSSize)
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := srs.WriteTo(&buf)
+	assert.NoError(err)
+	var decoded SRS
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(*srs, decoded)
+}
+
+func TestCommit(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+	digest, err := Commit(f, *testSrs)
+	assert.NoError(err)
+
+	// ∑ᵢfᵢ⋅Gᵢ
+	var expected Digest
+	expected.MultiExp(testSrs.Basis[:len(f)], f, ecc.MultiExpConfig{NbTasks: 1})
+	assert.True(digest.Equal(&expected))
+
+	_, err = Commit(randomPolynomial(len(testSrs.Basis)+1), *testSrs)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Commit(nil, *testSrs)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestOpen(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(srsSize)
+	digest, err := Commit(f, *testSrs)
+	assert.NoError(err)
+
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(f, digest, point, sha256.New(), *testSrs, []byte("data"))
+	assert.NoError(err)
+
+	expected := eval(f, point)
+	assert.True(proof.ClaimedValue.Equal(&expected), "wrong claimed value")
+	assert.NoError(Verify(&digest, &proof, point, sha256.New(), *testSrs, []byte("data")))
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decoded OpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.NoError(Verify(&digest, &decoded, point, sha256.New(), *testSrs, []byte("data")))
+
+	// wrong value
+	wrong := proof
+	wrong.ClaimedValue.SetRandom()
+	assert.ErrorIs(Verify(&digest, &wrong, point, sha256.New(), *testSrs, []byte("data")), ErrVerifyOpeningProof)
+
+	// wrong point
+	var other fr.Element
+	other.SetRandom()
+	assert.ErrorIs(Verify(&digest, &proof, other, sha256.New(), *testSrs, []byte("data")), ErrVerifyOpeningProof)
+
+	// wrong transcript data
+	assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), *testSrs), ErrVerifyOpeningProof)
+
+	// wrong folded scalar
+	wrong = proof
+	wrong.IPA.A.SetRandom()
+	assert.ErrorIs(Verify(&digest, &wrong, point, sha256.New(), *testSrs, []byte("data")), ErrVerifyOpeningProof)
+
+	// truncated proof
+	wrong = proof
+	wrong.IPA.L = wrong.IPA.L[1:]
+	assert.ErrorIs(Verify(&digest, &wrong, point, sha256.New(), *testSrs, []byte("data")), ErrInvalidProof)
+}
+
+func TestAccumulators(t *testing.T) {
+	assert := require.New(t)
+
+	const nbProofs = 4
+	accumulators := make([]Accumulator, nbProofs)
+	for i := range accumulators {
+		f := randomPolynomial(srsSize)
+		digest, err := Commit(f, *testSrs)
+		assert.NoError(err)
+		var point fr.Element
+		point.SetRandom()
+		proof, err := Open(f, digest, point, sha256.New(), *testSrs)
+		assert.NoError(err)
+		accumulators[i], err = SuccinctVerify(&digest, &proof, point, sha256.New(), *testSrs)
+		assert.NoError(err)
+
+		// P = A⋅Commit(s)
+		s := accumulators[i].Polynomial()
+		var z fr.Element
+		z.SetRandom()
+		expected := eval(s, z)
+		got := accumulators[i].Evaluate(z)
+		assert.True(got.Equal(&expected), "wrong evaluation of s")
+	}
+	assert.NoError(CheckAccumulators(*testSrs, accumulators...))
+
+	// a single invalid accumulator makes the batch fail
+	accumulators[2].A.SetRandom()
+	assert.ErrorIs(CheckAccumulators(*testSrs, accumulators...), ErrVerifyOpeningProof)
+}
+
+func TestBatchOpenSinglePoint(t *testing.T) {
+	assert := require.New(t)
+
+	// polynomials of different sizes
+	polynomials := [][]fr.Element{
+		randomPolynomial(srsSize),
+		randomPolynomial(10),
+		randomPolynomial(256),
+	}
+	digests := make([]Digest, len(polynomials))
+	for i := range polynomials {
+		var err error
+		digests[i], err = Commit(polynomials[i], *testSrs)
+		assert.NoError(err)
+	}
+
+	var point fr.Element
+	point.SetRandom()
+	proof, err := BatchOpenSinglePoint(polynomials, digests, point, sha256.New(), *testSrs, []byte("data"))
+	assert.NoError(err)
+	for i := range polynomials {
+		expected := eval(polynomials[i], point)
+		assert.True(proof.ClaimedValues[i].Equal(&expected), "wrong claimed value")
+	}
+	assert.NoError(BatchVerifySinglePoint(digests, &proof, point, sha256.New(), *testSrs, []byte("data")))
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decoded BatchOpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.NoError(BatchVerifySinglePoint(digests, &decoded, point, sha256.New(), *testSrs, []byte("data")))
+
+	// wrong claimed value
+	proof.ClaimedValues[1].SetRandom()
+	assert.ErrorIs(BatchVerifySinglePoint(digests, &proof, point, sha256.New(), *testSrs, []byte("data")), ErrVerifyBatchOpeningSinglePoint)
+
+	// invalid inputs
+	_, err = BatchOpenSinglePoint(polynomials, digests[:2], point, sha256.New(), *testSrs)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+	_, err = BatchOpenSinglePoint(nil, nil, point, sha256.New(), *testSrs)
+	assert.ErrorIs(err, ErrZeroNbDigests)
+}
+
+func BenchmarkOpen(b *testing.B) {
+	f := randomPolynomial(len(testSrs.Basis))
+	digest, _ := Commit(f, *testSrs)
+	var point fr.Element
+	point.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Open(f, digest, point, sha256.New(), *testSrs)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	f := randomPolynomial(len(testSrs.Basis))
+	digest, _ := Commit(f, *testSrs)
+	var point fr.Element
+	point.SetRandom()
+	proof, _ := Open(f, digest, point, sha256.New(), *testSrs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&digest, &proof, point, sha256.New(), *testSrs)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+// fromStdlib converts a crypto/ecdsa P-256 key to a gnark-crypto key
+func fromStdlib(t *testing.T, sk *ecdsa.PrivateKey) *PrivateKey {
+	t.Helper()
+	var buf [sizePrivateKey]byte
+	sk.X.FillBytes(buf[:sizeFp])
+	sk.Y.FillBytes(buf[sizeFp:sizePublicKey])
+	sk.D.FillBytes(buf[sizePublicKey:])
+
+	var res PrivateKey
+	if _, err := res.SetBytes(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	return &res
+}
+
+func TestCompatibilityStdlib(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("webauthn client data")
+	digest := sha256.Sum256(msg)
+
+	for i := 0; i < 8; i++ {
+		stdSk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk := fromStdlib(t, stdSk)
+
+		// crypto/ecdsa signature verified here
+		r, s, err := ecdsa.Sign(rand.Reader, stdSk, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		ok, err := sk.PublicKey.Verify(sig.Bytes(), msg, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatal("crypto/ecdsa signature should verify")
+		}
+
+		// signature generated here verified by crypto/ecdsa
+		sigBin, err := sk.Sign(msg, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := sig.SetBytes(sigBin); err != nil {
+			t.Fatal(err)
+		}
+		if !ecdsa.Verify(&stdSk.PublicKey, digest[:], new(big.Int).SetBytes(sig.R[:]), new(big.Int).SetBytes(sig.S[:])) {
+			t.Fatal("signature should verify with crypto/ecdsa")
+		}
+
+		// public key recovery of a crypto/ecdsa key
+		v, r, s, err := sk.SignForRecover(digest[:], nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ecdsa.Verify(&stdSk.PublicKey, digest[:], r, s) {
+			t.Fatal("signature should verify with crypto/ecdsa")
+		}
+		var recovered PublicKey
+		if err := recovered.RecoverFrom(digest[:], v, r, s); err != nil {
+			t.Fatal(err)
+		}
+		if !recovered.Equal(&sk.PublicKey) {
+			t.Fatal("recovered public key should be the crypto/ecdsa one")
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package p256
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/consensys/gnark-crypto/ecc/p256/fp"
+)
+
+func TestG1AffineSerialization(t *testing.T) {
+	t.Parallel()
+	// test round trip serialization of infinity
+	{
+		// uncompressed
+		{
+			var p1, p2 G1Affine
+			p2.X.SetRandom()
+			p2.Y.SetRandom()
+			buf := p1.RawBytes()
+			n, err := p2.SetBytes(buf[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != SizeOfG1AffineUncompressed {
+				t.Fatal("invalid number of bytes consumed in buffer")
+			}
+			if !(p2.X.IsZero() && p2.Y.IsZero()) {
+				t.Fatal("deserialization of uncompressed infinity point is not infinity")
+			}
+		}
+	}
+
+	// a point off the curve must be rejected
+	{
+		var p G1Affine
+		buf := g1GenAff.RawBytes()
+		buf[SizeOfG1AffineUncompressed-1] ^= 1
+		if _, err := p.SetBytes(buf[:]); err == nil {
+			t.Fatal("deserialization of a point off the curve should fail")
+		}
+		if _, err := p.SetBytes(buf[:SizeOfG1AffineUncompressed-1]); err == nil {
+			t.Fatal("deserialization of a short buffer should fail")
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[G1] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.RawBytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineUncompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG1AffineSerializationStdlib(t *testing.T) {
+	t.Parallel()
+
+	// SEC 1 uncompressed points from crypto/ecdh are 0x04||X||Y
+	for i := 0; i < 8; i++ {
+		sk, err := ecdh.P256().GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var pk G1Affine
+		if _, err := pk.SetBytes(sk.PublicKey().Bytes()[1:]); err != nil {
+			t.Fatal(err)
+		}
+
+		var s big.Int
+		s.SetBytes(sk.Bytes())
+		var expected G1Affine
+		expected.ScalarMultiplicationBase(&s)
+		if !pk.Equal(&expected) {
+			t.Fatal("public key from crypto/ecdh does not match [sk]G")
+		}
+	}
+}
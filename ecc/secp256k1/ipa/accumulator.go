@@ -0,0 +1,170 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"hash"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// Accumulator is the deferred part of the verification of an inner product argument.
+//
+// With the challenges xⱼ of the proof, the basis folds to G₀ = ∑ᵢsᵢGᵢ, where the sᵢ are the
+// coefficients of s(X) = ∏ⱼ(1 + xⱼ⁻¹X^{2ᵏ⁻¹⁻ʲ}). The accumulator holds if P = A⋅G₀, that is
+// if P is A times the commitment to s.
+type Accumulator struct {
+	// Challenges xⱼ⁻¹ defining s
+	Challenges []fr.Element
+
+	// A folded scalar of the proof
+	A fr.Element
+
+	// P expected value of A⋅G₀
+	P secp256k1.G1Affine
+}
+
+// SuccinctVerify checks an opening proof in O(log n), and returns the accumulator which
+// remains to be checked with CheckAccumulators for the proof to be valid.
+func SuccinctVerify(commitment *Digest, proof *OpeningProof, point fr.Element, hf hash.Hash, srs SRS, dataTranscript ...[]byte) (Accumulator, error) {
+	nbRounds, err := srs.nbRounds()
+	if err != nil {
+		return Accumulator{}, err
+	}
+	fs := fiatshamir.NewTranscript(hf, challengeIDs(nbRounds)...)
+	return succinctVerifyInnerProduct(fs, srs, commitment, &proof.IPA, point, proof.ClaimedValue, dataTranscript...)
+}
+
+// succinctVerifyInnerProduct computes the accumulator of an inner product argument for
+// ⟨a, (zⁱ)ᵢ⟩ = value, where a is committed in commitment.
+//
+// The folded b is b₀ = ⟨s, (zⁱ)ᵢ⟩ = s(z), and the proof is valid iff
+//
+//	C + value⋅q + ∑ⱼ(xⱼ⋅Lⱼ + xⱼ⁻¹⋅Rⱼ) - (A⋅b₀)⋅q = A⋅G₀
+func succinctVerifyInnerProduct(fs *fiatshamir.Transcript, srs SRS, commitment *Digest, proof *InnerProductProof, point, value fr.Element, dataTranscript ...[]byte) (Accumulator, error) {
+	var res Accumulator
+	nbRounds := bits.TrailingZeros(uint(len(srs.Basis)))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return res, ErrInvalidProof
+	}
+
+	w, err := deriveChallenge(fs, "w", []secp256k1.G1Affine{*commitment}, []fr.Element{point, value}, dataTranscript...)
+	if err != nil {
+		return res, err
+	}
+	x := make([]fr.Element, nbRounds)
+	for j := 0; j < nbRounds; j++ {
+		x[j], err = deriveChallenge(fs, "x"+strconv.Itoa(j), []secp256k1.G1Affine{proof.L[j], proof.R[j]}, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	res.Challenges = fr.BatchInvert(x)
+	res.A.Set(&proof.A)
+	b0 := res.Evaluate(point)
+
+	points := make([]secp256k1.G1Affine, 0, 2*nbRounds+2)
+	scalars := make([]fr.Element, 0, 2*nbRounds+2)
+
+	// (value - A⋅b₀)⋅w⋅U
+	var qScalar fr.Element
+	qScalar.Mul(&proof.A, &b0).Sub(&value, &qScalar).Mul(&qScalar, &w)
+	points = append(points, srs.U)
+	scalars = append(scalars, qScalar)
+
+	for j := 0; j < nbRounds; j++ {
+		points = append(points, proof.L[j], proof.R[j])
+		scalars = append(scalars, x[j], res.Challenges[j])
+	}
+
+	var one fr.Element
+	one.SetOne()
+	points = append(points, *commitment)
+	scalars = append(scalars, one)
+
+	if _, err := res.P.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// Evaluate returns s(z) = ∏ⱼ(1 + xⱼ⁻¹z^{2ᵏ⁻¹⁻ʲ}), in O(log n).
+func (acc *Accumulator) Evaluate(z fr.Element) fr.Element {
+	var res, zPower, tmp, one fr.Element
+	res.SetOne()
+	one.SetOne()
+	zPower.Set(&z)
+	for j := len(acc.Challenges) - 1; j >= 0; j-- {
+		tmp.Mul(&acc.Challenges[j], &zPower)
+		tmp.Add(&tmp, &one)
+		res.Mul(&res, &tmp)
+		zPower.Square(&zPower)
+	}
+	return res
+}
+
+// Polynomial returns the coefficients of s, in O(n).
+func (acc *Accumulator) Polynomial() []fr.Element {
+	k := len(acc.Challenges)
+	s := make([]fr.Element, 1, 1<<k)
+	s[0].SetOne()
+	// the bits of i, from the most significant, tell in which half i was at each round
+	for j := 0; j < k; j++ {
+		s = s[:2*len(s)]
+		for i := len(s)/2 - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &acc.Challenges[j])
+			s[2*i].Set(&s[i])
+		}
+	}
+	return s
+}
+
+// CheckAccumulators checks that Pₖ = Aₖ⋅⟨sₖ, G⟩ for all the accumulators, with a single
+// multi-scalar multiplication ∑ₖrₖPₖ = ⟨∑ₖrₖAₖsₖ, G⟩ for random rₖ.
+func CheckAccumulators(srs SRS, accumulators ...Accumulator) error {
+	n := len(srs.Basis)
+	for i := range accumulators {
+		if 1<<len(accumulators[i].Challenges) != n {
+			return ErrInvalidProof
+		}
+	}
+
+	points := make([]secp256k1.G1Affine, n, n+len(accumulators))
+	copy(points, srs.Basis)
+	scalars := make([]fr.Element, n, n+len(accumulators))
+
+	var r, rA fr.Element
+	r.SetOne()
+	for k := range accumulators {
+		if k > 0 {
+			if _, err := r.SetRandom(); err != nil {
+				return err
+			}
+		}
+		rA.Mul(&r, &accumulators[k].A)
+		s := accumulators[k].Polynomial()
+		for i := range s {
+			s[i].Mul(&s[i], &rA)
+			scalars[i].Add(&scalars[i], &s[i])
+		}
+		points = append(points, accumulators[k].P)
+		scalars = append(scalars, *new(fr.Element).Neg(&r))
+	}
+
+	var check secp256k1.G1Jac
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.Z.IsZero() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
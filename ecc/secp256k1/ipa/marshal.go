@@ -0,0 +1,175 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// WriteTo writes binary encoding of the SRS
+func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
+	n, err := writePoints(w, srs.Basis)
+	if err != nil {
+		return n, err
+	}
+	m, err := writePoints(w, []secp256k1.G1Affine{srs.U})
+	return n + m, err
+}
+
+// ReadFrom decodes SRS data from reader.
+func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
+	n, err := readPoints(r, &srs.Basis)
+	if err != nil {
+		return n, err
+	}
+	var u []secp256k1.G1Affine
+	m, err := readPoints(r, &u)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	if len(u) != 1 {
+		return n, ErrInvalidSRS
+	}
+	srs.U = u[0]
+	return n, nil
+}
+
+// WriteTo writes binary encoding of an InnerProductProof
+func (proof *InnerProductProof) WriteTo(w io.Writer) (int64, error) {
+	n, err := writePoints(w, proof.L)
+	if err != nil {
+		return n, err
+	}
+	m, err := writePoints(w, proof.R)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	buf := proof.A.Bytes()
+	k, err := w.Write(buf[:])
+	return n + int64(k), err
+}
+
+// ReadFrom decodes InnerProductProof data from reader.
+func (proof *InnerProductProof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := readPoints(r, &proof.L)
+	if err != nil {
+		return n, err
+	}
+	m, err := readPoints(r, &proof.R)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	m, err = readScalar(r, &proof.A)
+	return n + m, err
+}
+
+// WriteTo writes binary encoding of an OpeningProof
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	n, err := proof.IPA.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	buf := proof.ClaimedValue.Bytes()
+	k, err := w.Write(buf[:])
+	return n + int64(k), err
+}
+
+// ReadFrom decodes OpeningProof data from reader.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := proof.IPA.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	m, err := readScalar(r, &proof.ClaimedValue)
+	return n + m, err
+}
+
+// WriteTo writes binary encoding of a BatchOpeningProof
+func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	n, err := proof.IPA.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	claimedValues := fr.Vector(proof.ClaimedValues)
+	m, err := claimedValues.WriteTo(w)
+	return n + m, err
+}
+
+// ReadFrom decodes BatchOpeningProof data from reader.
+func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := proof.IPA.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	var claimedValues fr.Vector
+	m, err := claimedValues.ReadFrom(r)
+	proof.ClaimedValues = claimedValues
+	return n + m, err
+}
+
+// writePoints writes the number of points as a big endian uint32, followed
+// by the uncompressed encoding of the points.
+func writePoints(w io.Writer, points []secp256k1.G1Affine) (int64, error) {
+	if err := binary.Write(w, binary.BigEndian, uint32(len(points))); err != nil {
+		return 0, err
+	}
+	n := int64(4)
+	for i := range points {
+		buf := points[i].RawBytes()
+		m, err := w.Write(buf[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// readPoints reads points encoded as in writePoints, checking that they are on
+// the curve and in the prime order subgroup.
+func readPoints(r io.Reader, points *[]secp256k1.G1Affine) (int64, error) {
+	var buf [secp256k1.SizeOfG1AffineUncompressed]byte
+	read, err := io.ReadFull(r, buf[:4])
+	n := int64(read)
+	if err != nil {
+		return n, err
+	}
+	nbPoints := binary.BigEndian.Uint32(buf[:4])
+
+	// the points are appended one at a time, so that a corrupted length does
+	// not trigger a large allocation.
+	*points = (*points)[:0]
+	for i := uint32(0); i < nbPoints; i++ {
+		read, err = io.ReadFull(r, buf[:])
+		n += int64(read)
+		if err != nil {
+			return n, err
+		}
+		var p secp256k1.G1Affine
+		if _, err = p.SetBytes(buf[:]); err != nil {
+			return n, err
+		}
+		*points = append(*points, p)
+	}
+	return n, nil
+}
+
+// readScalar reads a big endian encoded scalar, checking that it is reduced.
+func readScalar(r io.Reader, s *fr.Element) (int64, error) {
+	var buf [fr.Bytes]byte
+	read, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(read), err
+	}
+	return int64(read), s.SetBytesCanonical(buf[:])
+}
@@ -0,0 +1,141 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+var errBatchSize = errors.New("number of public keys, signatures and messages mismatch")
+
+// BatchVerifyError is returned by BatchVerify when some signatures of the
+// batch are invalid.
+type BatchVerifyError struct {
+	// Indices of the invalid signatures, in increasing order.
+	Indices []int
+}
+
+func (e *BatchVerifyError) Error() string {
+	return fmt.Sprintf("invalid signatures at indices %v", e.Indices)
+}
+
+// batchEntry holds a decoded signature of the batch and its random coefficient.
+type batchEntry struct {
+	P, R    secp256k1.G1Affine
+	s, e, a fr.Element
+}
+
+// BatchVerify verifies the BIP-340 signatures sigs[i] of msgs[i] by pubs[i].
+//
+// For random 128-bit aᵢ it checks with a single multi-scalar multiplication that
+//
+//	(∑ aᵢ⋅sᵢ)⋅G - ∑ aᵢ⋅Rᵢ - ∑ (aᵢ⋅eᵢ)⋅Pᵢ = 0
+//
+// where Rᵢ is the point with x coordinate rᵢ and even y coordinate. This holds
+// for a batch containing an invalid signature with probability at most 2⁻¹²⁸.
+// If the check fails, the batch is bisected to isolate the invalid signatures,
+// whose indices are returned in a *BatchVerifyError.
+//
+// If hFunc is not nil, the messages are hashed with hFunc before being verified.
+func BatchVerify(pubs []PublicKey, sigs [][]byte, msgs [][]byte, hFunc hash.Hash) (bool, error) {
+	if len(pubs) != len(sigs) || len(pubs) != len(msgs) {
+		return false, errBatchSize
+	}
+
+	entries := make([]batchEntry, len(pubs))
+	wellFormed := make([]int, 0, len(pubs))
+	var invalid []int
+	var aBytes [16]byte
+	for i := range pubs {
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+		e := &entries[i]
+		var r fp.Element
+		r.SetBytes(sig.R[:])
+		if err := liftX(&e.R, &r); err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+		e.P.Set(&pubs[i].A)
+		e.s.SetBytes(sig.S[:])
+
+		m, err := hashMessage(msgs[i], hFunc)
+		if err != nil {
+			return false, err
+		}
+		px := e.P.X.Bytes()
+		e.e = challenge(sig.R[:], px[:], m)
+
+		for e.a.IsZero() {
+			if _, err := rand.Read(aBytes[:]); err != nil {
+				return false, err
+			}
+			e.a.SetBytes(aBytes[:])
+		}
+		wellFormed = append(wellFormed, i)
+	}
+
+	invalid = append(invalid, findInvalid(entries, wellFormed)...)
+	if len(invalid) == 0 {
+		return true, nil
+	}
+	sort.Ints(invalid)
+	return false, &BatchVerifyError{Indices: invalid}
+}
+
+// findInvalid returns the indices in subset of the invalid signatures, halving
+// the subset as long as its random linear combination does not verify.
+func findInvalid(entries []batchEntry, subset []int) []int {
+	if len(subset) == 0 || verifyCombination(entries, subset) {
+		return nil
+	}
+	if len(subset) == 1 {
+		return []int{subset[0]}
+	}
+	mid := len(subset) / 2
+	return append(findInvalid(entries, subset[:mid]), findInvalid(entries, subset[mid:])...)
+}
+
+// verifyCombination checks the random linear combination of the signatures in subset.
+func verifyCombination(entries []batchEntry, subset []int) bool {
+	_, g := secp256k1.Generators()
+
+	points := make([]secp256k1.G1Affine, 0, 2*len(subset)+1)
+	scalars := make([]fr.Element, 2*len(subset)+1)
+
+	var sumS fr.Element
+	for k, i := range subset {
+		e := &entries[i]
+		var tmp fr.Element
+		sumS.Add(&sumS, tmp.Mul(&e.a, &e.s))
+
+		// -aᵢ⋅Rᵢ
+		points = append(points, e.R)
+		scalars[2*k].Neg(&e.a)
+
+		// -(aᵢ⋅eᵢ)⋅Pᵢ
+		points = append(points, e.P)
+		scalars[2*k+1].Mul(&e.a, &e.e).Neg(&scalars[2*k+1])
+	}
+	points = append(points, g)
+	scalars[2*len(subset)] = sumS
+
+	var res secp256k1.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false
+	}
+	return res.Z.IsZero()
+}
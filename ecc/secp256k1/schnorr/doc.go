@@ -0,0 +1,17 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package schnorr provides BIP-340 Schnorr signatures on the secp256k1 curve.
+//
+// Public keys are x-only: a public key is the 32-byte x coordinate of the
+// point with even y among ±P, and the secret scalar is negated accordingly
+// when signing. Nonces are derived deterministically from the secret key, the
+// message and 32 bytes of auxiliary randomness, and all hashes are tagged
+// hashes SHA256(SHA256(tag) ∥ SHA256(tag) ∥ m).
+//
+// Messages are signed as is, so they may have any length. If a hash function
+// is given to Sign or Verify, the message is first hashed with it.
+//
+// Documentation:
+//   - BIP-340: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+package schnorr
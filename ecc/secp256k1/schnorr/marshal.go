@@ -0,0 +1,126 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+var errWrongSize = errors.New("wrong size buffer")
+var errRBiggerThanPMod = errors.New("r >= p_mod")
+var errSBiggerThanRMod = errors.New("s >= r_mod")
+
+// Bytes returns the binary representation of the public key, that is the
+// x coordinate of the point as a 32 bytes big endian integer (BIP-340 x-only
+// encoding).
+func (pk *PublicKey) Bytes() []byte {
+	var res [sizePublicKey]byte
+	pkBin := pk.A.X.Bytes()
+	subtle.ConstantTimeCopy(1, res[:], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets pk from its x-only binary representation in buf, that is
+// the point with x coordinate buf[:32] and even y coordinate.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	var x fp.Element
+	if err := x.SetBytesCanonical(buf[:sizePublicKey]); err != nil {
+		return 0, err
+	}
+	if err := liftX(&pk.A, &x); err != nil {
+		return 0, err
+	}
+	return sizePublicKey, nil
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	pubkBin := privKey.PublicKey.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizePublicKey], pubkBin)
+	subtle.ConstantTimeCopy(1, res[sizePublicKey:], privKey.scalar[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as  publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+// The public key is recomputed from the scalar and must match publicKey.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	var res PrivateKey
+	subtle.ConstantTimeCopy(1, res.scalar[:], buf[sizePublicKey:sizePrivateKey])
+	if err := res.setPublicKey(); err != nil {
+		return 0, err
+	}
+	if subtle.ConstantTimeCompare(res.PublicKey.Bytes(), buf[:sizePublicKey]) != 1 {
+		return 0, ErrInvalidPrivateKey
+	}
+	*privKey = res
+	return sizePrivateKey, nil
+}
+
+// SetSecretKey sets privKey from the 32 bytes big endian secret scalar sk, as
+// defined in BIP-340, and computes the associated public key.
+func (privKey *PrivateKey) SetSecretKey(sk []byte) error {
+	if len(sk) != sizeFr {
+		return errWrongSize
+	}
+	var res PrivateKey
+	copy(res.scalar[:], sk)
+	if err := res.setPublicKey(); err != nil {
+		return err
+	}
+	*privKey = res
+	return nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size 64 r||s, where r is the x coordinate of the nonce
+// commitment.
+func (sig *Signature) Bytes() []byte {
+	var res [sizeSignature]byte
+	subtle.ConstantTimeCopy(1, res[:sizeFp], sig.R[:])
+	subtle.ConstantTimeCopy(1, res[sizeFp:], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary.
+// buf is read interpreted as r||s, and r (resp. s) must be smaller than the
+// modulus of the base field (resp. the order of the group).
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != sizeSignature {
+		return 0, errWrongSize
+	}
+
+	var r fp.Element
+	if err := r.SetBytesCanonical(buf[:sizeFp]); err != nil {
+		return 0, errRBiggerThanPMod
+	}
+	var s fr.Element
+	if err := s.SetBytesCanonical(buf[sizeFp:]); err != nil {
+		return 0, errSBiggerThanRMod
+	}
+
+	subtle.ConstantTimeCopy(1, sig.R[:], buf[:sizeFp])
+	subtle.ConstantTimeCopy(1, sig.S[:], buf[sizeFp:])
+	return sizeSignature, nil
+}
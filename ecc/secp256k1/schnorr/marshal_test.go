@@ -0,0 +1,79 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 100
+)
+
+func TestSerialization(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[SECP256K1] Schnorr serialization: SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+
+			var end PrivateKey
+			buf := privKey.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != sizePrivateKey {
+				return false
+			}
+
+			return end.PublicKey.Equal(&privKey.PublicKey) && subtle.ConstantTimeCompare(end.scalar[:], privKey.scalar[:]) == 1
+
+		},
+	))
+
+	properties.Property("[SECP256K1] Schnorr serialization: public key is x-only", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+
+			var pub PublicKey
+			buf := privKey.PublicKey.Bytes()
+			n, err := pub.SetBytes(buf)
+			if err != nil {
+				return false
+			}
+
+			return n == sizePublicKey && len(buf) == sizePublicKey && pub.A.Equal(&privKey.PublicKey.A)
+		},
+	))
+
+	properties.Property("[SECP256K1] Schnorr serialization: mismatched public key should be rejected", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			other, _ := GenerateKey(rand.Reader)
+
+			buf := privKey.Bytes()
+			copy(buf, other.PublicKey.Bytes())
+			var end PrivateKey
+			_, err := end.SetBytes(buf)
+			return err == ErrInvalidPrivateKey
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
@@ -0,0 +1,310 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizeFp         = fp.Bytes
+	sizePublicKey  = sizeFp
+	sizePrivateKey = sizePublicKey + sizeFr
+	sizeSignature  = sizeFp + sizeFr
+	sizeAuxRand    = 32
+)
+
+var (
+	// ErrInvalidPrivateKey is returned when the secret scalar is zero or not
+	// smaller than the order of the group.
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	// ErrNotOnCurve is returned when an x coordinate is not the abscissa of a
+	// point on the curve.
+	ErrNotOnCurve = errors.New("x is not the abscissa of a point on the curve")
+	// ErrInvalidAuxRand is returned when the auxiliary randomness is not 32 bytes long.
+	ErrInvalidAuxRand = errors.New("auxiliary randomness must be 32 bytes long")
+	// ErrInvalidSignature is returned when a freshly computed signature does not
+	// verify, which indicates a fault during signing.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// tags of the hashes defined in BIP-340
+const (
+	tagAux       = "BIP0340/aux"
+	tagNonce     = "BIP0340/nonce"
+	tagChallenge = "BIP0340/challenge"
+)
+
+// PublicKey represents a BIP-340 public key, that is a point of the curve with
+// even y coordinate.
+type PublicKey struct {
+	A secp256k1.G1Affine
+}
+
+// PrivateKey represents a BIP-340 private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// Signature represents a BIP-340 signature
+type Signature struct {
+	R [sizeFp]byte // x coordinate of the nonce commitment, in big Endian
+	S [sizeFr]byte // in big Endian
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	var buf [sizeFr + 16]byte
+	if _, err := io.ReadFull(rand, buf[:]); err != nil {
+		return nil, err
+	}
+
+	// reduce 384 bits modulo r-1 and add 1, so that the scalar is non-zero and
+	// close to uniform.
+	k := new(big.Int).SetBytes(buf[:])
+	n := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	k.Mod(k, n).Add(k, big.NewInt(1))
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:])
+	if err := privateKey.setPublicKey(); err != nil {
+		return nil, err
+	}
+	return privateKey, nil
+}
+
+// setPublicKey sets the public key from the secret scalar, normalized to even y.
+func (privKey *PrivateKey) setPublicKey() error {
+	var d fr.Element
+	if err := d.SetBytesCanonical(privKey.scalar[:]); err != nil || d.IsZero() {
+		return ErrInvalidPrivateKey
+	}
+	var bd big.Int
+	d.BigInt(&bd)
+	privKey.PublicKey.A.ScalarMultiplicationBase(&bd)
+	if !hasEvenY(&privKey.PublicKey.A) {
+		privKey.PublicKey.A.Neg(&privKey.PublicKey.A)
+	}
+	return nil
+}
+
+// TaggedHash returns SHA256(SHA256(tag) ∥ SHA256(tag) ∥ msgs[0] ∥ msgs[1] ∥ ...)
+// as defined in BIP-340.
+func TaggedHash(tag string, msgs ...[]byte) [sha256.Size]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+	var res [sha256.Size]byte
+	h.Sum(res[:0])
+	return res
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign performs the BIP-340 signature with 32 bytes of fresh auxiliary
+// randomness.
+//
+// If hFunc is not nil, the message is hashed with hFunc before being signed.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	var auxRand [sizeAuxRand]byte
+	if _, err := io.ReadFull(rand.Reader, auxRand[:]); err != nil {
+		return nil, err
+	}
+	return privKey.SignWithAuxRand(message, auxRand[:], hFunc)
+}
+
+// SignWithAuxRand performs the BIP-340 signature with the given 32 bytes of
+// auxiliary randomness. The signature is a deterministic function of the
+// private key, the message and auxRand.
+//
+//	d = sk if P has even y, else r - sk
+//	t = d ⊕ hash_aux(auxRand)
+//	k = hash_nonce(t ∥ x(P) ∥ m) (mod r), negated if R = k⋅G has odd y
+//	e = hash_challenge(x(R) ∥ x(P) ∥ m) (mod r)
+//	signature = x(R) ∥ k + e⋅d (mod r)
+//
+// If hFunc is not nil, the message is hashed with hFunc before being signed.
+func (privKey *PrivateKey) SignWithAuxRand(message, auxRand []byte, hFunc hash.Hash) ([]byte, error) {
+	if len(auxRand) != sizeAuxRand {
+		return nil, ErrInvalidAuxRand
+	}
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	var d fr.Element
+	if err := d.SetBytesCanonical(privKey.scalar[:]); err != nil || d.IsZero() {
+		return nil, ErrInvalidPrivateKey
+	}
+	var bd big.Int
+	d.BigInt(&bd)
+	var P secp256k1.G1Affine
+	P.ScalarMultiplicationBase(&bd)
+	if !hasEvenY(&P) {
+		d.Neg(&d)
+	}
+	px := P.X.Bytes()
+
+	// t = bytes(d) ⊕ hash_aux(auxRand)
+	t := d.Bytes()
+	tAux := TaggedHash(tagAux, auxRand)
+	for i := range t {
+		t[i] ^= tAux[i]
+	}
+
+	// k = hash_nonce(t ∥ x(P) ∥ m) (mod r)
+	kBytes := TaggedHash(tagNonce, t[:], px[:], m)
+	var k fr.Element
+	k.SetBytes(kBytes[:])
+	if k.IsZero() {
+		return nil, ErrInvalidSignature
+	}
+	var bk big.Int
+	k.BigInt(&bk)
+	var R secp256k1.G1Affine
+	R.ScalarMultiplicationBase(&bk)
+	if !hasEvenY(&R) {
+		k.Neg(&k)
+	}
+
+	var sig Signature
+	sig.R = R.X.Bytes()
+	e := challenge(sig.R[:], px[:], m)
+
+	// s = k + e⋅d (mod r)
+	var s fr.Element
+	s.Mul(&e, &d).Add(&s, &k)
+	sig.S = s.Bytes()
+
+	// check the signature to protect against faults, as recommended by BIP-340
+	if !verify(&privKey.PublicKey.A, &sig, m) {
+		return nil, ErrInvalidSignature
+	}
+
+	return sig.Bytes(), nil
+}
+
+// Verify validates the BIP-340 signature
+//
+//	e = hash_challenge(r ∥ x(P) ∥ m) (mod r)
+//	R = s⋅G - e⋅P
+//	R ≠ O, y(R) is even and x(R) = r
+//
+// If hFunc is not nil, the message is hashed with hFunc before being verified.
+func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	// Deserialize the signature
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	return verify(&publicKey.A, &sig, m), nil
+}
+
+// verify checks the signature sig of the hashed message m by P.
+func verify(P *secp256k1.G1Affine, sig *Signature, m []byte) bool {
+	px := P.X.Bytes()
+	e := challenge(sig.R[:], px[:], m)
+	e.Neg(&e)
+
+	var s fr.Element
+	s.SetBytes(sig.S[:])
+
+	var bs, be big.Int
+	s.BigInt(&bs)
+	e.BigInt(&be)
+
+	// R = s⋅G - e⋅P
+	var _R secp256k1.G1Jac
+	_R.JointScalarMultiplicationBase(P, &bs, &be)
+	var R secp256k1.G1Affine
+	R.FromJacobian(&_R)
+
+	if R.IsInfinity() || !hasEvenY(&R) {
+		return false
+	}
+	rx := R.X.Bytes()
+	return subtle.ConstantTimeCompare(rx[:], sig.R[:]) == 1
+}
+
+// challenge returns hash_challenge(r ∥ x(P) ∥ m) reduced modulo the order of the group.
+func challenge(r, px, m []byte) fr.Element {
+	eBytes := TaggedHash(tagChallenge, r, px, m)
+	var e fr.Element
+	e.SetBytes(eBytes[:])
+	return e
+}
+
+// hashMessage returns the message hashed with hFunc, or the message itself if hFunc is nil.
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
+// hasEvenY returns true if the y coordinate of p is even.
+func hasEvenY(p *secp256k1.G1Affine) bool {
+	return p.Y.Bits()[0]&1 == 0
+}
+
+// liftX returns the point with x coordinate x and even y coordinate.
+func liftX(p *secp256k1.G1Affine, x *fp.Element) error {
+	// y² = x³ + 7
+	var y2, b fp.Element
+	b.SetUint64(7)
+	y2.Square(x).Mul(&y2, x).Add(&y2, &b)
+	if p.Y.Sqrt(&y2) == nil {
+		return ErrNotOnCurve
+	}
+	p.X.Set(x)
+	if !hasEvenY(p) {
+		p.Y.Neg(&p.Y)
+	}
+	return nil
+}
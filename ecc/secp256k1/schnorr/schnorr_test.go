@@ -0,0 +1,187 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestSchnorr(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[SECP256K1] test the signing and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			hFunc := sha256.New()
+			sig, _ := privKey.Sign(msg, hFunc)
+			flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] test the signing and verification (raw message)", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			sig, _ := privKey.Sign(msg, nil)
+			flag, _ := publicKey.Verify(sig, msg, nil)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] public keys have even y", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			return hasEvenY(&privKey.PublicKey.A) && privKey.PublicKey.A.IsOnCurve()
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// TestVectors checks the implementation against the BIP-340 test vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+func TestVectors(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("testdata/test-vectors.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	for _, record := range records[1:] {
+		index, sk, pkHex, auxRand, msg, sigBin := record[0], record[1], record[2], record[3], decode(record[4]), decode(record[5])
+		expected := record[6] == "TRUE"
+
+		if sk != "" {
+			var privKey PrivateKey
+			if err := privKey.SetSecretKey(decode(sk)); err != nil {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			if !bytes.Equal(privKey.PublicKey.Bytes(), decode(pkHex)) {
+				t.Fatalf("vector %s: wrong public key", index)
+			}
+			sig, err := privKey.SignWithAuxRand(msg, decode(auxRand), nil)
+			if err != nil {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			if !bytes.Equal(sig, sigBin) {
+				t.Fatalf("vector %s: wrong signature", index)
+			}
+		}
+
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(decode(pkHex)); err != nil {
+			if expected {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			continue
+		}
+		ok, _ := publicKey.Verify(sigBin, msg, nil)
+		if ok != expected {
+			t.Fatalf("vector %s: expected verification result %v (%s)", index, expected, record[7])
+		}
+	}
+}
+
+func TestTaggedHash(t *testing.T) {
+	t.Parallel()
+
+	// SHA256(SHA256(tag) ∥ SHA256(tag) ∥ m)
+	msg := []byte("message")
+	tagHash := sha256.Sum256([]byte(tagChallenge))
+	expected := sha256.Sum256(append(append(tagHash[:], tagHash[:]...), msg...))
+	if TaggedHash(tagChallenge, msg[:3], msg[3:]) != expected {
+		t.Fatal("wrong tagged hash")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("testing Schnorr")
+	sig, err := privKey.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// s + r is a valid scalar for the same equation modulo r, but is not reduced
+	bad := make([]byte, sizeSignature)
+	copy(bad, sig)
+	for i := range bad[sizeFp:] {
+		bad[sizeFp+i] = 0xff
+	}
+	if ok, err := privKey.PublicKey.Verify(bad, msg, nil); ok || err == nil {
+		t.Fatal("non reduced s should be rejected")
+	}
+
+	// other signature of the same message with a fresh nonce
+	sig2, _ := privKey.Sign(msg, nil)
+	if bytes.Equal(sig, sig2) {
+		t.Fatal("auxiliary randomness should change the signature")
+	}
+	if ok, _ := privKey.PublicKey.Verify(sig2, msg, nil); !ok {
+		t.Fatal("second signature should verify")
+	}
+
+	// the aux randomness must be 32 bytes
+	if _, err := privKey.SignWithAuxRand(msg, make([]byte, 31), nil); err != ErrInvalidAuxRand {
+		t.Fatal("short auxiliary randomness should be rejected")
+	}
+}
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Schnorr sign()")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.Sign(msg, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Schnorr sign()")
+	sig, _ := privKey.Sign(msg, nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.PublicKey.Verify(sig, msg, nil)
+	}
+}
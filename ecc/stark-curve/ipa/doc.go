@@ -0,0 +1,20 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ipa provides a transparent polynomial commitment scheme based on the
+// inner product argument (IPA) of Bulletproofs, which does not require a pairing.
+//
+// The commitment to f(X) = ∑ᵢ fᵢXⁱ is the Pedersen vector commitment ∑ᵢ fᵢ⋅Gᵢ, where
+// the basis (Gᵢ) is derived by hashing to the curve, so that no trusted setup is
+// needed. An opening proof at z is an inner product argument for ⟨f, (zⁱ)ᵢ⟩ = f(z),
+// of size O(log n); verifying it takes O(n) group operations.
+//
+// The linear part of the verification is isolated in an Accumulator: SuccinctVerify
+// checks a proof in O(log n) and returns an accumulator, and many accumulators can
+// be checked at once with a single multi-scalar multiplication by CheckAccumulators.
+// This is the deferred check of Halo-style recursion, see https://eprint.iacr.org/2019/1021.pdf.
+//
+// The commitments are not hiding.
+package ipa
@@ -0,0 +1,471 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidNbDigests              = errors.New("number of digests is not the same as the number of polynomials")
+	ErrZeroNbDigests                 = errors.New("number of digests is zero")
+	ErrInvalidPolynomialSize         = errors.New("invalid polynomial size (larger than SRS or == 0)")
+	ErrInvalidSRS                    = errors.New("the size of the srs should be a power of two")
+	ErrInvalidProof                  = errors.New("number of rounds of the proof does not match the srs size")
+	ErrVerifyOpeningProof            = errors.New("can't verify opening proof")
+	ErrVerifyBatchOpeningSinglePoint = errors.New("can't verify batch opening proof at single point")
+	ErrMinSRSSize                    = errors.New("minimum srs size is 2")
+)
+
+// domain separation tags of the hash to curve deriving the srs
+const (
+	basisDST = "GNARK-CRYPTO-IPA-STARK-CURVE-BASIS"
+	uDST     = "GNARK-CRYPTO-IPA-STARK-CURVE-U"
+)
+
+// Digest commitment of a polynomial.
+type Digest = starkcurve.G1Affine
+
+// SRS holds the public parameters of the scheme, which are obtained by hashing to
+// the curve and have no trapdoor.
+type SRS struct {
+	// Basis of the Pedersen vector commitment, of size a power of two
+	Basis []starkcurve.G1Affine
+
+	// U is the point used to bind the inner product in the opening proofs
+	U starkcurve.G1Affine
+}
+
+// InnerProductProof proves that the inner product of the vector committed in C
+// with a public vector b is v. At each round, the vectors are folded in half.
+type InnerProductProof struct {
+	// L[j], R[j] commit to the cross terms of the j-th round
+	L, R []starkcurve.G1Affine
+
+	// A is the vector committed in C, folded down to a single scalar
+	A fr.Element
+}
+
+// OpeningProof IPA proof for a single polynomial and a single point.
+type OpeningProof struct {
+	// IPA proves that ⟨f, (zⁱ)ᵢ⟩ = f(z)
+	IPA InnerProductProof
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// BatchOpeningProof opening proof for many polynomials at the same point
+type BatchOpeningProof struct {
+	// IPA opening of ∑ᵢγⁱfᵢ
+	IPA InnerProductProof
+
+	// ClaimedValues purported values
+	ClaimedValues []fr.Element
+}
+
+// NewSRS returns a new SRS for polynomials of size at most size, which is rounded
+// up to the next power of two.
+// The i-th point of the basis is HashToG1 of i, encoded as a 64-bit big-endian integer.
+func NewSRS(size uint64) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	size = 1 << bits.Len64(size-1)
+
+	var srs SRS
+	var err error
+	srs.U, err = starkcurve.HashToG1(nil, []byte(uDST))
+	if err != nil {
+		return nil, err
+	}
+
+	srs.Basis = make([]starkcurve.G1Affine, size)
+	chErr := make(chan error, 1)
+	parallel.Execute(len(srs.Basis), func(start, end int) {
+		var msg [8]byte
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint64(msg[:], uint64(i))
+			p, err := starkcurve.HashToG1(msg[:], []byte(basisDST))
+			if err != nil {
+				select {
+				case chErr <- err:
+				default:
+				}
+				return
+			}
+			srs.Basis[i] = p
+		}
+	})
+	select {
+	case err := <-chErr:
+		return nil, err
+	default:
+	}
+
+	return &srs, nil
+}
+
+// nbRounds returns the number of rounds of the inner product argument for srs.
+func (srs *SRS) nbRounds() (int, error) {
+	n := len(srs.Basis)
+	if n < 2 || n&(n-1) != 0 {
+		return 0, ErrInvalidSRS
+	}
+	return bits.TrailingZeros(uint(n)), nil
+}
+
+// Commit commits to a polynomial using a multi exponentiation with the SRS.
+// It is assumed that the polynomial is in canonical form, in Montgomery form.
+func Commit(p []fr.Element, srs SRS, nbTasks ...int) (Digest, error) {
+
+	if len(p) == 0 || len(p) > len(srs.Basis) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res starkcurve.G1Affine
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(srs.Basis[:len(p)], p, config); err != nil {
+		return Digest{}, err
+	}
+
+	return res, nil
+}
+
+// Open computes an opening proof of polynomial p, committed in digest, at given point.
+// dataTranscript is some extra data that might be needed for Fiat Shamir, and is bound
+// to the first challenge.
+func Open(p []fr.Element, digest Digest, point fr.Element, hf hash.Hash, srs SRS, dataTranscript ...[]byte) (OpeningProof, error) {
+	if len(p) == 0 || len(p) > len(srs.Basis) {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+	nbRounds, err := srs.nbRounds()
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	var res OpeningProof
+	a := make([]fr.Element, len(srs.Basis))
+	copy(a, p)
+	b := powers(point, len(srs.Basis))
+	res.ClaimedValue = innerProduct(a, b)
+
+	fs := fiatshamir.NewTranscript(hf, challengeIDs(nbRounds)...)
+	res.IPA, err = proveInnerProduct(fs, srs, &digest, a, b, point, res.ClaimedValue, dataTranscript...)
+	return res, err
+}
+
+// Verify verifies a IPA opening proof at a single point, by checking the accumulator
+// returned by SuccinctVerify.
+func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, hf hash.Hash, srs SRS, dataTranscript ...[]byte) error {
+	acc, err := SuccinctVerify(commitment, proof, point, hf, srs, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	return CheckAccumulators(srs, acc)
+}
+
+// BatchOpenSinglePoint creates a batch opening proof at point of a list of polynomials.
+// It's an interactive protocol, made non-interactive using Fiat Shamir.
+//
+// * point is the point at which the polynomials are opened.
+// * digests is the list of committed polynomials to open, need to derive the challenge using Fiat Shamir.
+// * polynomials is the list of polynomials to open, they are supposed to be of the same size.
+// * dataTranscript extra data that might be needed to derive the challenge used for folding
+func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, srs SRS, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+
+	// check for invalid sizes
+	nbDigests := len(digests)
+	if nbDigests != len(polynomials) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return BatchOpeningProof{}, ErrZeroNbDigests
+	}
+	for i := range polynomials {
+		if len(polynomials[i]) == 0 || len(polynomials[i]) > len(srs.Basis) {
+			return BatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+	}
+	nbRounds, err := srs.nbRounds()
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	var res BatchOpeningProof
+
+	// compute the purported values
+	b := powers(point, len(srs.Basis))
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	parallel.Execute(len(polynomials), func(start, end int) {
+		for i := start; i < end; i++ {
+			res.ClaimedValues[i] = innerProduct(polynomials[i], b)
+		}
+	})
+
+	// derive the challenge γ, binded to the point and the commitments
+	fs := fiatshamir.NewTranscript(hf, append([]string{"gamma"}, challengeIDs(nbRounds)...)...)
+	gamma, err := deriveGamma(fs, point, digests, res.ClaimedValues, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	gammai := powers(gamma, nbDigests)
+
+	// compute ∑ᵢγⁱfᵢ
+	folded := make([]fr.Element, len(srs.Basis))
+	for i := range polynomials {
+		parallel.Execute(len(polynomials[i]), func(start, end int) {
+			var pj fr.Element
+			for j := start; j < end; j++ {
+				pj.Mul(&polynomials[i][j], &gammai[i])
+				folded[j].Add(&folded[j], &pj)
+			}
+		})
+	}
+	foldedDigest, foldedValue, err := fold(digests, res.ClaimedValues, gammai)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	res.IPA, err = proveInnerProduct(fs, srs, &foldedDigest, folded, b, point, foldedValue)
+	return res, err
+}
+
+// BatchVerifySinglePoint verifies a batched opening proof at a single point of a list of polynomials.
+//
+// * digests list of digests on which batchOpeningProof is based
+// * batchOpeningProof opening proof of digests
+// * dataTranscript extra data that might be needed to derive the challenge used for folding
+func BatchVerifySinglePoint(digests []Digest, batchOpeningProof *BatchOpeningProof, point fr.Element, hf hash.Hash, srs SRS, dataTranscript ...[]byte) error {
+
+	nbDigests := len(digests)
+	if nbDigests != len(batchOpeningProof.ClaimedValues) {
+		return ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return ErrZeroNbDigests
+	}
+	nbRounds, err := srs.nbRounds()
+	if err != nil {
+		return err
+	}
+
+	fs := fiatshamir.NewTranscript(hf, append([]string{"gamma"}, challengeIDs(nbRounds)...)...)
+	gamma, err := deriveGamma(fs, point, digests, batchOpeningProof.ClaimedValues, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	foldedDigest, foldedValue, err := fold(digests, batchOpeningProof.ClaimedValues, powers(gamma, nbDigests))
+	if err != nil {
+		return err
+	}
+
+	acc, err := succinctVerifyInnerProduct(fs, srs, &foldedDigest, &batchOpeningProof.IPA, point, foldedValue)
+	if err != nil {
+		return err
+	}
+	if err := CheckAccumulators(srs, acc); err != nil {
+		return ErrVerifyBatchOpeningSinglePoint
+	}
+	return nil
+}
+
+// challengeIDs returns the names of the challenges of the inner product argument:
+// w to bind the inner product, and xⱼ for the j-th round.
+func challengeIDs(nbRounds int) []string {
+	res := make([]string, nbRounds+1)
+	res[0] = "w"
+	for j := 0; j < nbRounds; j++ {
+		res[j+1] = "x" + strconv.Itoa(j)
+	}
+	return res
+}
+
+// proveInnerProduct proves that ⟨a, b⟩ = value, where a is committed in commitment
+// and b = (zⁱ)ᵢ for z = point.
+func proveInnerProduct(fs *fiatshamir.Transcript, srs SRS, commitment *Digest, a, b []fr.Element, point, value fr.Element, dataTranscript ...[]byte) (InnerProductProof, error) {
+	var res InnerProductProof
+
+	// the inner product is bound with q = w⋅U
+	w, err := deriveChallenge(fs, "w", []starkcurve.G1Affine{*commitment}, []fr.Element{point, value}, dataTranscript...)
+	if err != nil {
+		return res, err
+	}
+
+	g := srs.Basis
+	a = append([]fr.Element(nil), a...)
+	b = append([]fr.Element(nil), b...)
+
+	nbRounds := bits.TrailingZeros(uint(len(g)))
+	res.L = make([]starkcurve.G1Affine, nbRounds)
+	res.R = make([]starkcurve.G1Affine, nbRounds)
+	points := make([]starkcurve.G1Affine, 0, len(g)/2+1)
+	scalars := make([]fr.Element, 0, len(g)/2+1)
+	var wz fr.Element
+	for j := 0; j < nbRounds; j++ {
+		m := len(a) / 2
+		aL, aR := a[:m], a[m:]
+		bL, bR := b[:m], b[m:]
+		gL, gR := g[:m], g[m:]
+
+		// L = ⟨a_R, G_L⟩ + ⟨a_R, b_L⟩⋅q
+		wz = innerProduct(aR, bL)
+		wz.Mul(&wz, &w)
+		points = append(append(points[:0], gL...), srs.U)
+		scalars = append(append(scalars[:0], aR...), wz)
+		if _, err := res.L[j].MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+			return res, err
+		}
+
+		// R = ⟨a_L, G_R⟩ + ⟨a_L, b_R⟩⋅q
+		wz = innerProduct(aL, bR)
+		wz.Mul(&wz, &w)
+		points = append(append(points[:0], gR...), srs.U)
+		scalars = append(append(scalars[:0], aL...), wz)
+		if _, err := res.R[j].MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+			return res, err
+		}
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), []starkcurve.G1Affine{res.L[j], res.R[j]}, nil)
+		if err != nil {
+			return res, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		// a ← a_L + x⋅a_R, b ← b_L + x⁻¹⋅b_R
+		parallel.Execute(m, func(start, end int) {
+			var tmp fr.Element
+			for i := start; i < end; i++ {
+				tmp.Mul(&x, &aR[i])
+				aL[i].Add(&aL[i], &tmp)
+				tmp.Mul(&xInv, &bR[i])
+				bL[i].Add(&bL[i], &tmp)
+			}
+		})
+		a, b = aL, bL
+
+		// G ← G_L + x⁻¹⋅G_R, not needed after the last round
+		if j == nbRounds-1 {
+			break
+		}
+		var xInvBigInt big.Int
+		xInv.BigInt(&xInvBigInt)
+		gJac := make([]starkcurve.G1Jac, m)
+		parallel.Execute(m, func(start, end int) {
+			for i := start; i < end; i++ {
+				gJac[i].FromAffine(&gR[i])
+				gJac[i].ScalarMultiplication(&gJac[i], &xInvBigInt)
+				gJac[i].AddMixed(&gL[i])
+			}
+		})
+		g = starkcurve.BatchJacobianToAffineG1(gJac)
+	}
+	res.A.Set(&a[0])
+
+	return res, nil
+}
+
+// deriveGamma derives a challenge using Fiat Shamir to fold proofs.
+func deriveGamma(fs *fiatshamir.Transcript, point fr.Element, digests []Digest, claimedValues []fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	return deriveChallenge(fs, "gamma", digests, append([]fr.Element{point}, claimedValues...), dataTranscript...)
+}
+
+// deriveChallenge binds the points, the scalars and dataTranscript, in this order, to the
+// challenge name and returns it.
+func deriveChallenge(fs *fiatshamir.Transcript, name string, points []starkcurve.G1Affine, scalars []fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	for i := range points {
+		b := points[i].RawBytes()
+		if err := fs.Bind(name, b[:]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range scalars {
+		if err := fs.Bind(name, scalars[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind(name, dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var challenge fr.Element
+	challenge.SetBytes(b)
+	return challenge, nil
+}
+
+// fold folds digests and evaluations using the list of factors as random numbers.
+//
+// * digests list of digests to fold
+// * evaluations list of evaluations to fold
+// * factors list of multiplicative factors used for the folding (in Montgomery form)
+//
+// * Returns ∑ᵢcᵢdᵢ, ∑ᵢcᵢf(aᵢ)
+func fold(di []Digest, fai []fr.Element, ci []fr.Element) (Digest, fr.Element, error) {
+
+	// length inconsistency between digests and evaluations should have been done before calling this function
+	nbDigests := len(di)
+
+	// fold the claimed values ∑ᵢcᵢf(aᵢ)
+	var foldedEvaluations, tmp fr.Element
+	for i := 0; i < nbDigests; i++ {
+		tmp.Mul(&fai[i], &ci[i])
+		foldedEvaluations.Add(&foldedEvaluations, &tmp)
+	}
+
+	// fold the digests ∑ᵢ[cᵢ]([fᵢ(α)]G₁)
+	var foldedDigests Digest
+	_, err := foldedDigests.MultiExp(di, ci, ecc.MultiExpConfig{})
+	if err != nil {
+		return foldedDigests, foldedEvaluations, err
+	}
+
+	// folding done
+	return foldedDigests, foldedEvaluations, nil
+
+}
+
+// powers returns [1, x, x², …, xⁿ⁻¹].
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// innerProduct returns ∑ᵢ a[i]⋅b[i].
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	for i := 0; i < len(a) && i < len(b); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
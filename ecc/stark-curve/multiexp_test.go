@@ -0,0 +1,83 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package starkcurve
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExpG1(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 2
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	for _, nbPoints := range []int{1, 7, 73, 1100} {
+		nbPoints := nbPoints
+		properties.Property(fmt.Sprintf("[G1] MultiExp of %d points should match the sum of scalar multiplications", nbPoints), prop.ForAll(
+			func() bool {
+				points := make([]G1Affine, nbPoints)
+				scalars := make([]fr.Element, nbPoints)
+				var s fr.Element
+				var bs big.Int
+				for i := range points {
+					s.SetRandom()
+					points[i].ScalarMultiplicationBase(s.BigInt(&bs))
+					scalars[i].SetRandom()
+				}
+				// sprinkle some small and zero scalars
+				scalars[0].SetZero()
+				scalars[nbPoints/2].SetUint64(3)
+
+				var expected, tmp G1Jac
+				for i := range points {
+					tmp.FromAffine(&points[i])
+					tmp.ScalarMultiplication(&tmp, scalars[i].BigInt(&bs))
+					expected.AddAssign(&tmp)
+				}
+
+				var res, resSingleTask G1Jac
+				res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+				resSingleTask.MultiExp(points, scalars, ecc.MultiExpConfig{NbTasks: 1})
+
+				return res.Equal(&expected) && resSingleTask.Equal(&expected)
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkMultiExpG1(b *testing.B) {
+	const nbPoints = 1 << 14
+	points := make([]G1Affine, nbPoints)
+	scalars := make([]fr.Element, nbPoints)
+	var bs big.Int
+	for i := range points {
+		scalars[i].SetRandom()
+		points[i].ScalarMultiplicationBase(scalars[i].BigInt(&bs))
+	}
+
+	var res G1Jac
+	for n := 1 << 8; n <= nbPoints; n <<= 3 {
+		b.Run(fmt.Sprintf("%d points", n), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				res.MultiExp(points[:n], scalars[:n], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
@@ -16,4 +16,5 @@ const (
 	BW6_761
 	BW6_756
 	BW6_633
+	ED25519
 )
@@ -0,0 +1,9 @@
+// Package asm is a workaround to force go mod vendor to include the asm files
+// see https://github.com/Consensys/gnark-crypto/issues/619
+package asm
+
+const DUMMY = 0
+const qInvNeg = 0
+const mu = 0
+const q = 0
+const q0 = 0
@@ -0,0 +1,242 @@
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+// mul(res, x, y *Element)
+// Montgomery multiplication (REDC) of x and y, see mul_cios_one_limb
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVQ  x+8(FP), AX
+	MOVQ  0(AX), AX
+	MOVQ  y+16(FP), DX
+	MULQ  0(DX)              // hi, lo = x * y
+	MOVQ  AX, R15
+	MOVQ  DX, R14
+	MOVQ  $const_qInvNeg, DX
+	IMULQ DX, AX             // m = lo * qInvNeg
+	MOVQ  $const_q, CX
+	MULQ  CX                 // hi2, _ = m * q
+	NEGQ  R15
+
+	// carry = lo != 0
+	ADCQ    DX, R14       // hi = hi + hi2 + carry
+	MOVQ    $0, BX
+	ADCQ    $0, BX
+	MOVQ    R14, SI
+	SUBQ    CX, SI        // t = hi - q
+	SBBQ    $0, BX
+	CMOVQCC SI, R14       // if hi overflowed or hi >= q, hi = t
+	MOVQ    res+0(FP), AX
+	MOVQ    R14, 0(AX)
+	RET
+
+#define ADD_F64(in0, in1, in2, in3, in4, in5) \
+	VPADDQ    in1, in0, in3      \
+	VPCMPUQ   $1, in0, in3, in5  \
+	VPSUBQ    in4, in3, in2      \
+	VPMINUQ   in2, in3, in3      \
+	VPBLENDMQ in2, in3, in5, in2 \
+
+#define SUB_F64(in0, in1, in2, in3, in4) \
+	VPCMPUQ $1, in1, in0, in4  \
+	VPSUBQ  in1, in0, in2      \
+	VPADDQ  in3, in2, in4, in2 \
+
+#define MUL_F64(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12, in13) \
+	VPSRLQ    $32, in0, in3        \
+	VPSRLQ    $32, in1, in4        \
+	VPMULUDQ  in4, in3, in5        \
+	VPMULUDQ  in1, in3, in3        \
+	VPMULUDQ  in4, in0, in4        \
+	VPMULUDQ  in1, in0, in6        \
+	VPSRLQ    $32, in6, in7        \
+	VPADDQ    in7, in3, in3        \
+	VPANDQ    in9, in3, in7        \
+	VPADDQ    in7, in4, in4        \
+	VPSRLQ    $32, in3, in3        \
+	VPADDQ    in3, in5, in5        \
+	VPSRLQ    $32, in4, in7        \
+	VPADDQ    in7, in5, in5        \
+	VPSLLQ    $32, in4, in4        \
+	VPANDQ    in9, in6, in6        \
+	VPORQ     in4, in6, in6        \
+	VPSLLQ    $32, in6, in3        \
+	VPADDQ    in6, in3, in3        \
+	VPSUBQ    in3, in11, in3       \
+	VPSLLQ    $32, in3, in4        \
+	VPCMPUQ   $1, in3, in4, in12   \
+	VPSRLQ    $32, in3, in7        \
+	VPSUBQ    in7, in3, in3        \
+	VPADDQ    in10, in3, in12, in3 \
+	VPADDQ    in3, in5, in4        \
+	VPCMPUQ   $1, in5, in4, in13   \
+	VPSUBQ    in8, in4, in2        \
+	VPMINUQ   in2, in4, in4        \
+	VPBLENDMQ in2, in4, in13, in2  \
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+
+loop_1:
+	TESTQ     BX, BX
+	JEQ       done_2     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4
+	VMOVDQU64 0(DX), Z5
+	ADD_F64(Z4, Z5, Z6, Z7, Z0, K1)
+	VMOVDQU64 Z6, 0(CX)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX       // decrement n
+	JMP  loop_1
+
+done_2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+
+loop_3:
+	TESTQ     BX, BX
+	JEQ       done_4     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4
+	VMOVDQU64 0(DX), Z5
+	SUB_F64(Z4, Z5, Z6, Z0, K1)
+	VMOVDQU64 Z6, 0(CX)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX       // decrement n
+	JMP  loop_3
+
+done_4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+
+loop_5:
+	TESTQ     BX, BX
+	JEQ       done_6     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4
+	VMOVDQU64 0(DX), Z5
+	MUL_F64(Z4, Z5, Z6, Z7, Z8, Z9, Z10, Z11, Z0, Z1, Z2, Z3, K1, K2)
+	VMOVDQU64 Z6, 0(CX)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX       // decrement n
+	JMP  loop_5
+
+done_6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// n is the number of blocks of 8 elements to process
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	VPBROADCASTQ 0(DX), Z5       // b = *b
+
+loop_7:
+	TESTQ     BX, BX
+	JEQ       done_8     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4
+	VMOVDQA64 Z5, Z7
+	MUL_F64(Z4, Z7, Z6, Z8, Z9, Z10, Z11, Z12, Z0, Z1, Z2, Z3, K1, K2)
+	VMOVDQU64 Z6, 0(CX)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, CX
+	DECQ BX       // decrement n
+	JMP  loop_7
+
+done_8:
+	RET
+
+// innerProdVec(t *Element, a, b *Element, n uint64)
+// t[0...8] = sum(a[i::8] * b[i::8]); the caller adds up the 8 accumulators
+// n is the number of blocks of 8 elements to process
+TEXT ·innerProdVec(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         t+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	VPXORQ       Z7, Z7, Z7      // acc = 0
+
+loop_9:
+	TESTQ     BX, BX
+	JEQ       done_10    // n == 0, we are done
+	VMOVDQU64 0(R15), Z4
+	VMOVDQU64 0(DX), Z5
+	MUL_F64(Z4, Z5, Z6, Z9, Z10, Z11, Z12, Z13, Z0, Z1, Z2, Z3, K1, K2)
+	ADD_F64(Z7, Z6, Z7, Z8, Z0, K1)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	DECQ BX       // decrement n
+	JMP  loop_9
+
+done_10:
+	VMOVDQU64 Z7, 0(CX)
+	RET
@@ -0,0 +1,144 @@
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define ADD_F64(in0, in1, in2, in3, in4, in5) \
+	ADDS in1, in0, in3     \
+	SUB  in5, in3, in4     \
+	CSEL CS, in4, in3, in2 \
+	CMP  in5, in3          \
+	CSEL CS, in4, in2, in2 \
+
+#define SUB_F64(in0, in1, in2, in3, in4, in5) \
+	SUBS in1, in0, in3     \
+	ADD  in5, in3, in4     \
+	CSEL CS, in3, in4, in2 \
+
+#define MUL_F64(in0, in1, in2, in3, in4, in5, in6, in7, in8) \
+	MUL   in1, in0, in3     \
+	UMULH in1, in0, in4     \
+	MUL   in8, in3, in5     \
+	MUL   in7, in5, in6     \
+	ADDS  in6, in3, ZR      \
+	UMULH in7, in5, in5     \
+	ADCS  in5, in4, in4     \
+	SUB   in7, in4, in6     \
+	CSEL  CS, in6, in4, in2 \
+	CMP   in7, in4          \
+	CSEL  CS, in6, in2, in2 \
+
+// mul(res, x, y *Element)
+// Montgomery multiplication (REDC) of x and y, see mul_cios_one_limb
+TEXT ·mul(SB), NOFRAME|NOSPLIT, $0-24
+	LDP  res+0(FP), (R0, R1)
+	MOVD y+16(FP), R2
+	MOVD $const_q, R3
+	MOVD $const_qInvNeg, R4
+	MOVD (R1), R1
+	MOVD (R2), R2
+	MUL_F64(R1, R2, R1, R5, R6, R7, R8, R3, R4)
+	MOVD R1, (R0)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+// n is the number of elements to process
+TEXT ·addVec(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  res+0(FP), (R0, R1)
+	LDP  b+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+
+loop1:
+	CBZ    R3, done2
+	MOVD.P 8(R1), R6
+	MOVD.P 8(R2), R7
+	ADD_F64(R6, R7, R8, R9, R10, R4)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    loop1
+
+done2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+// n is the number of elements to process
+TEXT ·subVec(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  res+0(FP), (R0, R1)
+	LDP  b+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+
+loop3:
+	CBZ    R3, done4
+	MOVD.P 8(R1), R6
+	MOVD.P 8(R2), R7
+	SUB_F64(R6, R7, R8, R9, R10, R4)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    loop3
+
+done4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// n is the number of elements to process
+TEXT ·mulVec(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  res+0(FP), (R0, R1)
+	LDP  b+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+
+loop5:
+	CBZ    R3, done6
+	MOVD.P 8(R1), R6
+	MOVD.P 8(R2), R7
+	MUL_F64(R6, R7, R8, R9, R10, R11, R12, R4, R5)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    loop5
+
+done6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// n is the number of elements to process
+TEXT ·scalarMulVec(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  res+0(FP), (R0, R1)
+	LDP  b+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+	MOVD (R2), R7
+
+loop7:
+	CBZ    R3, done8
+	MOVD.P 8(R1), R6
+	MUL_F64(R6, R7, R8, R9, R10, R11, R12, R4, R5)
+	MOVD.P R8, 8(R0)
+	SUB    $1, R3, R3
+	JMP    loop7
+
+done8:
+	RET
+
+// innerProdVec(res, a, b *Element, n uint64) res = sum(a[0...n] * b[0...n])
+// n is the number of elements to process
+TEXT ·innerProdVec(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  res+0(FP), (R0, R1)
+	LDP  b+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+	MOVD ZR, R9              // acc = 0
+
+loop9:
+	CBZ    R3, done10
+	MOVD.P 8(R1), R6
+	MOVD.P 8(R2), R7
+	MUL_F64(R6, R7, R8, R12, R13, R14, R15, R4, R5)
+	ADD_F64(R9, R8, R9, R10, R11, R4)
+	SUB    $1, R3, R3
+	JMP    loop9
+
+done10:
+	MOVD R9, (R0)
+	RET
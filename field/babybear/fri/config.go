@@ -0,0 +1,124 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+)
+
+var ErrInvalidConfig = errors.New("invalid fri configuration")
+
+// Config parameters of FRI, trading the size of the proofs for the time of
+// the prover and the security level.
+type Config struct {
+
+	// BlowupFactor ρ⁻¹ = size_code_word/size_polynomial, a power of 2 ≥ 2.
+	BlowupFactor uint64
+
+	// FoldingFactor k of each step, the polynomial P being folded as ∑ⱼ xʲPⱼ
+	// where P = ∑ⱼ XʲPⱼ(Xᵏ). It is 2, 4, 8 or 16.
+	FoldingFactor uint64
+
+	// NbQueries number of queries of the verifier.
+	NbQueries int
+
+	// FinalDegree bound on the degree of the fully folded polynomial, which is
+	// sent in clear. At least one folding step is done.
+	FinalDegree uint64
+
+	// GrindingBits number of bits of the proof of work done by the prover
+	// before deriving the queries, at most 30.
+	GrindingBits int
+}
+
+// DefaultConfig returns a configuration with a blowup factor of 4, folding
+// by 4 down to a polynomial of degree 7, and 42 queries after a proof of
+// work of 16 bits, for about 100 bits of conjectured security.
+func DefaultConfig() Config {
+	return Config{
+		BlowupFactor:  4,
+		FoldingFactor: 4,
+		NbQueries:     42,
+		FinalDegree:   7,
+		GrindingBits:  16,
+	}
+}
+
+// Check returns an error if the configuration is invalid.
+func (c Config) Check() error {
+	if c.BlowupFactor < 2 || bits.OnesCount64(c.BlowupFactor) != 1 {
+		return fmt.Errorf("%w: blowup factor %d is not a power of 2 ≥ 2", ErrInvalidConfig, c.BlowupFactor)
+	}
+	switch c.FoldingFactor {
+	case 2, 4, 8, 16:
+	default:
+		return fmt.Errorf("%w: folding factor %d is not 2, 4, 8 or 16", ErrInvalidConfig, c.FoldingFactor)
+	}
+	if c.NbQueries < 1 {
+		return fmt.Errorf("%w: at least one query is needed", ErrInvalidConfig)
+	}
+	if c.GrindingBits < 0 || c.GrindingBits > 30 {
+		return fmt.Errorf("%w: %d grinding bits is out of [0, 30]", ErrInvalidConfig, c.GrindingBits)
+	}
+	return nil
+}
+
+// extensionBits number of bits of the extension field of the challenges
+const extensionBits = extDegree * fr.Bits
+
+// ConjecturedSecurityBits returns the security level, in bits, of FRI for
+// polynomials of the given size, under the conjecture that FRI is sound up to
+// the capacity bound (as in ethSTARK): each query then brings log₂(ρ⁻¹) bits,
+// to which the grinding bits are added. It is capped by the error of the
+// folding challenges, about |D|/|𝔽r⁴| for a domain D.
+func (c Config) ConjecturedSecurityBits(size uint64) float64 {
+	n := max(ecc.NextPowerOfTwo(size), 2)
+	domainBits := math.Log2(float64(n * c.BlowupFactor))
+	queryBits := float64(c.NbQueries)*math.Log2(float64(c.BlowupFactor)) + float64(c.GrindingBits)
+	return math.Min(queryBits, extensionBits-domainBits)
+}
+
+// ProvenSecurityBits returns the security level, in bits, of FRI for
+// polynomials of the given size, in the unique decoding regime: each query
+// detects a function (1-ρ)/2-far from the code with probability (1-ρ)/2, to
+// which the grinding bits are added. It is capped by the error of the folding
+// challenges, bounded by ∑ᵢ(kᵢ-1)|D|/|𝔽r⁴| for the folding factors kᵢ.
+func (c Config) ProvenSecurityBits(size uint64) float64 {
+	n := max(ecc.NextPowerOfTwo(size), 2)
+	var nbFoldings int
+	for _, k := range foldingArities(n, finalSize(n, c), c) {
+		nbFoldings += k - 1
+	}
+	rho := 1 / float64(c.BlowupFactor)
+	commitBits := extensionBits - math.Log2(float64(n*c.BlowupFactor)*float64(nbFoldings))
+	queryBits := float64(c.NbQueries)*math.Log2(2/(1+rho)) + float64(c.GrindingBits)
+	return math.Min(queryBits, commitBits)
+}
+
+// finalSize returns the number of coefficients of the fully folded polynomial,
+// when folding a polynomial of size n. At least one folding step is done.
+func finalSize(n uint64, config Config) int {
+	return int(min(ecc.NextPowerOfTwo(config.FinalDegree+1), n/2))
+}
+
+// foldingArities returns the folding factors of the steps folding a polynomial
+// of size n to a polynomial of size finalSize. All the steps use
+// config.FoldingFactor, except possibly the last one.
+func foldingArities(n uint64, finalSize int, config Config) []int {
+	var res []int
+	for m := int(n); m > finalSize; {
+		k := min(int(config.FoldingFactor), m/finalSize)
+		res = append(res, k)
+		m /= k
+	}
+	return res
+}
@@ -0,0 +1,292 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/extensions"
+)
+
+var (
+	ErrDeepQuotient  = errors.New("the opened rows do not match the quotient tested by fri")
+	ErrPointInDomain = errors.New("the evaluation point lies in the evaluation domain")
+)
+
+// CommittedColumns polynomials committed together, as the columns of a
+// matrix whose rows are their evaluations on the domain.
+type CommittedColumns struct {
+
+	// Root of the Merkle tree whose i-th leaf is the i-th row
+	Root Digest
+
+	coefficients [][]fr.Element
+	evaluations  [][]fr.Element // evaluations of the columns, in natural order
+	tree         *merkleTree
+}
+
+// Commit commits to columns of polynomials given by their coefficients, each
+// of at most the size of f.
+func (f *FRI) Commit(columns [][]fr.Element) (*CommittedColumns, error) {
+	res := &CommittedColumns{
+		coefficients: columns,
+		evaluations:  make([][]fr.Element, len(columns)),
+	}
+	for j := range columns {
+		var err error
+		if res.evaluations[j], err = f.lowDegreeExtension(columns[j]); err != nil {
+			return nil, err
+		}
+	}
+	res.tree = newMerkleTree(f.h, int(f.domain.Cardinality), func(i int, buf []fr.Element) []fr.Element {
+		for j := range res.evaluations {
+			buf = append(buf, res.evaluations[j][i])
+		}
+		return buf
+	})
+	res.Root = res.tree.root()
+	return res, nil
+}
+
+// RowOpening row of a commitment opened at a query, with its authentication
+// path.
+type RowOpening struct {
+	Values []fr.Element
+	Path   []Digest
+}
+
+// BatchOpeningProof proof of the evaluations of several commitments at
+// several points.
+type BatchOpeningProof struct {
+
+	// ClaimedValues[c][j][k] evaluation of the j-th column of the c-th
+	// commitment at the k-th point
+	ClaimedValues [][][]extensions.E4
+
+	// ProofOfProximity of the DEEP quotient
+	ProofOfProximity ProofOfProximity
+
+	// Rows[q][c] row of the c-th commitment at the q-th query
+	Rows [][]RowOpening
+}
+
+// BatchOpen proves the evaluations of all the columns of the commitments at
+// all the points.
+//
+// With α a challenge, S = ∑ⱼαʲfⱼ the combination of all the columns and
+// Vₖ = ∑ⱼαʲfⱼ(zₖ), FRI proves that
+//
+//	Q = ∑ₖ αᵏᵂ (S - Vₖ)/(X - zₖ)
+//
+// is a polynomial, W being the number of columns.
+func (f *FRI) BatchOpen(commitments []*CommittedColumns, points []extensions.E4) (BatchOpeningProof, error) {
+	var proof BatchOpeningProof
+	if err := f.checkPoints(points); err != nil {
+		return proof, err
+	}
+
+	proof.ClaimedValues = make([][][]extensions.E4, len(commitments))
+	for c := range commitments {
+		proof.ClaimedValues[c] = make([][]extensions.E4, len(commitments[c].coefficients))
+		for j, p := range commitments[c].coefficients {
+			proof.ClaimedValues[c][j] = make([]extensions.E4, len(points))
+			for k := range points {
+				proof.ClaimedValues[c][j][k] = evalAtExt(p, &points[k])
+			}
+		}
+	}
+
+	roots := make([]Digest, len(commitments))
+	for c := range commitments {
+		roots[c] = commitments[c].Root
+	}
+	tr := f.newTranscript()
+	alpha := f.absorbClaims(tr, roots, points, proof.ClaimedValues)
+
+	// evaluations of the quotient on the domain
+	n := int(f.domain.Cardinality)
+	combination := make([]extensions.E4, n) // S
+	var alphaJ extensions.E4
+	alphaJ.SetOne()
+	for c := range commitments {
+		for j := range commitments[c].evaluations {
+			for i, y := range commitments[c].evaluations[j] {
+				var t extensions.E4
+				t.MulByElement(&alphaJ, &y)
+				combination[i].Add(&combination[i], &t)
+			}
+			alphaJ.Mul(&alphaJ, &alpha)
+		}
+	}
+	combined := combineClaims(proof.ClaimedValues, len(points), &alpha) // Vₖ
+
+	quotient := make([]extensions.E4, n)
+	denominators := make([]extensions.E4, n)
+	var gamma extensions.E4 // αᵏᵂ
+	gamma.SetOne()
+	for k := range points {
+		var x fr.Element
+		x.SetOne()
+		for i := range denominators {
+			denominators[i].Neg(&points[k])
+			addBase(&denominators[i], &denominators[i], &x)
+			x.Mul(&x, &f.domain.Generator)
+		}
+		denominators = extensions.BatchInvertE4(denominators)
+		for i := range quotient {
+			var t extensions.E4
+			t.Sub(&combination[i], &combined[k]).Mul(&t, &denominators[i]).Mul(&t, &gamma)
+			quotient[i].Add(&quotient[i], &t)
+		}
+		gamma.Mul(&gamma, &alphaJ)
+	}
+
+	var positions []uint64
+	proof.ProofOfProximity, positions = f.prove(tr, quotient)
+
+	proof.Rows = make([][]RowOpening, len(positions))
+	for q, pos := range positions {
+		proof.Rows[q] = make([]RowOpening, len(commitments))
+		for c := range commitments {
+			row := &proof.Rows[q][c]
+			row.Values = make([]fr.Element, len(commitments[c].evaluations))
+			for j := range row.Values {
+				row.Values[j] = commitments[c].evaluations[j][pos]
+			}
+			row.Path = commitments[c].tree.prove(int(pos))
+		}
+	}
+	return proof, nil
+}
+
+// BatchVerify checks a proof built by BatchOpen for the commitments of the
+// given roots at the given points.
+func (f *FRI) BatchVerify(roots []Digest, points []extensions.E4, proof BatchOpeningProof) error {
+	if err := f.checkPoints(points); err != nil {
+		return err
+	}
+	if len(proof.ClaimedValues) != len(roots) || len(proof.Rows) != f.config.NbQueries {
+		return ErrProofShape
+	}
+	for c := range proof.ClaimedValues {
+		for j := range proof.ClaimedValues[c] {
+			if len(proof.ClaimedValues[c][j]) != len(points) {
+				return ErrProofShape
+			}
+		}
+	}
+
+	tr := f.newTranscript()
+	alpha := f.absorbClaims(tr, roots, points, proof.ClaimedValues)
+	positions, values, err := f.verify(tr, proof.ProofOfProximity)
+	if err != nil {
+		return err
+	}
+	combined := combineClaims(proof.ClaimedValues, len(points), &alpha)
+
+	for q, pos := range positions {
+		if len(proof.Rows[q]) != len(roots) {
+			return ErrProofShape
+		}
+
+		// S(x) at x = ωᵖᵒˢ
+		var combination, alphaJ extensions.E4
+		alphaJ.SetOne()
+		for c := range roots {
+			row := &proof.Rows[q][c]
+			if len(row.Values) != len(proof.ClaimedValues[c]) {
+				return ErrProofShape
+			}
+			if !f.h.verifyPath(&roots[c], row.Values, row.Path, pos, f.domain.Cardinality) {
+				return ErrMerklePath
+			}
+			for j := range row.Values {
+				var t extensions.E4
+				t.MulByElement(&alphaJ, &row.Values[j])
+				combination.Add(&combination, &t)
+				alphaJ.Mul(&alphaJ, &alpha)
+			}
+		}
+
+		var x fr.Element
+		x.Exp(f.domain.Generator, new(big.Int).SetUint64(pos))
+		var quotient, gamma extensions.E4
+		gamma.SetOne()
+		for k := range points {
+			var t, d extensions.E4
+			d.Neg(&points[k])
+			addBase(&d, &d, &x)
+			d.Inverse(&d)
+			t.Sub(&combination, &combined[k]).Mul(&t, &d).Mul(&t, &gamma)
+			quotient.Add(&quotient, &t)
+			gamma.Mul(&gamma, &alphaJ)
+		}
+		if !quotient.Equal(&values[q]) {
+			return ErrDeepQuotient
+		}
+	}
+	return nil
+}
+
+// checkPoints returns an error if one of the points is in the domain, where
+// the quotient is not defined.
+func (f *FRI) checkPoints(points []extensions.E4) error {
+	cardinality := new(big.Int).SetUint64(f.domain.Cardinality)
+	for k := range points {
+		var y extensions.E4
+		y.Exp(points[k], cardinality)
+		if y.IsOne() {
+			return ErrPointInDomain
+		}
+	}
+	return nil
+}
+
+// absorbClaims absorbs the roots, the points and the claimed values in the
+// transcript, and returns the challenge α combining the columns.
+func (f *FRI) absorbClaims(tr *transcript, roots []Digest, points []extensions.E4, claimedValues [][][]extensions.E4) extensions.E4 {
+	for c := range roots {
+		tr.absorbDigest(&roots[c])
+	}
+	tr.absorbExt(points...)
+	for c := range claimedValues {
+		for j := range claimedValues[c] {
+			tr.absorbExt(claimedValues[c][j]...)
+		}
+	}
+	return tr.squeezeExt()
+}
+
+// combineClaims returns Vₖ = ∑ⱼαʲvⱼₖ for each point, j running over the
+// columns of all the commitments.
+func combineClaims(claimedValues [][][]extensions.E4, nbPoints int, alpha *extensions.E4) []extensions.E4 {
+	res := make([]extensions.E4, nbPoints)
+	var alphaJ extensions.E4
+	alphaJ.SetOne()
+	for c := range claimedValues {
+		for j := range claimedValues[c] {
+			for k := range res {
+				var t extensions.E4
+				t.Mul(&alphaJ, &claimedValues[c][j][k])
+				res[k].Add(&res[k], &t)
+			}
+			alphaJ.Mul(&alphaJ, alpha)
+		}
+	}
+	return res
+}
+
+// evalAtExt returns p(z) for a polynomial p of coefficients in 𝔽r.
+func evalAtExt(p []fr.Element, z *extensions.E4) extensions.E4 {
+	var res extensions.E4
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, z)
+		addBase(&res, &res, &p[i])
+	}
+	return res
+}
@@ -0,0 +1,123 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/extensions"
+)
+
+func randomColumns(nbColumns, size int) [][]fr.Element {
+	res := make([][]fr.Element, nbColumns)
+	for j := range res {
+		res[j] = randomPolynomial(size)
+	}
+	return res
+}
+
+func randomPoints(n int) []extensions.E4 {
+	res := make([]extensions.E4, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestBatchOpen(t *testing.T) {
+	const size = 32
+	f, err := New(size, testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// commitments of different widths, with columns of different sizes
+	c0, err := f.Commit(randomColumns(3, size))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1, err := f.Commit(append(randomColumns(1, size/2), randomColumns(1, size)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitments := []*CommittedColumns{c0, c1}
+	roots := []Digest{c0.Root, c1.Root}
+	points := randomPoints(2)
+
+	proof, err := f.BatchOpen(commitments, points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.BatchVerify(roots, points, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// claimed values
+	for c := range commitments {
+		for j, p := range commitments[c].coefficients {
+			for k := range points {
+				var expected extensions.E4
+				for i := len(p) - 1; i >= 0; i-- {
+					expected.Mul(&expected, &points[k])
+					addBase(&expected, &expected, &p[i])
+				}
+				if !expected.Equal(&proof.ClaimedValues[c][j][k]) {
+					t.Fatalf("wrong claimed value of column %d of commitment %d at point %d", j, c, k)
+				}
+			}
+		}
+	}
+
+	var one fr.Element
+	one.SetOne()
+
+	// wrong claimed value: the quotient is not a polynomial
+	v := &proof.ClaimedValues[1][0][1]
+	addBase(v, v, &one)
+	if err := f.BatchVerify(roots, points, proof); err == nil {
+		t.Fatal("a wrong claimed value should be rejected")
+	}
+	addBase(v, v, new(fr.Element).Neg(&one))
+
+	// wrong point
+	if err := f.BatchVerify(roots, randomPoints(2), proof); err == nil {
+		t.Fatal("a wrong point should be rejected")
+	}
+
+	// wrong row
+	row := proof.Rows[0][1].Values
+	row[0].Add(&row[0], &one)
+	if err := f.BatchVerify(roots, points, proof); !errors.Is(err, ErrMerklePath) {
+		t.Fatalf("expected ErrMerklePath, got %v", err)
+	}
+	row[0].Sub(&row[0], &one)
+
+	// wrong number of commitments
+	if err := f.BatchVerify(roots[:1], points, proof); !errors.Is(err, ErrProofShape) {
+		t.Fatalf("expected ErrProofShape, got %v", err)
+	}
+	if err := f.BatchVerify(roots, points, proof); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBatchOpenPointInDomain(t *testing.T) {
+	f, err := New(8, testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := f.Commit(randomColumns(2, 8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	points := randomPoints(2)
+	points[1] = lift(&f.domain.Generator)
+	if _, err := f.BatchOpen([]*CommittedColumns{c}, points); !errors.Is(err, ErrPointInDomain) {
+		t.Fatalf("expected ErrPointInDomain, got %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/extensions"
+	"github.com/consensys/gnark-crypto/field/babybear/poseidon2"
+)
+
+// digestSize number of field elements of a digest, half of the width of the
+// Poseidon2 permutation.
+const digestSize = 8
+
+// Digest root of a Merkle tree, or node of its authentication paths.
+type Digest [digestSize]fr.Element
+
+// hasher hashes the leaves and the nodes of the Merkle trees with the
+// Poseidon2 permutation of default parameters.
+//
+// A leaf is hashed with a sponge of rate digestSize, whose capacity is
+// initialized with the length of the leaf. Two nodes are compressed as in
+// poseidon2.Permutation.Compress.
+type hasher struct {
+	perm  *poseidon2.Permutation
+	state []fr.Element
+}
+
+func newHasher() *hasher {
+	params := poseidon2.GetDefaultParameters()
+	return &hasher{
+		perm:  poseidon2.NewPermutation(params.Width, params.NbFullRounds, params.NbPartialRounds),
+		state: make([]fr.Element, 2*digestSize),
+	}
+}
+
+func (h *hasher) permute(state []fr.Element) {
+	if err := h.perm.Permutation(state); err != nil {
+		panic(err) // the state has the width of the permutation
+	}
+}
+
+// hashLeaf returns the digest of a leaf made of the elements of leaf.
+func (h *hasher) hashLeaf(leaf []fr.Element) Digest {
+	for i := range h.state {
+		h.state[i].SetZero()
+	}
+	h.state[len(h.state)-1].SetUint64(uint64(len(leaf)))
+	for len(leaf) > 0 {
+		n := min(len(leaf), digestSize)
+		for i := 0; i < n; i++ {
+			h.state[i].Add(&h.state[i], &leaf[i])
+		}
+		h.permute(h.state)
+		leaf = leaf[n:]
+	}
+	var res Digest
+	copy(res[:], h.state)
+	return res
+}
+
+// compress returns the parent of the nodes left and right.
+func (h *hasher) compress(left, right *Digest) Digest {
+	copy(h.state, left[:])
+	copy(h.state[digestSize:], right[:])
+	h.permute(h.state)
+	var res Digest
+	for i := range res {
+		res[i].Add(&h.state[digestSize+i], &right[i])
+	}
+	return res
+}
+
+// merkleTree Merkle tree with a power of 2 number of leaves, keeping all its
+// nodes so that several leaves can be proven.
+type merkleTree struct {
+
+	// levels[0] are the hashes of the leaves, levels[len(levels)-1] contains
+	// the root.
+	levels [][]Digest
+}
+
+// newMerkleTree builds the Merkle tree of nbLeaves leaves, the i-th leaf
+// being returned by leaf(i, buf), where buf may be used to store it.
+func newMerkleTree(h *hasher, nbLeaves int, leaf func(i int, buf []fr.Element) []fr.Element) *merkleTree {
+	var res merkleTree
+	level := make([]Digest, nbLeaves)
+	var buf []fr.Element
+	for i := range level {
+		buf = leaf(i, buf[:0])
+		level[i] = h.hashLeaf(buf)
+	}
+	res.levels = append(res.levels, level)
+	for len(level) > 1 {
+		next := make([]Digest, len(level)/2)
+		for i := range next {
+			next[i] = h.compress(&level[2*i], &level[2*i+1])
+		}
+		res.levels = append(res.levels, next)
+		level = next
+	}
+	return &res
+}
+
+// root returns the root of the tree.
+func (t *merkleTree) root() Digest {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// nbLeaves returns the number of leaves of the tree.
+func (t *merkleTree) nbLeaves() int {
+	return len(t.levels[0])
+}
+
+// prove returns the authentication path of leaf i, from the leaves to the
+// root.
+func (t *merkleTree) prove(i int) []Digest {
+	res := make([]Digest, len(t.levels)-1)
+	for l := range res {
+		res[l] = t.levels[l][i^1]
+		i >>= 1
+	}
+	return res
+}
+
+// verifyPath returns true if path authenticates leaf as the i-th leaf of a
+// tree of nbLeaves leaves and of the given root.
+func (h *hasher) verifyPath(root *Digest, leaf []fr.Element, path []Digest, i, nbLeaves uint64) bool {
+	if i >= nbLeaves || uint64(1)<<len(path) != nbLeaves {
+		return false
+	}
+	node := h.hashLeaf(leaf)
+	for l := range path {
+		if i&1 == 0 {
+			node = h.compress(&node, &path[l])
+		} else {
+			node = h.compress(&path[l], &node)
+		}
+		i >>= 1
+	}
+	return node == *root
+}
+
+// transcript Fiat-Shamir transcript, as a duplex sponge over the Poseidon2
+// permutation with a rate of digestSize elements. Absorbing after squeezing
+// discards the remaining output.
+type transcript struct {
+	h      *hasher
+	state  []fr.Element
+	pos    int          // number of elements absorbed since the last permutation
+	output []fr.Element // squeezed elements not yet returned
+}
+
+func newTranscript(h *hasher) *transcript {
+	return &transcript{h: h, state: make([]fr.Element, 2*digestSize)}
+}
+
+// clone returns a copy of t, sharing its hasher.
+func (t *transcript) clone() *transcript {
+	res := &transcript{h: t.h, pos: t.pos}
+	res.state = append([]fr.Element{}, t.state...)
+	res.output = append([]fr.Element{}, t.output...)
+	return res
+}
+
+func (t *transcript) absorb(x ...fr.Element) {
+	t.output = t.output[:0]
+	for i := range x {
+		if t.pos == digestSize {
+			t.h.permute(t.state)
+			t.pos = 0
+		}
+		t.state[t.pos] = x[i]
+		t.pos++
+	}
+}
+
+func (t *transcript) absorbDigest(d *Digest) {
+	t.absorb(d[:]...)
+}
+
+func (t *transcript) absorbExt(x ...extensions.E4) {
+	for i := range x {
+		c := coordinates(&x[i])
+		t.absorb(c[:]...)
+	}
+}
+
+func (t *transcript) squeeze() fr.Element {
+	if len(t.output) == 0 {
+		t.h.permute(t.state)
+		t.pos = 0
+		t.output = append(t.output[:0], t.state[:digestSize]...)
+	}
+	res := t.output[len(t.output)-1]
+	t.output = t.output[:len(t.output)-1]
+	return res
+}
+
+func (t *transcript) squeezeExt() extensions.E4 {
+	var c [extDegree]fr.Element
+	for i := range c {
+		c[i] = t.squeeze()
+	}
+	return fromCoordinates(c[:])
+}
+
+// squeezeBits returns the nbBits least significant bits of a squeezed
+// element.
+func (t *transcript) squeezeBits(nbBits int) uint64 {
+	x := t.squeeze()
+	return x.Uint64() & (uint64(1)<<nbBits - 1)
+}
+
+// extDegree degree of the extension of the challenges
+const extDegree = 4
+
+// lift embeds x in the extension.
+func lift(x *fr.Element) extensions.E4 {
+	var res extensions.E4
+	res.B0.A0.Set(x)
+	return res
+}
+
+// addBase sets z to x + y where y is in the base field.
+func addBase(z, x *extensions.E4, y *fr.Element) {
+	z.Set(x)
+	z.B0.A0.Add(&z.B0.A0, y)
+}
+
+// coordinates returns the coordinates of x over 𝔽r.
+func coordinates(x *extensions.E4) [extDegree]fr.Element {
+	return [extDegree]fr.Element{x.B0.A0, x.B0.A1, x.B1.A0, x.B1.A1}
+}
+
+// fromCoordinates returns the element of coordinates c over 𝔽r.
+func fromCoordinates(c []fr.Element) extensions.E4 {
+	var res extensions.E4
+	res.B0.A0, res.B0.A1, res.B1.A0, res.B1.A1 = c[0], c[1], c[2], c[3]
+	return res
+}
@@ -233,6 +233,8 @@ func GenerateCommonASM(w io.Writer, nbWords, nbBits int, hasVector bool) error {
 	if nbWords == 1 {
 		if nbBits == 31 {
 			return GenerateF31ASM(f, hasVector)
+		} else if nbBits == 64 {
+			return GenerateF64ASM(f, hasVector)
 		} else {
 			panic("not implemented")
 		}
@@ -345,6 +347,48 @@ func GenerateF31ASM(f *FFAmd64, hasVector bool) error {
 	return nil
 }
 
+// GenerateF64ASM generates the assembly for the Goldilocks field; the scalar
+// multiplication is always generated, the AVX512 vector operations if hasVector is set.
+func GenerateF64ASM(f *FFAmd64, hasVector bool) error {
+	f.generateMulF64()
+	if !hasVector {
+		return nil
+	}
+
+	f.generateDefinesF64()
+	f.generateAddVecF64()
+	f.generateSubVecF64()
+	f.generateMulVecF64()
+	f.generateScalarMulVecF64()
+	f.generateInnerProdVecF64()
+
+	return nil
+}
+
+// GenerateF64FFTKernels generates the AVX512 radix-2 butterflies with precomputed
+// twiddles of the Goldilocks FFT.
+func GenerateF64FFTKernels(w io.Writer, nbBits int) error {
+	if nbBits != 64 {
+		return fmt.Errorf("only 64 bits supported")
+	}
+	f := NewFFAmd64(w, 1)
+
+	f.WriteLn("")
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+	f.Comment("Refer to the generator for more documentation.")
+	f.WriteLn("")
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	f.generateDefinesF64()
+	f.generateFFTInnerF64(true)
+	f.generateFFTInnerF64(false)
+
+	return nil
+}
+
 func ElementASMFileName(nbWords, nbBits int) string {
 	const nameW1 = "element_%db_amd64.s"
 	const nameWN = "element_%dw_amd64.s"
@@ -363,7 +407,7 @@ func ElementASMBaseDir(nbWords, nbBits int) string {
 	const fWN = "element_%dw"
 
 	if nbWords == 1 {
-		return fmt.Sprintf(fW1, 31)
+		return fmt.Sprintf(fW1, nbBits)
 	}
 	return fmt.Sprintf(fWN, nbWords)
 }
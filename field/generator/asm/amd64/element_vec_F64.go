@@ -0,0 +1,418 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// The vector code below is specialised for the Goldilocks field q = 2⁶⁴ - 2³² + 1,
+// with elements in Montgomery form (R = 2⁶⁴). A zmm register holds 8 elements.
+//
+// AVX512 has no 64x64 bits multiplication, so the 128 bits products are built from
+// four 32x32 bits VPMULUDQ. The Montgomery reduction of hi⋅2⁶⁴ + lo then needs no
+// multiplication, as q⁻¹ = 1 + 2³² (mod 2⁶⁴):
+//
+//	m = lo⋅(-q⁻¹) = -(lo + lo⋅2³²)      (mod 2⁶⁴)
+//	(lo + m⋅q) / 2⁶⁴ = m - ⌈m⋅(2³²-1) / 2⁶⁴⌉ + [lo ≠ 0]
+//	                 = m - (m >> 32) + [m⋅2³² mod 2⁶⁴ < m]
+//
+// and the result hi + m - (m >> 32) + [m⋅2³² mod 2⁶⁴ < m] < 2q is reduced with a conditional
+// subtraction; when the sum overflows 2⁶⁴, the wrapped value minus q is the correct result.
+
+// f64Registers holds the constants the vector macros need
+type f64Registers struct {
+	q, maskLo, one, zero amd64.VectorRegister
+}
+
+// vpcmpuqLT sets the mask k to a < b (unsigned, lane wise)
+func (f *FFAmd64) vpcmpuqLT(a, b, k any) {
+	f.WriteLn(fmt.Sprintf("    VPCMPUQ $1, %s, %s, %s", b, a, k))
+}
+
+// vpaddqk sets r = a + b on the lanes of the mask k
+func (f *FFAmd64) vpaddqk(a, b, k, r any) {
+	f.WriteLn(fmt.Sprintf("    VPADDQ %s, %s, %s, %s", b, a, k, r))
+}
+
+func (f *FFAmd64) loadConstantsF64(registers *amd64.Registers) f64Registers {
+	c := f64Registers{
+		q:      registers.PopV(),
+		maskLo: registers.PopV(),
+		one:    registers.PopV(),
+		zero:   registers.PopV(),
+	}
+	f.MOVQ("$const_q", amd64.AX)
+	f.VPBROADCASTQ(amd64.AX, c.q)
+	f.MOVQ("$0xffffffff", amd64.AX)
+	f.VPBROADCASTQ(amd64.AX, c.maskLo)
+	f.MOVQ("$1", amd64.AX)
+	f.VPBROADCASTQ(amd64.AX, c.one)
+	f.VPXORQ(c.zero, c.zero, c.zero)
+	return c
+}
+
+func (f *FFAmd64) generateDefinesF64() {
+	// res = a + b (mod q)
+	// requires b < q; a may be any 64 bits value
+	_ = f.Define("add_f64", 6, func(args ...any) {
+		a, b, res, t, q, k := args[0], args[1], args[2], args[3], args[4], args[5]
+		f.VPADDQ(b, a, t)
+		f.vpcmpuqLT(t, a, k) // k = carry
+		f.VPSUBQ(q, t, res)
+		f.VPMINUQ(res, t, t)
+		f.VPBLENDMQ(res, t, res, k)
+	})
+
+	// res = a - b (mod q)
+	_ = f.Define("sub_f64", 5, func(args ...any) {
+		a, b, res, q, k := args[0], args[1], args[2], args[3], args[4]
+		f.vpcmpuqLT(a, b, k) // k = borrow
+		f.VPSUBQ(b, a, res)
+		f.vpaddqk(res, q, k, res) // res += q on borrow
+	})
+
+	// res = a * b (mod q), Montgomery product
+	// res must differ from the temporaries; a and b are clobbered
+	_ = f.Define("mul_f64", 14, func(args ...any) {
+		a, b, res := args[0], args[1], args[2]
+		t0, t1, t2, t3, t4 := args[3], args[4], args[5], args[6], args[7]
+		q, maskLo, one, zero := args[8], args[9], args[10], args[11]
+		k1, k2 := args[12], args[13]
+
+		// 128 bits product hi, lo = a * b
+		f.VPSRLQ("$32", a, t0)
+		f.VPSRLQ("$32", b, t1)
+		f.VPMULUDQ(t1, t0, t2)
+		f.VPMULUDQ(b, t0, t0)
+		f.VPMULUDQ(t1, a, t1)
+		f.VPMULUDQ(b, a, t3)
+		f.VPSRLQ("$32", t3, t4)
+		f.VPADDQ(t4, t0, t0)
+		f.VPANDQ(maskLo, t0, t4)
+		f.VPADDQ(t4, t1, t1)
+		f.VPSRLQ("$32", t0, t0)
+		f.VPADDQ(t0, t2, t2)
+		f.VPSRLQ("$32", t1, t4)
+		f.VPADDQ(t4, t2, t2)
+		f.VPSLLQ("$32", t1, t1)
+		f.VPANDQ(maskLo, t3, t3)
+		f.VPORQ(t1, t3, t3)
+
+		// Montgomery reduction
+		f.VPSLLQ("$32", t3, t0)
+		f.VPADDQ(t3, t0, t0)
+		f.VPSUBQ(t0, zero, t0)
+		f.VPSLLQ("$32", t0, t1)
+		f.vpcmpuqLT(t1, t0, k1)
+		f.VPSRLQ("$32", t0, t4)
+		f.VPSUBQ(t4, t0, t0)
+		f.vpaddqk(t0, one, k1, t0) // t0 = (lo + m * q) / 2⁶⁴
+
+		// res = hi + t0 (mod q)
+		f.VPADDQ(t0, t2, t1)
+		f.vpcmpuqLT(t1, t2, k2)
+		f.VPSUBQ(q, t1, res)
+		f.VPMINUQ(res, t1, t1)
+		f.VPBLENDMQ(res, t1, res, k2)
+	})
+}
+
+func (f *FFAmd64) generateMulF64() {
+	f.Comment("mul(res, x, y *Element)")
+	f.Comment("Montgomery multiplication (REDC) of x and y, see mul_cios_one_limb")
+
+	registers := f.FnHeader("mul", 0, 24, amd64.AX, amd64.DX)
+	defer f.AssertCleanStack(0, 0)
+
+	lo := registers.Pop()
+	hi := registers.Pop()
+	qReg := registers.Pop()
+	carry := registers.Pop()
+	t := registers.Pop()
+
+	f.MOVQ("x+8(FP)", amd64.AX)
+	f.MOVQ("0(AX)", amd64.AX)
+	f.MOVQ("y+16(FP)", amd64.DX)
+	f.MULQ("0(DX)", "hi, lo = x * y")
+	f.MOVQ(amd64.AX, lo)
+	f.MOVQ(amd64.DX, hi)
+
+	f.MOVQ("$const_qInvNeg", amd64.DX)
+	f.IMULQ(amd64.DX, amd64.AX, "m = lo * qInvNeg")
+	f.MOVQ("$const_q", qReg)
+	f.MULQ(qReg, "hi2, _ = m * q")
+
+	f.WriteLn(fmt.Sprintf("    NEGQ %s", lo))
+	f.Comment("carry = lo != 0")
+	f.ADCQ(amd64.DX, hi, "hi = hi + hi2 + carry")
+	f.MOVQ("$0", carry)
+	f.ADCQ("$0", carry)
+
+	f.MOVQ(hi, t)
+	f.SUBQ(qReg, t, "t = hi - q")
+	f.SBBQ("$0", carry)
+	f.CMOVQCC(t, hi, "if hi overflowed or hi >= q, hi = t")
+
+	f.MOVQ("res+0(FP)", amd64.AX)
+	f.MOVQ(hi, "0(AX)")
+	f.RET()
+
+	registers.Push(lo, hi, qReg, carry, t)
+}
+
+// vecLoopF64 generates the loop body of a vector operation on 8 elements at a time.
+// The function has the signature fn(res, a, b *Element, n uint64), n being the number of blocks
+// of 8 elements.
+func (f *FFAmd64) vecLoopF64(name, doc string, broadcastB bool, body func(registers *amd64.Registers, c f64Registers, a, b, res amd64.VectorRegister)) {
+	f.Comment(doc)
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(name, 0, argSize, amd64.AX)
+	defer f.AssertCleanStack(0, 0)
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrRes := registers.Pop()
+	len := registers.Pop()
+
+	c := f.loadConstantsF64(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+	res := registers.PopV()
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	if broadcastB {
+		f.VPBROADCASTQ(addrB.At(0), b, "b = *b")
+	}
+
+	f.LABEL(loop)
+
+	f.TESTQ(len, len)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.VMOVDQU64(addrA.At(0), a)
+	if !broadcastB {
+		f.VMOVDQU64(addrB.At(0), b)
+	}
+	body(&registers, c, a, b, res)
+	f.VMOVDQU64(res, addrRes.At(0))
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ("$64", addrA)
+	if !broadcastB {
+		f.ADDQ("$64", addrB)
+	}
+	f.ADDQ("$64", addrRes)
+	f.DECQ(len, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrRes, len)
+}
+
+func (f *FFAmd64) mulF64(registers *amd64.Registers, c f64Registers, a, b, res amd64.VectorRegister) {
+	mul, err := f.DefineFn("mul_f64")
+	if err != nil {
+		panic(err)
+	}
+	t := registers.PopVN(5)
+	mul(a, b, res, t[0], t[1], t[2], t[3], t[4], c.q, c.maskLo, c.one, c.zero, amd64.K1, amd64.K2)
+	registers.PushV(t...)
+}
+
+func (f *FFAmd64) generateAddVecF64() {
+	add, err := f.DefineFn("add_f64")
+	if err != nil {
+		panic(err)
+	}
+	f.vecLoopF64("addVec", "addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]", false,
+		func(registers *amd64.Registers, c f64Registers, a, b, res amd64.VectorRegister) {
+			t := registers.PopV()
+			add(a, b, res, t, c.q, amd64.K1)
+			registers.PushV(t)
+		})
+}
+
+func (f *FFAmd64) generateSubVecF64() {
+	sub, err := f.DefineFn("sub_f64")
+	if err != nil {
+		panic(err)
+	}
+	f.vecLoopF64("subVec", "subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]", false,
+		func(registers *amd64.Registers, c f64Registers, a, b, res amd64.VectorRegister) {
+			sub(a, b, res, c.q, amd64.K1)
+		})
+}
+
+func (f *FFAmd64) generateMulVecF64() {
+	f.vecLoopF64("mulVec", "mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]", false, f.mulF64)
+}
+
+func (f *FFAmd64) generateScalarMulVecF64() {
+	f.vecLoopF64("scalarMulVec", "scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b", true,
+		func(registers *amd64.Registers, c f64Registers, a, b, res amd64.VectorRegister) {
+			// mul_f64 clobbers its inputs
+			bb := registers.PopV()
+			f.VMOVDQA64(b, bb)
+			f.mulF64(registers, c, a, bb, res)
+			registers.PushV(bb)
+		})
+}
+
+func (f *FFAmd64) generateInnerProdVecF64() {
+	f.Comment("innerProdVec(t *Element, a, b *Element, n uint64)")
+	f.Comment("t[0...8] = sum(a[i::8] * b[i::8]); the caller adds up the 8 accumulators")
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader("innerProdVec", 0, argSize, amd64.AX)
+	defer f.AssertCleanStack(0, 0)
+
+	add, err := f.DefineFn("add_f64")
+	if err != nil {
+		panic(err)
+	}
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrT := registers.Pop()
+	len := registers.Pop()
+
+	c := f.loadConstantsF64(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+	p := registers.PopV()
+	acc := registers.PopV()
+	t := registers.PopV()
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.MOVQ("t+0(FP)", addrT)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	f.VPXORQ(acc, acc, acc, "acc = 0")
+
+	f.LABEL(loop)
+
+	f.TESTQ(len, len)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.VMOVDQU64(addrA.At(0), a)
+	f.VMOVDQU64(addrB.At(0), b)
+	f.mulF64(&registers, c, a, b, p)
+	add(acc, p, acc, t, c.q, amd64.K1)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ("$64", addrA)
+	f.ADDQ("$64", addrB)
+	f.DECQ(len, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.VMOVDQU64(acc, addrT.At(0))
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrT, len)
+}
+
+// generateFFTInnerF64 generates the radix-2 butterflies of a FFT stage with precomputed twiddles
+//
+//	func innerDIFWithTwiddles_avx512(a, am, twiddles *Element, n uint64)
+//	func innerDITWithTwiddles_avx512(a, am, twiddles *Element, n uint64)
+//
+// where am points to a[m] and n is the number of blocks of 8 elements to process.
+// DIF computes (a[i], a[i+m]) = (a[i] + a[i+m], (a[i] - a[i+m]) * twiddles[i]) and
+// DIT computes (a[i], a[i+m]) = (a[i] + a[i+m] * twiddles[i], a[i] - a[i+m] * twiddles[i]).
+func (f *FFAmd64) generateFFTInnerF64(dif bool) {
+	name := "innerDITWithTwiddles_avx512"
+	if dif {
+		name = "innerDIFWithTwiddles_avx512"
+	}
+	const argSize = 4 * 8
+	registers := f.FnHeader(name, 0, argSize, amd64.AX)
+	defer f.AssertCleanStack(0, 0)
+
+	add, err := f.DefineFn("add_f64")
+	if err != nil {
+		panic(err)
+	}
+	sub, err := f.DefineFn("sub_f64")
+	if err != nil {
+		panic(err)
+	}
+
+	addrA := registers.Pop()
+	addrAm := registers.Pop()
+	addrTwiddles := registers.Pop()
+	len := registers.Pop()
+
+	c := f.loadConstantsF64(&registers)
+	a := registers.PopV()
+	am := registers.PopV()
+	w := registers.PopV()
+	s := registers.PopV()
+	d := registers.PopV()
+	t := registers.PopV()
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.MOVQ("a+0(FP)", addrA)
+	f.MOVQ("am+8(FP)", addrAm)
+	f.MOVQ("twiddles+16(FP)", addrTwiddles)
+	f.MOVQ("n+24(FP)", len)
+
+	f.LABEL(loop)
+
+	f.TESTQ(len, len)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.VMOVDQU64(addrA.At(0), a, "load a[i]")
+	f.VMOVDQU64(addrAm.At(0), am, "load a[i+m]")
+	f.VMOVDQU64(addrTwiddles.At(0), w, "load twiddles[i]")
+
+	if dif {
+		add(a, am, s, t, c.q, amd64.K1)
+		sub(a, am, d, c.q, amd64.K1)
+		f.mulF64(&registers, c, d, w, am)
+		f.VMOVDQU64(s, addrA.At(0), "store a[i]")
+		f.VMOVDQU64(am, addrAm.At(0), "store a[i+m]")
+	} else {
+		f.mulF64(&registers, c, am, w, d)
+		add(a, d, s, t, c.q, amd64.K1)
+		sub(a, d, am, c.q, amd64.K1)
+		f.VMOVDQU64(s, addrA.At(0), "store a[i]")
+		f.VMOVDQU64(am, addrAm.At(0), "store a[i+m]")
+	}
+
+	f.ADDQ("$64", addrA)
+	f.ADDQ("$64", addrAm)
+	f.ADDQ("$64", addrTwiddles)
+	f.DECQ(len, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrAm, addrTwiddles, len)
+}
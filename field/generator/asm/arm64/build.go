@@ -59,6 +59,8 @@ func GenerateCommonASM(w io.Writer, nbWords, nbBits int, hasVector bool) error {
 	if nbWords == 1 {
 		if nbBits == 31 {
 			return GenerateF31ASM(f, hasVector)
+		} else if nbBits == 64 {
+			return GenerateF64ASM(f, hasVector)
 		} else {
 			panic("not implemented")
 		}
@@ -236,3 +238,45 @@ func GenerateF31ASM(f *FFArm64, hasVector bool) error {
 
 	return nil
 }
+
+// GenerateF64ASM generates the assembly for the Goldilocks field; the scalar
+// multiplication is always generated, the vector operations if hasVector is set.
+func GenerateF64ASM(f *FFArm64, hasVector bool) error {
+	f.generateDefinesF64()
+	f.generateMulF64()
+	if !hasVector {
+		return nil
+	}
+
+	f.generateAddVecF64()
+	f.generateSubVecF64()
+	f.generateMulVecF64()
+	f.generateScalarMulVecF64()
+	f.generateInnerProdVecF64()
+
+	return nil
+}
+
+// GenerateF64FFTKernels generates the radix-2 butterflies with precomputed
+// twiddles of the Goldilocks FFT.
+func GenerateF64FFTKernels(w io.Writer, nbBits int) error {
+	if nbBits != 64 {
+		return fmt.Errorf("only 64 bits supported")
+	}
+	f := NewFFArm64(w, 1)
+
+	f.WriteLn("")
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+	f.Comment("Refer to the generator for more documentation.")
+	f.WriteLn("")
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	f.generateDefinesF64()
+	f.generateFFTInnerF64(true)
+	f.generateFFTInnerF64(false)
+
+	return nil
+}
@@ -0,0 +1,305 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package arm64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/arm64"
+)
+
+// NEON has no 64x64 bits multiplication, so the Goldilocks field operations are
+// scalar; the assembly saves the bound checks and keeps q in a register across
+// the loops.
+
+func (f *FFArm64) generateDefinesF64() {
+	// res = a + b (mod q)
+	// res must differ from t0, t1
+	_ = f.Define("add_f64", 6, func(args ...arm64.Register) {
+		a, b, res, t0, t1, q := args[0], args[1], args[2], args[3], args[4], args[5]
+		f.ADDS(b, a, t0)
+		f.SUB(q, t0, t1)
+		f.CSEL("CS", t1, t0, res)
+		f.CMP(q, t0)
+		f.CSEL("CS", t1, res, res)
+	})
+
+	// res = a - b (mod q)
+	// res must differ from t0, t1
+	_ = f.Define("sub_f64", 6, func(args ...arm64.Register) {
+		a, b, res, t0, t1, q := args[0], args[1], args[2], args[3], args[4], args[5]
+		f.SUBS(b, a, t0)
+		f.ADD(q, t0, t1)
+		f.CSEL("CS", t0, t1, res)
+	})
+
+	// res = a * b (mod q), Montgomery product (see mul_cios_one_limb)
+	// res must differ from the temporaries
+	_ = f.Define("mul_f64", 9, func(args ...arm64.Register) {
+		a, b, res := args[0], args[1], args[2]
+		lo, hi, m, t := args[3], args[4], args[5], args[6]
+		q, qInvNeg := args[7], args[8]
+		f.MUL(b, a, lo)
+		f.UMULH(b, a, hi)
+		f.MUL(qInvNeg, lo, m)
+		f.MUL(q, m, t)
+		f.ADDS(t, lo, "ZR")
+		f.UMULH(q, m, m)
+		f.ADCS(m, hi, hi)
+		f.SUB(q, hi, t)
+		f.CSEL("CS", t, hi, res)
+		f.CMP(q, hi)
+		f.CSEL("CS", t, res, res)
+	})
+}
+
+func (f *FFArm64) generateMulF64() {
+	f.Comment("mul(res, x, y *Element)")
+	f.Comment("Montgomery multiplication (REDC) of x and y, see mul_cios_one_limb")
+	registers := f.FnHeader("mul", 0, 24)
+	defer f.AssertCleanStack(0, 0)
+
+	mul, err := f.DefineFn("MUL_F64")
+	if err != nil {
+		panic(err)
+	}
+
+	resPtr := registers.Pop()
+	xPtr := registers.Pop()
+	yPtr := registers.Pop()
+	q := registers.Pop()
+	qInvNeg := registers.Pop()
+	t := registers.PopN(4)
+
+	f.LDP("res+0(FP)", resPtr, xPtr)
+	f.MOVD("y+16(FP)", yPtr)
+	f.MOVD("$const_q", q)
+	f.MOVD("$const_qInvNeg", qInvNeg)
+	f.MOVD(fmt.Sprintf("(%s)", xPtr), xPtr)
+	f.MOVD(fmt.Sprintf("(%s)", yPtr), yPtr)
+	mul(xPtr, yPtr, xPtr, t[0], t[1], t[2], t[3], q, qInvNeg)
+	f.MOVD(xPtr, fmt.Sprintf("(%s)", resPtr))
+	f.RET()
+
+	registers.Push(resPtr, xPtr, yPtr, q, qInvNeg)
+	registers.Push(t...)
+}
+
+// vecLoopF64 generates a loop over n elements for a function with the signature
+// fn(res, a, b *Element, n uint64).
+func (f *FFArm64) vecLoopF64(name, doc string, scalarB bool, body func(registers *arm64.Registers, a, b, res, q, qInvNeg arm64.Register)) {
+	f.Comment(doc)
+	f.Comment("n is the number of elements to process")
+	registers := f.FnHeader(name, 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	resPtr := registers.Pop()
+	aPtr := registers.Pop()
+	bPtr := registers.Pop()
+	n := registers.Pop()
+	q := registers.Pop()
+	qInvNeg := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	res := registers.Pop()
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.LDP("res+0(FP)", resPtr, aPtr)
+	f.LDP("b+16(FP)", bPtr, n)
+	f.MOVD("$const_q", q)
+	f.MOVD("$const_qInvNeg", qInvNeg)
+	if scalarB {
+		f.MOVD(fmt.Sprintf("(%s)", bPtr), b)
+	}
+
+	f.LABEL(loop)
+	f.CBZ(n, done)
+
+	f.MOVDP(8, aPtr, a)
+	if !scalarB {
+		f.MOVDP(8, bPtr, b)
+	}
+	body(&registers, a, b, res, q, qInvNeg)
+	f.WriteLn(fmt.Sprintf("    MOVD.P %s, 8(%s)", res, resPtr))
+
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	registers.Push(resPtr, aPtr, bPtr, n, q, qInvNeg, a, b, res)
+}
+
+func (f *FFArm64) mulF64(registers *arm64.Registers, a, b, res, q, qInvNeg arm64.Register) {
+	mul, err := f.DefineFn("MUL_F64")
+	if err != nil {
+		panic(err)
+	}
+	t := registers.PopN(4)
+	mul(a, b, res, t[0], t[1], t[2], t[3], q, qInvNeg)
+	registers.Push(t...)
+}
+
+func (f *FFArm64) generateAddVecF64() {
+	add, err := f.DefineFn("ADD_F64")
+	if err != nil {
+		panic(err)
+	}
+	f.vecLoopF64("addVec", "addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]", false,
+		func(registers *arm64.Registers, a, b, res, q, _ arm64.Register) {
+			t := registers.PopN(2)
+			add(a, b, res, t[0], t[1], q)
+			registers.Push(t...)
+		})
+}
+
+func (f *FFArm64) generateSubVecF64() {
+	sub, err := f.DefineFn("SUB_F64")
+	if err != nil {
+		panic(err)
+	}
+	f.vecLoopF64("subVec", "subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]", false,
+		func(registers *arm64.Registers, a, b, res, q, _ arm64.Register) {
+			t := registers.PopN(2)
+			sub(a, b, res, t[0], t[1], q)
+			registers.Push(t...)
+		})
+}
+
+func (f *FFArm64) generateMulVecF64() {
+	f.vecLoopF64("mulVec", "mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]", false, f.mulF64)
+}
+
+func (f *FFArm64) generateScalarMulVecF64() {
+	f.vecLoopF64("scalarMulVec", "scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b", true, f.mulF64)
+}
+
+func (f *FFArm64) generateInnerProdVecF64() {
+	f.Comment("innerProdVec(res, a, b *Element, n uint64) res = sum(a[0...n] * b[0...n])")
+	f.Comment("n is the number of elements to process")
+	registers := f.FnHeader("innerProdVec", 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	add, err := f.DefineFn("ADD_F64")
+	if err != nil {
+		panic(err)
+	}
+
+	resPtr := registers.Pop()
+	aPtr := registers.Pop()
+	bPtr := registers.Pop()
+	n := registers.Pop()
+	q := registers.Pop()
+	qInvNeg := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	p := registers.Pop()
+	acc := registers.Pop()
+	t := registers.PopN(2)
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.LDP("res+0(FP)", resPtr, aPtr)
+	f.LDP("b+16(FP)", bPtr, n)
+	f.MOVD("$const_q", q)
+	f.MOVD("$const_qInvNeg", qInvNeg)
+	f.MOVD("ZR", acc, "acc = 0")
+
+	f.LABEL(loop)
+	f.CBZ(n, done)
+
+	f.MOVDP(8, aPtr, a)
+	f.MOVDP(8, bPtr, b)
+	f.mulF64(&registers, a, b, p, q, qInvNeg)
+	add(acc, p, acc, t[0], t[1], q)
+
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.MOVD(acc, fmt.Sprintf("(%s)", resPtr))
+	f.RET()
+
+	registers.Push(resPtr, aPtr, bPtr, n, q, qInvNeg, a, b, p, acc)
+	registers.Push(t...)
+}
+
+// generateFFTInnerF64 generates the radix-2 butterflies of a FFT stage with precomputed twiddles
+//
+//	func innerDIFWithTwiddles_arm64(a, am, twiddles *Element, n uint64)
+//	func innerDITWithTwiddles_arm64(a, am, twiddles *Element, n uint64)
+//
+// where am points to a[m] and n is the number of butterflies.
+// DIF computes (a[i], a[i+m]) = (a[i] + a[i+m], (a[i] - a[i+m]) * twiddles[i]) and
+// DIT computes (a[i], a[i+m]) = (a[i] + a[i+m] * twiddles[i], a[i] - a[i+m] * twiddles[i]).
+func (f *FFArm64) generateFFTInnerF64(dif bool) {
+	name := "innerDITWithTwiddles_arm64"
+	if dif {
+		name = "innerDIFWithTwiddles_arm64"
+	}
+	registers := f.FnHeader(name, 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	add, err := f.DefineFn("ADD_F64")
+	if err != nil {
+		panic(err)
+	}
+	sub, err := f.DefineFn("SUB_F64")
+	if err != nil {
+		panic(err)
+	}
+
+	aPtr := registers.Pop()
+	amPtr := registers.Pop()
+	wPtr := registers.Pop()
+	n := registers.Pop()
+	q := registers.Pop()
+	qInvNeg := registers.Pop()
+	a := registers.Pop()
+	am := registers.Pop()
+	w := registers.Pop()
+	s := registers.Pop()
+	d := registers.Pop()
+	t := registers.PopN(2)
+
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.LDP("a+0(FP)", aPtr, amPtr)
+	f.LDP("twiddles+16(FP)", wPtr, n)
+	f.MOVD("$const_q", q)
+	f.MOVD("$const_qInvNeg", qInvNeg)
+
+	f.LABEL(loop)
+	f.CBZ(n, done)
+
+	f.MOVD(fmt.Sprintf("(%s)", aPtr), a, "load a[i]")
+	f.MOVD(fmt.Sprintf("(%s)", amPtr), am, "load a[i+m]")
+	f.MOVDP(8, wPtr, w, "load twiddles[i]")
+
+	if dif {
+		add(a, am, s, t[0], t[1], q)
+		sub(a, am, d, t[0], t[1], q)
+		f.mulF64(&registers, d, w, am, q, qInvNeg)
+	} else {
+		f.mulF64(&registers, am, w, d, q, qInvNeg)
+		add(a, d, s, t[0], t[1], q)
+		sub(a, d, am, t[0], t[1], q)
+	}
+	f.WriteLn(fmt.Sprintf("    MOVD.P %s, 8(%s)", s, aPtr))
+	f.WriteLn(fmt.Sprintf("    MOVD.P %s, 8(%s)", am, amPtr))
+
+	f.SUB(1, n, n)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	registers.Push(aPtr, amPtr, wPtr, n, q, qInvNeg, a, am, w, s, d)
+	registers.Push(t...)
+}
@@ -64,6 +64,7 @@ type Field struct {
 
 	Word Word // 32 iff Q < 2^32, else 64
 	F31  bool // 31 bits field
+	F64  bool // 64 bits Goldilocks field
 
 	// asm code generation
 	GenerateOpsAMD64       bool
@@ -105,10 +106,12 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	}
 	// pre compute field constants
 	F.NbBits = bModulus.BitLen()
-	// note: here we set F31 only for BabyBear and KoalaBear;
+	// note: here we set F31 only for BabyBear, KoalaBear and Mersenne31;
 	// we could do uint32 bit size for all fields with NbBits <= 31, but we keep it as is for now
 	// to avoid breaking changes
-	F.F31 = F.ModulusHex == "7f000001" || F.ModulusHex == "78000001" // F.NbBits <= 31
+	F.F31 = F.ModulusHex == "7f000001" || F.ModulusHex == "78000001" || F.ModulusHex == "7fffffff" // F.NbBits <= 31
+	// similarly, F64 is set only for Goldilocks; its assembly relies on q = 2⁶⁴ - 2³² + 1
+	F.F64 = F.ModulusHex == "ffffffff00000001"
 	F.NbWords = len(bModulus.Bits())
 	F.NbWordsLastIndex = F.NbWords - 1
 
@@ -300,16 +303,16 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// note: to simplify output files generated, we generated ASM code only for
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
-	F.GenerateOpsAMD64 = F.F31 || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
+	F.GenerateOpsAMD64 = F.F31 || F.F64 || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
 	if F.NbWords == 4 && F.GenerateOpsAMD64 && F.NbBits <= 225 {
 		// 4 words field with 225 bits or less have no vector ops
 		// for now since we generate both in same file we disable
 		// TODO @gbotrel
 		F.GenerateOpsAMD64 = false
 	}
-	F.GenerateVectorOpsAMD64 = F.F31 || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
-	F.GenerateOpsARM64 = F.F31 || (F.GenerateOpsAMD64 && (F.NbWords%2 == 0))
-	F.GenerateVectorOpsARM64 = F.F31
+	F.GenerateVectorOpsAMD64 = F.F31 || F.F64 || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
+	F.GenerateOpsARM64 = F.F31 || F.F64 || (F.GenerateOpsAMD64 && (F.NbWords%2 == 0))
+	F.GenerateVectorOpsARM64 = F.F31 || F.F64
 
 	// setting Mu 2^288 / q
 	if F.NbWords == 4 {
@@ -3,6 +3,7 @@ package generator
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/bits"
 	"os"
 	"path/filepath"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/asm/arm64"
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	eccconfig "github.com/consensys/gnark-crypto/internal/generator/config"
 )
@@ -35,6 +37,7 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		FieldPackagePath: fieldImportPath,
 		FF:               F.PackageName,
 		HasASMKernel:     F.F31,
+		HasASMButterfly:  F.F64,
 		Kernels:          []int{5, 8},
 		Package:          "fft",
 	}
@@ -44,6 +47,8 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 	if data.HasASMKernel {
 		pureGoBuildTag = "purego || (!amd64)"
 		data.Kernels = []int{8}
+	} else if data.HasASMButterfly {
+		pureGoBuildTag = "purego || (!amd64 && !arm64)"
 	}
 
 	entries := []bavard.Entry{
@@ -82,6 +87,28 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		fftKernels.Close()
 	}
 
+	if data.HasASMButterfly {
+		data.Q = F.Q[0]
+		data.QInvNeg = F.QInverse[0]
+		entries = append(entries,
+			bavard.Entry{
+				File:      filepath.Join(outputDir, "kernel_amd64.go"),
+				Templates: []string{"kernel.f64.amd64.go.tmpl"},
+				BuildTag:  "!purego"},
+			bavard.Entry{
+				File:      filepath.Join(outputDir, "kernel_arm64.go"),
+				Templates: []string{"kernel.f64.arm64.go.tmpl"},
+				BuildTag:  "!purego"})
+
+		// generate the assembly files;
+		if err := generateF64FFTKernels(filepath.Join(outputDir, "kernel_amd64.s"), F.NbBits, amd64.GenerateF64FFTKernels); err != nil {
+			return err
+		}
+		if err := generateF64FFTKernels(filepath.Join(outputDir, "kernel_arm64.s"), F.NbBits, arm64.GenerateF64FFTKernels); err != nil {
+			return err
+		}
+	}
+
 	funcs := make(map[string]interface{})
 	funcs["bitReverse"] = bitReverse
 	funcs["reverseBits"] = func(x, n any) uint64 {
@@ -128,11 +155,29 @@ type fftTemplateData struct {
 	FieldPackagePath string // path to the finite field package
 	FF               string // name of the package corresponding to the finite field
 	HasASMKernel     bool   // indicates if the kernels have an assembly impl
+	HasASMButterfly  bool   // indicates if the butterflies with twiddles have an assembly impl
 	Kernels          []int  // indicates which kernels to generate
 	Package          string // package name
 	Q, QInvNeg       uint64
 }
 
+func generateF64FFTKernels(path string, nbBits int, generate func(io.Writer, int) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	f.WriteString("//go:build !purego\n")
+
+	if err := generate(f, nbBits); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	return runASMFormatter(path)
+}
+
 func findTemplatesRootDir() (string, error) {
 	// walks through the directory tree to find the templates root dir;
 	// we find the dir with go.mod file, then go up to field/generator/internal/templates
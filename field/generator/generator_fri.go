@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// generateFRI generates the FRI package of a small field, whose challenges are
+// drawn in the extension of the field from the extensions package: 𝔽r⁴ for
+// the 31-bit fields, 𝔽r² for Goldilocks.
+func generateFRI(F *config.Field, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "fri")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(outputDir, "hash.go"), Templates: []string{"hash.go.tmpl"}},
+		{File: filepath.Join(outputDir, "hash_test.go"), Templates: []string{"hash.test.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
+		{File: filepath.Join(outputDir, "deep.go"), Templates: []string{"deep.go.tmpl"}},
+		{File: filepath.Join(outputDir, "deep_test.go"), Templates: []string{"deep.test.go.tmpl"}},
+	}
+
+	type friTemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Ext              string
+		ExtDegree        int
+		DigestSize       int
+	}
+
+	data := &friTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Ext:              "E2",
+		ExtDegree:        2,
+		DigestSize:       4,
+	}
+	if F.F31 {
+		data.Ext = "E4"
+		data.ExtDegree = 4
+		data.DigestSize = 8
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	friTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	friTemplatesRootDir = filepath.Join(friTemplatesRootDir, "fri")
+
+	if err := bgen.GenerateWithOptions(data, "fri", friTemplatesRootDir, nil, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
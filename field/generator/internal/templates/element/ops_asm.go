@@ -131,6 +131,61 @@ func fromMont(z *{{.ElementName}} ) {
 func reduce(res *{{.ElementName}})
 `
 
+// OpsF64 is included with AMD64 and ARM64 builds of the Goldilocks field;
+// only the multiplication is implemented in assembly.
+const OpsF64 = `
+import (
+	_ "{{.ASMPackagePath}}"
+)
+
+//go:noescape
+func mul(res, x, y *{{.ElementName}})
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	mul(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	// see Mul for doc.
+	mul(z, x, x)
+	return z
+}
+
+{{ $mulConsts := list 3 5 13 }}
+{{- range $i := $mulConsts }}
+
+// MulBy{{$i}} x *= {{$i}} (mod q)
+func MulBy{{$i}}(x *{{$.ElementName}}) {
+	var y {{$.ElementName}}
+	y.SetUint64({{$i}})
+	x.Mul(x, &y)
+}
+
+{{- end}}
+
+func fromMont(z *{{.ElementName}}) {
+	_fromMontGeneric(z)
+}
+
+func reduce(z *{{.ElementName}}) {
+	_reduceGeneric(z)
+}
+
+// Butterfly sets
+//  a = a + b (mod q)
+//  b = a - b (mod q)
+func Butterfly(a, b *{{.ElementName}}) {
+	_butterflyGeneric(a, b)
+}
+`
+
 const IncludeASM = `
 
 // We include the hash to force the Go compiler to recompile: {{.Hash}}
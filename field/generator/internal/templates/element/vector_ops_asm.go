@@ -450,3 +450,262 @@ func (vector *Vector) Mul(a, b Vector) {
 	}
 }
 `
+
+const VectorOpsAmd64F64 = `
+
+import (
+	_ "{{.ASMPackagePath}}"
+	"github.com/consensys/gnark-crypto/utils/cpu"
+)
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func innerProdVec(t *{{.ElementName}}, a, b *{{.ElementName}}, n uint64)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	const blockSize = 8
+	if !cpu.SupportAVX512 || n < blockSize {
+		// call addVecGeneric
+		addVecGeneric(*vector, a, b)
+		return
+	}
+
+	addVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call addVecGeneric on the rest
+		start := n - n % blockSize
+		addVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	const blockSize = 8
+	if !cpu.SupportAVX512 || n < blockSize {
+		// call subVecGeneric
+		subVecGeneric(*vector, a, b)
+		return
+	}
+
+	subVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call subVecGeneric on the rest
+		start := n - n % blockSize
+		subVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	const blockSize = 8
+	if !cpu.SupportAVX512 || n < blockSize {
+		// call scalarMulVecGeneric
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+
+	scalarMulVec(&(*vector)[0], &a[0], b, n/blockSize)
+	if n % blockSize != 0 {
+		// call scalarMulVecGeneric on the rest
+		start := n - n % blockSize
+		scalarMulVecGeneric((*vector)[start:], a[start:], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	n := uint64(len(*vector))
+	if n == 0 {
+		return
+	}
+	if n != uint64(len(other)) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	const blockSize = 8
+	if !cpu.SupportAVX512 || n < blockSize {
+		// call innerProductVecGeneric
+		innerProductVecGeneric(&res, *vector, other)
+		return
+	}
+
+	var t [8]{{.ElementName}} // stores the accumulators
+	innerProdVec(&t[0], &(*vector)[0], &other[0], n/blockSize)
+	for i := 0; i < 8; i++ {
+		res.Add(&res, &t[i])
+	}
+	if n % blockSize != 0 {
+		// call innerProductVecGeneric on the rest
+		start := n - n % blockSize
+		var v {{.ElementName}}
+		innerProductVecGeneric(&v, (*vector)[start:], other[start:])
+		res.Add(&res, &v)
+	}
+
+	return
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	const blockSize = 8
+	if !cpu.SupportAVX512 || n < blockSize {
+		// call mulVecGeneric
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+
+	mulVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call mulVecGeneric on the rest
+		start := n - n % blockSize
+		mulVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+`
+
+const VectorOpsArm64F64 = `
+
+import (
+	_ "{{.ASMPackagePath}}"
+)
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func innerProdVec(res, a, b *{{.ElementName}}, n uint64)
+
+// note: NEON has no 64x64 bits multiplication; the assembly processes one
+// element at a time.
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	scalarMulVec(&(*vector)[0], &a[0], b, n)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	n := uint64(len(*vector))
+	if n == 0 {
+		return
+	}
+	if n != uint64(len(other)) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	innerProdVec(&res, &(*vector)[0], &other[0], n)
+	return
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	mulVec(&(*vector)[0], &a[0], &b[0], n)
+}
+`
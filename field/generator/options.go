@@ -11,12 +11,17 @@ type generatorConfig struct {
 	asmConfig     *config.Assembly
 	withSIS       bool
 	withPoseidon2 bool
+	withFRI       bool
 }
 
 func (cfg *generatorConfig) HasPoseidon2() bool {
 	return cfg.withPoseidon2
 }
 
+func (cfg *generatorConfig) HasFRI() bool {
+	return cfg.withFRI
+}
+
 func (cfg *generatorConfig) HasSIS() bool {
 	return cfg.withSIS
 }
@@ -45,6 +50,14 @@ func WithPoseidon2() Option {
 	}
 }
 
+// WithFRI generates a FRI package with challenges in an extension of the
+// field. It requires the FFT and Poseidon2 packages.
+func WithFRI() Option {
+	return func(opt *generatorConfig) {
+		opt.withFRI = true
+	}
+}
+
 func WithFFT(cfg *config.FFT) Option {
 	return func(opt *generatorConfig) {
 		opt.fftConfig = cfg
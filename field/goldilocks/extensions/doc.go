@@ -0,0 +1,10 @@
+// Package extensions implements the fields arithmetic of the 𝔽r² and 𝔽r³
+// extensions of the Goldilocks field, 𝔽r² using the non-residue of Plonky2.
+//
+//	𝔽r²[u] = 𝔽r/u²-7
+//	𝔽r³[v] = 𝔽r/v³-v-1
+//
+// This package is maintained by hand: unlike the field packages, it is not
+// produced by the field generator (field/generator), and go generate leaves it
+// untouched. Changes to the base field API must be reflected here manually.
+package extensions
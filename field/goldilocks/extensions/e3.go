@@ -0,0 +1,381 @@
+package extensions
+
+import (
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E3 is a degree three finite field extension of fr.Element
+//
+//	𝔽r³[v] = 𝔽r/v³-v-1
+type E3 struct {
+	A0, A1, A2 fr.Element
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E3) Cmp(x *E3) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *E3) LexicographicallyLargest() bool {
+	if !z.A2.IsZero() {
+		return z.A2.LexicographicallyLargest()
+	}
+	if !z.A1.IsZero() {
+		return z.A1.LexicographicallyLargest()
+	}
+	return z.A0.LexicographicallyLargest()
+}
+
+// SetString sets a E3 element from strings
+func (z *E3) SetString(s1, s2, s3 string) *E3 {
+	z.A0.SetString(s1)
+	z.A1.SetString(s2)
+	z.A2.SetString(s3)
+	return z
+}
+
+// SetZero sets an E3 elmt to zero
+func (z *E3) SetZero() *E3 {
+	*z = E3{}
+	return z
+}
+
+// Set sets an E3 from x
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetRandom sets a0, a1 and a2 to random values
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add adds two elements of E3
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub subtracts two elements of E3
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double doubles an E3 element
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg negates an E3 element
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*v+" + z.A2.String() + "*v**2"
+}
+
+// MulByElement multiplies an element in E3 by an element in fr
+func (z *E3) MulByElement(x *E3, y *fr.Element) *E3 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// Halve sets z to z / 2
+func (z *E3) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+	z.A2.Halve()
+}
+
+// Mul sets z to the E3-product of x,y, returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Karatsuba method for cubic extensions
+	// https://eprint.iacr.org/2006/471.pdf (section 4)
+	//
+	// the schoolbook product is c0 + c1*v + c2*v² + c3*v³ + c4*v⁴ and
+	// v³ = v + 1, v⁴ = v² + v, so that
+	// z = (c0 + c3) + (c1 + c3 + c4)*v + (c2 + c4)*v²
+	var t0, t1, t2, c1, c2, c3, tmp fr.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c3.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c3.Mul(&c3, &tmp).Sub(&c3, &t1).Sub(&c3, &t2)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+
+	c2.Add(&x.A0, &x.A2)
+	tmp.Add(&y.A0, &y.A2)
+	c2.Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.A0.Add(&t0, &c3)
+	z.A1.Add(&c1, &c3).Add(&z.A1, &t2)
+	z.A2.Add(&c2, &t2)
+
+	return z
+}
+
+// Square sets z to the E3-product of x,x, returns z
+func (z *E3) Square(x *E3) *E3 {
+	// see Mul for the reduction; c1 = 2*a0*a1, c2 = 2*a0*a2 + a1², c3 = 2*a1*a2
+	var t0, t1, t2, c1, c2, c3 fr.Element
+	t0.Square(&x.A0)
+	t1.Square(&x.A1)
+	t2.Square(&x.A2)
+
+	c3.Mul(&x.A1, &x.A2).Double(&c3)
+	c1.Mul(&x.A0, &x.A1).Double(&c1)
+	c2.Mul(&x.A0, &x.A2).Double(&c2).Add(&c2, &t1)
+
+	z.A0.Add(&t0, &c3)
+	z.A1.Add(&c1, &c3).Add(&z.A1, &t2)
+	z.A2.Add(&c2, &t2)
+
+	return z
+}
+
+// Inverse sets z to the E3-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	var c0, c1, c2, det fr.Element
+	x.cofactors(&c0, &c1, &c2, &det)
+
+	det.Inverse(&det)
+	z.A0.Mul(&c0, &det)
+	z.A1.Mul(&c1, &det)
+	z.A2.Mul(&c2, &det)
+
+	return z
+}
+
+// norm sets x to the norm of z
+func (z *E3) norm(x *fr.Element) {
+	var c0, c1, c2 fr.Element
+	z.cofactors(&c0, &c1, &c2, x)
+}
+
+// cofactors sets c0, c1, c2 to the cofactors of the first row of the matrix
+// of the multiplication by z in the basis (1, v, v²)
+//
+//	| a0  a2     a1      |
+//	| a1  a0+a2  a1+a2   |
+//	| a2  a1     a0+a2   |
+//
+// and det to its determinant, i.e. the norm of z. Then z⁻¹ = (c0, c1, c2) / det.
+func (z *E3) cofactors(c0, c1, c2, det *fr.Element) {
+	var s02, tmp fr.Element
+	s02.Add(&z.A0, &z.A2)
+
+	c0.Square(&s02)
+	tmp.Add(&z.A1, &z.A2).Mul(&tmp, &z.A1)
+	c0.Sub(c0, &tmp)
+
+	c1.Add(&z.A1, &z.A2).Mul(c1, &z.A2)
+	tmp.Mul(&z.A1, &s02)
+	c1.Sub(c1, &tmp)
+
+	c2.Square(&z.A1)
+	tmp.Mul(&z.A2, &s02)
+	c2.Sub(c2, &tmp)
+
+	det.Mul(&z.A0, c0)
+	tmp.Mul(&z.A2, c1)
+	det.Add(det, &tmp)
+	tmp.Mul(&z.A1, c2)
+	det.Add(det, &tmp)
+}
+
+// Legendre returns the Legendre symbol of z
+func (z *E3) Legendre() int {
+	// the extension has odd degree, so z is a square iff its norm is.
+	var n fr.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ (mod q³) and returns it
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q³) == (x⁻¹)ᵏ (mod q³)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+func init() {
+	// q³-1 = 2ˢ⋅t with t odd; q²+q+1 is odd so s is the 2-adicity of q-1.
+	q := fr.Modulus()
+	var t big.Int
+	t.Mul(q, q).Mul(&t, q).Sub(&t, big.NewInt(1))
+	t.Rsh(&t, t.TrailingZeroBits())
+	sqrtE3Exp.Sub(&t, big.NewInt(1)).Rsh(&sqrtE3Exp, 1)
+}
+
+var sqrtE3Exp big.Int // (t-1)/2
+
+// Sqrt sets z to the square root of and returns z
+// The function does not test whether the square root
+// exists or not, it's up to the caller to call
+// Legendre beforehand.
+func (z *E3) Sqrt(x *E3) *E3 {
+	// Write q³-1 = 2ˢ⋅t with t odd. Since q²+q+1 is odd, the 2-Sylow subgroup
+	// of 𝔽r³* is the one of 𝔽r*, so b = xᵗ lies in 𝔽r. If x is a square, b has
+	// order dividing 2ˢ⁻¹ and is a square in 𝔽r; then x^((t+1)/2) / √b is a square
+	// root of x.
+	var w, r, b E3
+	w.Exp(*x, &sqrtE3Exp) // x^((t-1)/2)
+	r.Mul(&w, x)          // x^((t+1)/2)
+	b.Mul(&r, &w)         // xᵗ
+
+	var s fr.Element
+	s.Sqrt(&b.A0)
+	s.Inverse(&s)
+	return z.MulByElement(&r, &s)
+}
+
+// BatchInvertE3 returns a new slice with every element in a inverted.
+// It uses Montgomery batch inversion trick.
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Select is conditional move.
+// If cond = 0, it sets z to caseZ and returns it. otherwise caseNz.
+func (z *E3) Select(cond int, caseZ *E3, caseNz *E3) *E3 {
+	z.A0.Select(cond, &caseZ.A0, &caseNz.A0)
+	z.A1.Select(cond, &caseZ.A1, &caseNz.A1)
+	z.A2.Select(cond, &caseZ.A2, &caseNz.A2)
+
+	return z
+}
+
+// Div divides an element in E3 by an element in E3
+func (z *E3) Div(x *E3, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
@@ -0,0 +1,472 @@
+package extensions
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE3ReceiverIsOperand(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genfr := GenFr()
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (Inverse) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (mul by element) should output the same result", prop.ForAll(
+		func(a *E3, b fr.Element) bool {
+			var c E3
+			c.MulByElement(a, &b)
+			a.MulByElement(a, &b)
+			return a.Equal(&c)
+		},
+		genA,
+		genfr,
+	))
+
+	properties.Property("[GOLDILOCKS] Having the receiver as operand (Sqrt) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			c.Square(a)
+			b.Sqrt(&c)
+			c.Sqrt(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3MulMaxed(t *testing.T) {
+	// let's pick a and b, with maxed A0, A1 and A2
+	var a, b E3
+	frMaxValue := fr.Element{
+		18446744069414584321,
+	}
+	frMaxValue[0]--
+
+	a.A0 = frMaxValue
+	a.A1 = frMaxValue
+	a.A2 = frMaxValue
+	b.A0 = frMaxValue
+	b.A1 = frMaxValue
+	b.A2 = frMaxValue
+
+	var c, d E3
+	d.Inverse(&b)
+	c.Set(&a)
+	c.Mul(&c, &b).Mul(&c, &d)
+	if !c.Equal(&a) {
+		t.Fatal("mul with max fr failed")
+	}
+}
+
+func TestE3DefiningPolynomial(t *testing.T) {
+	// v³ = v + 1
+	var v, v3, expected E3
+	v.A1.SetOne()
+	v3.Square(&v).Mul(&v3, &v)
+	expected.A0.SetOne()
+	expected.A1.SetOne()
+	if !v3.Equal(&expected) {
+		t.Fatal("v³ != v + 1")
+	}
+
+	// the extension has order q³, i.e. v^(q³) = v
+	q := fr.Modulus()
+	var e big.Int
+	e.Mul(q, q).Mul(&e, q)
+	var vq E3
+	vq.Exp(v, &e)
+	if !vq.Equal(&v) {
+		t.Fatal("v^(q³) != v")
+	}
+}
+
+func TestE3Ops(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genfr := GenFr()
+
+	properties.Property("[GOLDILOCKS] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] mul should be commutative and distributive", prop.ForAll(
+		func(a, b, c *E3) bool {
+			var ab, ba, l, r, tmp E3
+			ab.Mul(a, b)
+			ba.Mul(b, a)
+			tmp.Add(b, c)
+			l.Mul(a, &tmp)
+			tmp.Mul(a, c)
+			r.Add(&ab, &tmp)
+			return ab.Equal(&ba) && l.Equal(&r)
+		},
+		genA,
+		genB,
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] BatchInvertE3 should output the same result as Inverse", prop.ForAll(
+		func(a, b, c *E3) bool {
+
+			batch := BatchInvertE3([]E3{*a, *b, {}, *c})
+			a.Inverse(a)
+			b.Inverse(b)
+			c.Inverse(c)
+			return a.Equal(&batch[0]) && b.Equal(&batch[1]) && batch[2].IsZero() && c.Equal(&batch[3])
+		},
+		genA,
+		genA,
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] neg twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Neg(a).Neg(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *E3, b fr.Element) bool {
+			var c E3
+			var d fr.Element
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genfr,
+	))
+
+	properties.Property("[GOLDILOCKS] Double and mul by 2 should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			var c fr.Element
+			c.SetUint64(2)
+			b.Double(a)
+			a.MulByElement(a, &c)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Halve and Double should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			b := *a
+			b.Halve()
+			b.Double(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] norm should be multiplicative", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			var na, nb, nc fr.Element
+			c.Mul(a, b)
+			a.norm(&na)
+			b.norm(&nb)
+			c.norm(&nc)
+			na.Mul(&na, &nb)
+			return na.Equal(&nc)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[GOLDILOCKS] Legendre on square should output 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			c := b.Legendre()
+			return c == 1
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Legendre on square times non residue should output -1", prop.ForAll(
+		func(a *E3) bool {
+			// the generator of 𝔽r* is a non residue in 𝔽r, hence in 𝔽r³
+			var b E3
+			var g fr.Element
+			g.SetUint64(7)
+			b.Square(a).MulByElement(&b, &g)
+			c := b.Legendre()
+			return c == -1
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] square(sqrt) should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b, c, d, e E3
+			b.Square(a)
+			c.Sqrt(&b)
+			d.Square(&c)
+			e.Neg(a)
+			return (c.Equal(a) || c.Equal(&e)) && d.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Exp should be consistent with Mul", prop.ForAll(
+		func(a *E3, e uint8) bool {
+			var b, c E3
+			b.Exp(*a, big.NewInt(int64(e)))
+			c.SetOne()
+			for i := 0; i < int(e); i++ {
+				c.Mul(&c, a)
+			}
+			return b.Equal(&c)
+		},
+		genA,
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(uint8(genParams.Rng.Intn(256)), gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[GOLDILOCKS] Exp with a negative exponent should invert", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Exp(*a, big.NewInt(-1))
+			c.Inverse(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[GOLDILOCKS] Cmp and LexicographicallyLargest should be consistent", prop.ForAll(
+		func(a *E3) bool {
+			var negA E3
+			negA.Neg(a)
+			cmpResult := a.Cmp(&negA)
+			lResult := a.LexicographicallyLargest()
+			if lResult && cmpResult == 1 {
+				return true
+			}
+			if !lResult && cmpResult != 1 {
+				return true
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+}
+
+func TestE3Div(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+
+	properties.Property("[GOLDILOCKS] dividing then multiplying by the same element does nothing", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Div(a, b)
+			c.Mul(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Mul(b *testing.B) {
+	var a, c E3
+	_, _ = a.SetRandom()
+	_, _ = c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE3Sqrt(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+
+func BenchmarkE3Exp(b *testing.B) {
+	var x E3
+	_, _ = x.SetRandom()
+	b1, _ := rand.Int(rand.Reader, fr.Modulus())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Exp(x, b1)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE3BatchInvert(b *testing.B) {
+	a := make([]E3, 1024)
+	for i := range a {
+		_, _ = a[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchInvertE3(a)
+	}
+}
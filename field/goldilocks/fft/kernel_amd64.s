@@ -0,0 +1,118 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+// Refer to the generator for more documentation.
+
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define ADD_F64(in0, in1, in2, in3, in4, in5) \
+	VPADDQ    in1, in0, in3      \
+	VPCMPUQ   $1, in0, in3, in5  \
+	VPSUBQ    in4, in3, in2      \
+	VPMINUQ   in2, in3, in3      \
+	VPBLENDMQ in2, in3, in5, in2 \
+
+#define SUB_F64(in0, in1, in2, in3, in4) \
+	VPCMPUQ $1, in1, in0, in4  \
+	VPSUBQ  in1, in0, in2      \
+	VPADDQ  in3, in2, in4, in2 \
+
+#define MUL_F64(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12, in13) \
+	VPSRLQ    $32, in0, in3        \
+	VPSRLQ    $32, in1, in4        \
+	VPMULUDQ  in4, in3, in5        \
+	VPMULUDQ  in1, in3, in3        \
+	VPMULUDQ  in4, in0, in4        \
+	VPMULUDQ  in1, in0, in6        \
+	VPSRLQ    $32, in6, in7        \
+	VPADDQ    in7, in3, in3        \
+	VPANDQ    in9, in3, in7        \
+	VPADDQ    in7, in4, in4        \
+	VPSRLQ    $32, in3, in3        \
+	VPADDQ    in3, in5, in5        \
+	VPSRLQ    $32, in4, in7        \
+	VPADDQ    in7, in5, in5        \
+	VPSLLQ    $32, in4, in4        \
+	VPANDQ    in9, in6, in6        \
+	VPORQ     in4, in6, in6        \
+	VPSLLQ    $32, in6, in3        \
+	VPADDQ    in6, in3, in3        \
+	VPSUBQ    in3, in11, in3       \
+	VPSLLQ    $32, in3, in4        \
+	VPCMPUQ   $1, in3, in4, in12   \
+	VPSRLQ    $32, in3, in7        \
+	VPSUBQ    in7, in3, in3        \
+	VPADDQ    in10, in3, in12, in3 \
+	VPADDQ    in3, in5, in4        \
+	VPCMPUQ   $1, in5, in4, in13   \
+	VPSUBQ    in8, in4, in2        \
+	VPMINUQ   in2, in4, in4        \
+	VPBLENDMQ in2, in4, in13, in2  \
+
+TEXT ·innerDIFWithTwiddles_avx512(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         a+0(FP), R15
+	MOVQ         am+8(FP), DX
+	MOVQ         twiddles+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_1:
+	TESTQ     BX, BX
+	JEQ       done_2     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4 // load a[i]
+	VMOVDQU64 0(DX), Z5  // load a[i+m]
+	VMOVDQU64 0(CX), Z6  // load twiddles[i]
+	ADD_F64(Z4, Z5, Z7, Z9, Z0, K1)
+	SUB_F64(Z4, Z5, Z8, Z0, K1)
+	MUL_F64(Z8, Z6, Z5, Z10, Z11, Z12, Z13, Z14, Z0, Z1, Z2, Z3, K1, K2)
+	VMOVDQU64 Z7, 0(R15) // store a[i]
+	VMOVDQU64 Z5, 0(DX)  // store a[i+m]
+	ADDQ      $64, R15
+	ADDQ      $64, DX
+	ADDQ      $64, CX
+	DECQ      BX         // decrement n
+	JMP       loop_1
+
+done_2:
+	RET
+
+TEXT ·innerDITWithTwiddles_avx512(SB), NOSPLIT, $0-32
+	MOVQ         $const_q, AX
+	VPBROADCASTQ AX, Z0
+	MOVQ         $0xffffffff, AX
+	VPBROADCASTQ AX, Z1
+	MOVQ         $1, AX
+	VPBROADCASTQ AX, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         a+0(FP), R15
+	MOVQ         am+8(FP), DX
+	MOVQ         twiddles+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_3:
+	TESTQ     BX, BX
+	JEQ       done_4     // n == 0, we are done
+	VMOVDQU64 0(R15), Z4 // load a[i]
+	VMOVDQU64 0(DX), Z5  // load a[i+m]
+	VMOVDQU64 0(CX), Z6  // load twiddles[i]
+	MUL_F64(Z5, Z6, Z8, Z10, Z11, Z12, Z13, Z14, Z0, Z1, Z2, Z3, K1, K2)
+	ADD_F64(Z4, Z8, Z7, Z9, Z0, K1)
+	SUB_F64(Z4, Z8, Z5, Z0, K1)
+	VMOVDQU64 Z7, 0(R15) // store a[i]
+	VMOVDQU64 Z5, 0(DX)  // store a[i+m]
+	ADDQ      $64, R15
+	ADDQ      $64, DX
+	ADDQ      $64, CX
+	DECQ      BX         // decrement n
+	JMP       loop_3
+
+done_4:
+	RET
@@ -0,0 +1,51 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// q + r'.r = 1, i.e., qInvNeg = - q⁻¹ mod r
+// used for Montgomery reduction
+const qInvNeg = 18446744069414584319
+const q = 18446744069414584321
+
+//go:noescape
+func innerDIFWithTwiddles_arm64(a, am, twiddles *goldilocks.Element, n uint64)
+
+//go:noescape
+func innerDITWithTwiddles_arm64(a, am, twiddles *goldilocks.Element, n uint64)
+
+func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if start == end {
+		return
+	}
+	innerDIFWithTwiddles_arm64(&a[start], &a[start+m], &twiddles[start], uint64(end-start))
+}
+
+func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if start == end {
+		return
+	}
+	innerDITWithTwiddles_arm64(&a[start], &a[start+m], &twiddles[start], uint64(end-start))
+}
+
+func kerDIFNP_32(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDIFNP_32generic(a, twiddles, stage)
+}
+func kerDITNP_32(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDITNP_32generic(a, twiddles, stage)
+}
+
+func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDIFNP_256generic(a, twiddles, stage)
+}
+func kerDITNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDITNP_256generic(a, twiddles, stage)
+}
@@ -0,0 +1,77 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+// Refer to the generator for more documentation.
+
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define ADD_F64(in0, in1, in2, in3, in4, in5) \
+	ADDS in1, in0, in3     \
+	SUB  in5, in3, in4     \
+	CSEL CS, in4, in3, in2 \
+	CMP  in5, in3          \
+	CSEL CS, in4, in2, in2 \
+
+#define SUB_F64(in0, in1, in2, in3, in4, in5) \
+	SUBS in1, in0, in3     \
+	ADD  in5, in3, in4     \
+	CSEL CS, in3, in4, in2 \
+
+#define MUL_F64(in0, in1, in2, in3, in4, in5, in6, in7, in8) \
+	MUL   in1, in0, in3     \
+	UMULH in1, in0, in4     \
+	MUL   in8, in3, in5     \
+	MUL   in7, in5, in6     \
+	ADDS  in6, in3, ZR      \
+	UMULH in7, in5, in5     \
+	ADCS  in5, in4, in4     \
+	SUB   in7, in4, in6     \
+	CSEL  CS, in6, in4, in2 \
+	CMP   in7, in4          \
+	CSEL  CS, in6, in2, in2 \
+
+TEXT ·innerDIFWithTwiddles_arm64(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  a+0(FP), (R0, R1)
+	LDP  twiddles+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+
+loop1:
+	CBZ    R3, done2
+	MOVD   (R0), R6   // load a[i]
+	MOVD   (R1), R7   // load a[i+m]
+	MOVD.P 8(R2), R8  // load twiddles[i]
+	ADD_F64(R6, R7, R9, R11, R12, R4)
+	SUB_F64(R6, R7, R10, R11, R12, R4)
+	MUL_F64(R10, R8, R7, R13, R14, R15, R16, R4, R5)
+	MOVD.P R9, 8(R0)
+	MOVD.P R7, 8(R1)
+	SUB    $1, R3, R3
+	JMP    loop1
+
+done2:
+	RET
+
+TEXT ·innerDITWithTwiddles_arm64(SB), NOFRAME|NOSPLIT, $0-32
+	LDP  a+0(FP), (R0, R1)
+	LDP  twiddles+16(FP), (R2, R3)
+	MOVD $const_q, R4
+	MOVD $const_qInvNeg, R5
+
+loop3:
+	CBZ    R3, done4
+	MOVD   (R0), R6   // load a[i]
+	MOVD   (R1), R7   // load a[i+m]
+	MOVD.P 8(R2), R8  // load twiddles[i]
+	MUL_F64(R7, R8, R10, R13, R14, R15, R16, R4, R5)
+	ADD_F64(R6, R10, R9, R11, R12, R4)
+	SUB_F64(R6, R10, R7, R11, R12, R4)
+	MOVD.P R9, 8(R0)
+	MOVD.P R7, 8(R1)
+	SUB    $1, R3, R3
+	JMP    loop3
+
+done4:
+	RET
@@ -0,0 +1,21 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package fri implements the FRI proof of proximity over the goldilocks field,
+// and a batched DEEP-FRI polynomial commitment scheme on top of it.
+//
+// The field being small, the folding challenges and the out of domain points
+// are drawn in the extension 𝔽r² of the extensions package, so that their
+// soundness error is about |D|/|𝔽r²| instead of |D|/|𝔽r| for an evaluation domain D.
+//
+// The codewords are committed with Merkle trees hashing field elements with
+// the Poseidon2 permutation, which is also used as a duplex sponge for the
+// Fiat-Shamir transcript.
+//
+// Several polynomials over 𝔽r, organized in columns, are committed row by row
+// with Commit. BatchOpen proves their evaluations at points of the extension
+// by running FRI on a random linear combination of the DEEP quotients
+// (fⱼ - fⱼ(zₖ))/(X - zₖ).
+package fri
@@ -0,0 +1,403 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/extensions"
+	"github.com/consensys/gnark-crypto/field/goldilocks/fft"
+)
+
+var (
+	ErrLowDegree            = errors.New("the fully folded polynomial is not of the expected degree")
+	ErrProximityTestFolding = errors.New("one round of interaction failed")
+	ErrMerklePath           = errors.New("merkle path proof is wrong")
+	ErrProofOfWork          = errors.New("the proof of work nonce is wrong")
+	ErrProofShape           = errors.New("the proof of proximity does not match the parameters")
+	ErrPolynomialSize       = errors.New("the polynomial is larger than the size of the fri instance")
+)
+
+// FRI instance proving the proximity of codewords of the extension
+// 𝔽r² to the Reed-Solomon code of polynomials of a fixed size, evaluated on a
+// subgroup of 𝔽r of size size·BlowupFactor.
+type FRI struct {
+	config Config
+
+	// size number of coefficients of the polynomials, a power of 2
+	size uint64
+
+	// domain evaluation domain of the codewords, in natural order
+	domain *fft.Domain
+
+	// arities folding factors of the successive rounds
+	arities []int
+
+	// finalSize number of coefficients of the fully folded polynomial
+	finalSize int
+
+	h *hasher
+}
+
+// New returns a FRI instance for polynomials of at most size coefficients.
+// The configuration defaults to DefaultConfig.
+func New(size uint64, config ...Config) (*FRI, error) {
+	c := DefaultConfig()
+	if len(config) > 0 {
+		c = config[0]
+	}
+	if err := c.Check(); err != nil {
+		return nil, err
+	}
+	n := max(ecc.NextPowerOfTwo(size), 2)
+	if bits.Len64(n)+bits.Len64(c.BlowupFactor) > 64 {
+		return nil, fmt.Errorf("%w: the domain is too large", ErrInvalidConfig)
+	}
+	if _, err := fr.Generator(n * c.BlowupFactor); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	res := &FRI{
+		config:    c,
+		size:      n,
+		domain:    fft.NewDomain(n * c.BlowupFactor),
+		finalSize: finalSize(n, c),
+		h:         newHasher(),
+	}
+	res.arities = foldingArities(n, res.finalSize, c)
+	return res, nil
+}
+
+// LayerOpening opening of one round of FRI at a query: the fiber of the
+// codeword folded into a single value of the next round, with its
+// authentication path.
+type LayerOpening struct {
+	Fiber []extensions.E2
+	Path  []Digest
+}
+
+// QueryProof openings of all the rounds at one query.
+type QueryProof struct {
+	Layers []LayerOpening
+}
+
+// ProofOfProximity proof that a codeword is close to a polynomial of the
+// size of the FRI instance.
+type ProofOfProximity struct {
+
+	// Roots of the Merkle trees of the codewords of each round
+	Roots []Digest
+
+	// FinalPolynomial coefficients of the fully folded polynomial
+	FinalPolynomial []extensions.E2
+
+	// Nonce solution of the proof of work
+	Nonce uint64
+
+	// Queries openings, one per query
+	Queries []QueryProof
+}
+
+// BuildProofOfProximity returns a proof that the evaluation of p, given by its
+// coefficients, is a codeword.
+func (f *FRI) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, error) {
+	evals, err := f.lowDegreeExtension(p)
+	if err != nil {
+		return ProofOfProximity{}, err
+	}
+	codeword := make([]extensions.E2, len(evals))
+	for i := range evals {
+		codeword[i] = lift(&evals[i])
+	}
+	proof, _ := f.prove(f.newTranscript(), codeword)
+	return proof, nil
+}
+
+// VerifyProofOfProximity checks a proof built by BuildProofOfProximity.
+func (f *FRI) VerifyProofOfProximity(proof ProofOfProximity) error {
+	_, _, err := f.verify(f.newTranscript(), proof)
+	return err
+}
+
+// newTranscript returns a transcript bound to the parameters of f.
+func (f *FRI) newTranscript() *transcript {
+	tr := newTranscript(f.h)
+	tr.absorb(
+		fr.NewElement(f.size),
+		fr.NewElement(f.config.BlowupFactor),
+		fr.NewElement(f.config.FoldingFactor),
+		fr.NewElement(uint64(f.config.NbQueries)),
+		fr.NewElement(uint64(f.finalSize)),
+		fr.NewElement(uint64(f.config.GrindingBits)),
+	)
+	return tr
+}
+
+// lowDegreeExtension returns the evaluations of p on the domain, in natural
+// order.
+func (f *FRI) lowDegreeExtension(p []fr.Element) ([]fr.Element, error) {
+	if uint64(len(p)) > f.size {
+		return nil, ErrPolynomialSize
+	}
+	res := make([]fr.Element, f.domain.Cardinality)
+	copy(res, p)
+	f.domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res, nil
+}
+
+// prove runs the prover of FRI on the codeword, in natural order on the
+// domain, and returns the proof and the positions of the queries on the
+// domain.
+func (f *FRI) prove(tr *transcript, codeword []extensions.E2) (ProofOfProximity, []uint64) {
+	var proof ProofOfProximity
+	proof.Roots = make([]Digest, len(f.arities))
+	layers := make([][]extensions.E2, len(f.arities))
+	trees := make([]*merkleTree, len(f.arities))
+
+	// commit phase
+	genInv := f.domain.GeneratorInv
+	for l, k := range f.arities {
+		layers[l] = codeword
+		trees[l] = commitLayer(f.h, codeword, k)
+		proof.Roots[l] = trees[l].root()
+		tr.absorbDigest(&proof.Roots[l])
+		beta := tr.squeezeExt()
+		for ; k > 1; k >>= 1 {
+			codeword = foldCodeword(codeword, &beta, &genInv)
+			beta.Square(&beta)
+			genInv.Square(&genInv)
+		}
+	}
+	proof.FinalPolynomial = interpolate(codeword)[:f.finalSize]
+	tr.absorbExt(proof.FinalPolynomial...)
+
+	// proof of work
+	if f.config.GrindingBits > 0 {
+		for !checkProofOfWork(tr.clone(), proof.Nonce, f.config.GrindingBits) {
+			proof.Nonce++
+		}
+		checkProofOfWork(tr, proof.Nonce, f.config.GrindingBits)
+	}
+
+	// query phase
+	positions := f.deriveQueries(tr)
+	proof.Queries = make([]QueryProof, len(positions))
+	for q, pos := range positions {
+		proof.Queries[q].Layers = make([]LayerOpening, len(f.arities))
+		for l, k := range f.arities {
+			stride := uint64(len(layers[l]) / k)
+			i := pos % stride
+			opening := &proof.Queries[q].Layers[l]
+			opening.Fiber = make([]extensions.E2, k)
+			for t := range opening.Fiber {
+				opening.Fiber[t] = layers[l][i+uint64(t)*stride]
+			}
+			opening.Path = trees[l].prove(int(i))
+			pos = i
+		}
+	}
+	return proof, positions
+}
+
+// verify runs the verifier of FRI, and returns the positions of the queries
+// on the domain with the values of the codeword at these positions.
+func (f *FRI) verify(tr *transcript, proof ProofOfProximity) ([]uint64, []extensions.E2, error) {
+	if len(proof.Roots) != len(f.arities) || len(proof.FinalPolynomial) != f.finalSize ||
+		len(proof.Queries) != f.config.NbQueries {
+		return nil, nil, ErrProofShape
+	}
+	if f.config.GrindingBits > 0 && proof.Nonce >= fr.Modulus().Uint64() {
+		return nil, nil, ErrProofOfWork
+	}
+
+	betas := make([]extensions.E2, len(f.arities))
+	for l := range proof.Roots {
+		tr.absorbDigest(&proof.Roots[l])
+		betas[l] = tr.squeezeExt()
+	}
+	tr.absorbExt(proof.FinalPolynomial...)
+	if f.config.GrindingBits > 0 && !checkProofOfWork(tr, proof.Nonce, f.config.GrindingBits) {
+		return nil, nil, ErrProofOfWork
+	}
+	positions := f.deriveQueries(tr)
+
+	values := make([]extensions.E2, len(positions))
+	leaf := make([]fr.Element, 0, extDegree*f.config.FoldingFactor)
+	for q, pos := range positions {
+		layers := proof.Queries[q].Layers
+		if len(layers) != len(f.arities) {
+			return nil, nil, ErrProofShape
+		}
+		size := f.domain.Cardinality
+		genInv := f.domain.GeneratorInv
+		var folded extensions.E2
+		for l, k := range f.arities {
+			stride := size / uint64(k)
+			i, t := pos%stride, pos/stride
+			opening := &layers[l]
+			if len(opening.Fiber) != k {
+				return nil, nil, ErrProofShape
+			}
+			if l == 0 {
+				values[q] = opening.Fiber[t]
+			} else if !opening.Fiber[t].Equal(&folded) {
+				return nil, nil, ErrProximityTestFolding
+			}
+			leaf = appendCoordinates(leaf[:0], opening.Fiber)
+			if !f.h.verifyPath(&proof.Roots[l], leaf, opening.Path, i, stride) {
+				return nil, nil, ErrMerklePath
+			}
+
+			// x⁻¹ = ω⁻ⁱ and ζ⁻¹ = ω^(-stride) for a primitive k-th root of unity ζ
+			var xInv, zetaInv fr.Element
+			xInv.Exp(genInv, new(big.Int).SetUint64(i))
+			zetaInv.Exp(genInv, new(big.Int).SetUint64(stride))
+			folded = foldFiber(opening.Fiber, betas[l], xInv, zetaInv)
+
+			for ; k > 1; k >>= 1 {
+				genInv.Square(&genInv)
+			}
+			size, pos = stride, i
+		}
+
+		// the final codeword is the evaluation of the final polynomial
+		var x fr.Element
+		x.Inverse(&genInv).Exp(x, new(big.Int).SetUint64(pos))
+		if y := evalExt(proof.FinalPolynomial, &x); !y.Equal(&folded) {
+			return nil, nil, ErrLowDegree
+		}
+	}
+	return positions, values, nil
+}
+
+// deriveQueries returns the positions of the queries on the domain.
+func (f *FRI) deriveQueries(tr *transcript) []uint64 {
+	nbBits := bits.TrailingZeros64(f.domain.Cardinality)
+	res := make([]uint64, f.config.NbQueries)
+	for i := range res {
+		res[i] = tr.squeezeBits(nbBits)
+	}
+	return res
+}
+
+// checkProofOfWork absorbs the nonce in the transcript and returns true if the
+// nbBits least significant bits of the next squeezed element are zero.
+func checkProofOfWork(tr *transcript, nonce uint64, nbBits int) bool {
+	tr.absorb(fr.NewElement(nonce))
+	return tr.squeezeBits(nbBits) == 0
+}
+
+// commitLayer returns the Merkle tree of a codeword, whose leaves are the
+// fibers of the folding by k: the i-th leaf contains the coordinates of
+// codeword[i + t·len(codeword)/k] for 0 ≤ t < k.
+func commitLayer(h *hasher, codeword []extensions.E2, k int) *merkleTree {
+	stride := len(codeword) / k
+	return newMerkleTree(h, stride, func(i int, buf []fr.Element) []fr.Element {
+		for t := 0; t < k; t++ {
+			c := coordinates(&codeword[i+t*stride])
+			buf = append(buf, c[:]...)
+		}
+		return buf
+	})
+}
+
+// appendCoordinates appends the coordinates of the elements of x to buf.
+func appendCoordinates(buf []fr.Element, x []extensions.E2) []fr.Element {
+	for i := range x {
+		c := coordinates(&x[i])
+		buf = append(buf, c[:]...)
+	}
+	return buf
+}
+
+// foldPair returns (y₀+y₁)/2 + β(y₀-y₁)/2x, the folding by β of the
+// evaluations y₀ = P(x) and y₁ = P(-x) of P = P₀(X²) + XP₁(X²), that is
+// P₀(x²) + βP₁(x²).
+func foldPair(y0, y1, beta *extensions.E2, xInv *fr.Element) extensions.E2 {
+	var sum, diff extensions.E2
+	sum.Add(y0, y1)
+	diff.Sub(y0, y1).MulByElement(&diff, xInv).Mul(&diff, beta)
+	sum.Add(&sum, &diff)
+	sum.Halve()
+	return sum
+}
+
+// foldCodeword folds by β a codeword on the subgroup generated by ω, in
+// natural order, into a codeword on the subgroup generated by ω².
+func foldCodeword(codeword []extensions.E2, beta *extensions.E2, genInv *fr.Element) []extensions.E2 {
+	half := len(codeword) / 2
+	res := make([]extensions.E2, half)
+	var xInv fr.Element
+	xInv.SetOne()
+	for i := range res {
+		res[i] = foldPair(&codeword[i], &codeword[i+half], beta, &xInv)
+		xInv.Mul(&xInv, genInv)
+	}
+	return res
+}
+
+// foldFiber folds by β, β², β⁴… the fiber of the evaluations of P at xζᵗ for
+// 0 ≤ t < k, ζ being a primitive k-th root of unity, into ∑ⱼβʲPⱼ(xᵏ) where
+// P = ∑ⱼXʲPⱼ(Xᵏ).
+func foldFiber(fiber []extensions.E2, beta extensions.E2, xInv, zetaInv fr.Element) extensions.E2 {
+	buf := make([]extensions.E2, len(fiber))
+	copy(buf, fiber)
+	for m := len(buf) / 2; m > 0; m /= 2 {
+		x := xInv
+		for t := 0; t < m; t++ {
+			buf[t] = foldPair(&buf[t], &buf[t+m], &beta, &x)
+			x.Mul(&x, &zetaInv)
+		}
+		beta.Square(&beta)
+		xInv.Square(&xInv)
+		zetaInv.Square(&zetaInv)
+	}
+	return buf[0]
+}
+
+// interpolate returns the coefficients of the polynomial whose evaluations on
+// the subgroup of size len(codeword) are given in natural order.
+func interpolate(codeword []extensions.E2) []extensions.E2 {
+	n := uint64(len(codeword))
+	domain := fft.NewDomain(n, fft.WithoutPrecompute())
+	coords := make([][]fr.Element, extDegree)
+	for j := range coords {
+		coords[j] = make([]fr.Element, n)
+	}
+	for i := range codeword {
+		c := coordinates(&codeword[i])
+		for j := range coords {
+			coords[j][i] = c[j]
+		}
+	}
+	for j := range coords {
+		domain.FFTInverse(coords[j], fft.DIF)
+		fft.BitReverse(coords[j])
+	}
+	res := make([]extensions.E2, n)
+	c := make([]fr.Element, extDegree)
+	for i := range res {
+		for j := range c {
+			c[j] = coords[j][i]
+		}
+		res[i] = fromCoordinates(c)
+	}
+	return res
+}
+
+// evalExt returns p(x) for a polynomial p of coefficients in the extension.
+func evalExt(p []extensions.E2, x *fr.Element) extensions.E2 {
+	var res extensions.E2
+	for i := len(p) - 1; i >= 0; i-- {
+		res.MulByElement(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
@@ -0,0 +1,110 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/poseidon2"
+)
+
+func randomDigest() Digest {
+	var res Digest
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestCompress(t *testing.T) {
+	h := newHasher()
+	params := poseidon2.GetDefaultParameters()
+	perm := poseidon2.NewPermutation(params.Width, params.NbFullRounds, params.NbPartialRounds)
+
+	left, right := randomDigest(), randomDigest()
+	var bLeft, bRight, expected []byte
+	for i := range left {
+		bLeft = append(bLeft, left[i].Marshal()...)
+		bRight = append(bRight, right[i].Marshal()...)
+	}
+	expected, err := perm.Compress(bLeft, bRight)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := h.compress(&left, &right)
+	var bRes []byte
+	for i := range res {
+		bRes = append(bRes, res[i].Marshal()...)
+	}
+	if !bytes.Equal(bRes, expected) {
+		t.Fatal("compress does not match poseidon2 Compress")
+	}
+}
+
+func TestHashLeafLength(t *testing.T) {
+	h := newHasher()
+
+	// leaves differing only by trailing zeros have different digests
+	leaf := make([]fr.Element, digestSize+1)
+	leaf[0].SetRandom()
+	if h.hashLeaf(leaf) == h.hashLeaf(leaf[:digestSize]) {
+		t.Fatal("the length of the leaf should be hashed")
+	}
+}
+
+func TestMerkleTree(t *testing.T) {
+	h := newHasher()
+	const nbLeaves = 16
+	leaves := make([][]fr.Element, nbLeaves)
+	for i := range leaves {
+		leaves[i] = make([]fr.Element, 3)
+		for j := range leaves[i] {
+			leaves[i][j].SetRandom()
+		}
+	}
+	tree := newMerkleTree(h, nbLeaves, func(i int, buf []fr.Element) []fr.Element {
+		return append(buf, leaves[i]...)
+	})
+	root := tree.root()
+
+	for i := range leaves {
+		path := tree.prove(i)
+		if !h.verifyPath(&root, leaves[i], path, uint64(i), nbLeaves) {
+			t.Fatalf("leaf %d: the path should be valid", i)
+		}
+		if h.verifyPath(&root, leaves[(i+1)%nbLeaves], path, uint64(i), nbLeaves) {
+			t.Fatalf("leaf %d: the path should not authenticate another leaf", i)
+		}
+		if h.verifyPath(&root, leaves[i], path, uint64(i^1), nbLeaves) {
+			t.Fatalf("leaf %d: the path should not be valid at another position", i)
+		}
+	}
+}
+
+func TestTranscript(t *testing.T) {
+	h := newHasher()
+	x := randomDigest()
+
+	tr1, tr2 := newTranscript(h), newTranscript(h)
+	tr1.absorbDigest(&x)
+	tr2.absorbDigest(&x)
+	clone := tr1.clone()
+	a, b, c := tr1.squeezeExt(), tr2.squeezeExt(), clone.squeezeExt()
+	if !a.Equal(&b) || !a.Equal(&c) {
+		t.Fatal("the transcript should be deterministic")
+	}
+	if d := tr1.squeezeExt(); d.Equal(&a) {
+		t.Fatal("successive challenges should differ")
+	}
+
+	tr2.absorb(fr.One())
+	if d := tr2.squeezeExt(); d.Equal(&b) {
+		t.Fatal("absorbing should change the challenges")
+	}
+}
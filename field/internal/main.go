@@ -37,9 +37,24 @@ func main() {
 			generator.WithFFT(&config.FFT{}), // TODO @gbotrel
 			generator.WithSIS(),
 			generator.WithPoseidon2(),
+			generator.WithFRI(),
 		); err != nil {
 			panic(err)
 		}
 		fmt.Println("successfully generated", f.name, "field")
 	}
+
+	// mersenne31 has a 2-adicity of 1: it has no radix-2 FFT, its polynomials
+	// are evaluated on the circle group instead (see mersenne31/circle).
+	fc, err := config.NewFieldConfig("mersenne31", "Element", "0x7fffffff", true) // 2^31 - 1
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(fc, filepath.Join("..", "mersenne31"),
+		generator.WithASM(&config.Assembly{BuildDir: asmDirIncludePath, IncludeDir: asmDirIncludePath}),
+		generator.WithPoseidon2(),
+	); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated mersenne31 field")
 }
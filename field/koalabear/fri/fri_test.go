@@ -0,0 +1,192 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/extensions"
+)
+
+func randomPolynomial(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// testConfig small configuration keeping the tests fast
+func testConfig() Config {
+	return Config{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 8, FinalDegree: 0, GrindingBits: 4}
+}
+
+func TestFRIConfigs(t *testing.T) {
+	const size = 64
+	p := randomPolynomial(size)
+
+	for _, config := range []Config{
+		testConfig(),
+		{BlowupFactor: 4, FoldingFactor: 4, NbQueries: 8, FinalDegree: 3},
+		{BlowupFactor: 8, FoldingFactor: 8, NbQueries: 4, FinalDegree: 7, GrindingBits: 2},
+		{BlowupFactor: 2, FoldingFactor: 16, NbQueries: 4, FinalDegree: 1},
+		{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 4, FinalDegree: 1000},
+		DefaultConfig(),
+	} {
+		t.Run(fmt.Sprintf("%+v", config), func(t *testing.T) {
+			f, err := New(size, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := f.BuildProofOfProximity(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := f.VerifyProofOfProximity(proof); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestFRIInvalidConfig(t *testing.T) {
+	for _, config := range []Config{
+		{BlowupFactor: 3, FoldingFactor: 2, NbQueries: 1},
+		{BlowupFactor: 2, FoldingFactor: 3, NbQueries: 1},
+		{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 0},
+		{BlowupFactor: 2, FoldingFactor: 2, NbQueries: 1, GrindingBits: 31},
+	} {
+		if _, err := New(16, config); !errors.Is(err, ErrInvalidConfig) {
+			t.Fatalf("%+v: expected ErrInvalidConfig, got %v", config, err)
+		}
+	}
+	if _, err := New(1<<40, testConfig()); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("the domain should not exist, got %v", err)
+	}
+}
+
+func TestFRIPolynomialSize(t *testing.T) {
+	f, err := New(16, testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.BuildProofOfProximity(randomPolynomial(17)); !errors.Is(err, ErrPolynomialSize) {
+		t.Fatalf("expected ErrPolynomialSize, got %v", err)
+	}
+}
+
+func TestFRITampering(t *testing.T) {
+	f, err := New(32, testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := f.BuildProofOfProximity(randomPolynomial(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tamper := func(name string, expected error, modify func(p *ProofOfProximity)) {
+		t.Run(name, func(t *testing.T) {
+			// deep copy of the proof
+			p := proof
+			p.Roots = append([]Digest{}, proof.Roots...)
+			p.FinalPolynomial = append(p.FinalPolynomial[:0:0], proof.FinalPolynomial...)
+			p.Queries = make([]QueryProof, len(proof.Queries))
+			for q := range p.Queries {
+				p.Queries[q].Layers = make([]LayerOpening, len(proof.Queries[q].Layers))
+				for l, opening := range proof.Queries[q].Layers {
+					p.Queries[q].Layers[l].Fiber = append(opening.Fiber[:0:0], opening.Fiber...)
+					p.Queries[q].Layers[l].Path = append([]Digest{}, opening.Path...)
+				}
+			}
+			modify(&p)
+			if err := f.VerifyProofOfProximity(p); !errors.Is(err, expected) {
+				t.Fatalf("expected %v, got %v", expected, err)
+			}
+		})
+	}
+	var one fr.Element
+	one.SetOne()
+
+	tamper("honest", nil, func(p *ProofOfProximity) {})
+	tamper("root", ErrMerklePath, func(p *ProofOfProximity) {
+		p.Roots[0][0].Add(&p.Roots[0][0], &one)
+		p.Nonce = grind(f, p)
+	})
+	tamper("fiber", ErrMerklePath, func(p *ProofOfProximity) {
+		fiber := p.Queries[0].Layers[0].Fiber
+		addBase(&fiber[0], &fiber[0], &one)
+	})
+	tamper("path", ErrMerklePath, func(p *ProofOfProximity) {
+		path := p.Queries[1].Layers[1].Path
+		path[0][0].Add(&path[0][0], &one)
+	})
+	tamper("nonce", ErrProofOfWork, func(p *ProofOfProximity) {
+		for p.Nonce++; f.checkNonce(p); p.Nonce++ {
+		}
+	})
+	tamper("shape", ErrProofShape, func(p *ProofOfProximity) {
+		p.Queries = p.Queries[1:]
+	})
+	tamper("fiber size", ErrProofShape, func(p *ProofOfProximity) {
+		p.Queries[0].Layers[0].Fiber = p.Queries[0].Layers[0].Fiber[1:]
+	})
+}
+
+// TestFRIFarCodeword checks that a codeword far from the code is rejected.
+func TestFRIFarCodeword(t *testing.T) {
+	f, err := New(32, testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codeword := make([]extensions.E4, f.domain.Cardinality)
+	for i := range codeword {
+		codeword[i].SetRandom()
+	}
+	proof, _ := f.prove(f.newTranscript(), codeword)
+	if err := f.VerifyProofOfProximity(proof); !errors.Is(err, ErrLowDegree) {
+		t.Fatalf("expected ErrLowDegree, got %v", err)
+	}
+}
+
+// checkNonce returns true if the nonce of the proof solves the proof of work.
+func (f *FRI) checkNonce(proof *ProofOfProximity) bool {
+	tr := f.newTranscript()
+	for l := range proof.Roots {
+		tr.absorbDigest(&proof.Roots[l])
+		tr.squeezeExt()
+	}
+	tr.absorbExt(proof.FinalPolynomial...)
+	return checkProofOfWork(tr, proof.Nonce, f.config.GrindingBits)
+}
+
+// grind returns a nonce solving the proof of work of a modified proof.
+func grind(f *FRI, proof *ProofOfProximity) uint64 {
+	p := *proof
+	for p.Nonce = 0; !f.checkNonce(&p); p.Nonce++ {
+	}
+	return p.Nonce
+}
+
+func BenchmarkBuildProofOfProximity(b *testing.B) {
+	for _, size := range []uint64{1 << 12, 1 << 16} {
+		f, err := New(size)
+		if err != nil {
+			b.Fatal(err)
+		}
+		p := randomPolynomial(int(size))
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = f.BuildProofOfProximity(p)
+			}
+		})
+	}
+}
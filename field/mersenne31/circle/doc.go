@@ -0,0 +1,23 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package circle implements the FFT over the circle group x²+y²=1 of the
+// Mersenne31 field, as used by Circle STARKs (https://eprint.iacr.org/2024/278).
+//
+// Since p = 2³¹-1, the multiplicative group of 𝔽p has a 2-adicity of 1 and no
+// radix-2 FFT exists over it. The circle group however has p+1 = 2³¹ points,
+// and its subgroups of order 2ⁿ, or their twin cosets, are used as evaluation
+// domains instead.
+//
+// A twin coset of size N = 2ⁿ is D = Q·G ∪ Q⁻¹·G where G is the subgroup of
+// order N/2 and Q² ∉ G. Polynomials over D are expressed in the basis
+//
+//	yʲ⁰ · xʲ¹ · π(x)ʲ² · … · πⁿ⁻²(x)ʲⁿ⁻¹
+//
+// where π(x) = 2x²-1 is the x-coordinate of the doubling map. The FFT first
+// splits f(x, y) = f₀(x) + y·f₁(x) and then f(x) = g₀(π(x)) + x·g₁(π(x))
+// recursively, projecting D on the x-axis and then halving it with π.
+//
+// The circle-FRI folding maps, FoldCircleIntoLine and FoldLine, are the same
+// splits followed by a random linear combination of the two halves.
+package circle
@@ -0,0 +1,192 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"errors"
+
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+var (
+	ErrNotOnCircle    = errors.New("the point is not on the circle")
+	ErrNotTwinCoset   = errors.New("the coset and its conjugate are not disjoint")
+	ErrInvalidLogSize = errors.New("the size of the domain is out of range")
+)
+
+// Coset is the set {Initial·Stepⁱ, 0 ≤ i < 2^LogSize}, Step being a generator
+// of the subgroup of order 2^LogSize.
+type Coset struct {
+	Initial, Step Point
+	LogSize       int
+}
+
+// NewCoset returns the coset initial·G of the subgroup G of order 2^logSize.
+func NewCoset(initial Point, logSize int) (Coset, error) {
+	if !initial.IsOnCircle() {
+		return Coset{}, ErrNotOnCircle
+	}
+	step, err := Generator(logSize)
+	if err != nil {
+		return Coset{}, err
+	}
+	return Coset{Initial: initial, Step: step, LogSize: logSize}, nil
+}
+
+// Size returns the number of points of the coset.
+func (c *Coset) Size() int {
+	return 1 << c.LogSize
+}
+
+// At returns the i-th point Initial·Stepⁱ of the coset.
+func (c *Coset) At(i int) Point {
+	var res Point
+	res.ScalarMul(&c.Step, uint64(i)).Add(&res, &c.Initial)
+	return res
+}
+
+// Points returns the points of the coset, in order.
+func (c *Coset) Points() []Point {
+	res := make([]Point, c.Size())
+	res[0] = c.Initial
+	for i := 1; i < len(res); i++ {
+		res[i].Add(&res[i-1], &c.Step)
+	}
+	return res
+}
+
+// Double returns the image of the coset by the doubling map, of half its
+// size.
+func (c *Coset) Double() Coset {
+	var res Coset
+	res.Initial.Double(&c.Initial)
+	res.Step.Double(&c.Step)
+	res.LogSize = c.LogSize - 1
+	return res
+}
+
+// LineDomain is the projection on the x-axis of a coset containing the
+// antipode of each of its points: the i-th and (i+N/2)-th x-coordinates are
+// opposite.
+type LineDomain struct {
+	Coset Coset
+}
+
+// Size returns the number of x-coordinates of the domain.
+func (l *LineDomain) Size() int {
+	return l.Coset.Size()
+}
+
+// At returns the i-th x-coordinate of the domain.
+func (l *LineDomain) At(i int) fr.Element {
+	p := l.Coset.At(i)
+	return p.X
+}
+
+// Double returns the image of the domain by π(x) = 2x²-1, of half its size.
+func (l *LineDomain) Double() LineDomain {
+	return LineDomain{Coset: l.Coset.Double()}
+}
+
+// Domain is a twin coset D = Q·G ∪ Q⁻¹·G of size N = 2^LogSize, G being the
+// subgroup of order N/2, on which the FFT is defined.
+//
+// Its points are in natural order: the i-th point is Q·gⁱ for i < N/2, and the
+// (i+N/2)-th point is its conjugate Q⁻¹·g⁻ⁱ.
+type Domain struct {
+	// HalfCoset Q·G
+	HalfCoset Coset
+	LogSize   int
+
+	// twiddles[0] are the y-coordinates of Q·gⁱ for i < N/2, twiddles[k] the
+	// x-coordinates of the first half of the k-th projection of D on the
+	// x-axis
+	twiddles    [][]fr.Element
+	twiddlesInv [][]fr.Element
+
+	cardinalityInv fr.Element
+}
+
+// NewDomain returns the canonic domain of size 2^logSize, the twin coset of the
+// subgroup of order 2^(logSize-1) for Q of order 2^(logSize+1), which is also a
+// coset of the subgroup of order 2^logSize. It is used by Circle STARKs for
+// the traces, 1 ≤ logSize < 31.
+func NewDomain(logSize int) (*Domain, error) {
+	if logSize < 1 || logSize >= MaxLogOrder {
+		return nil, ErrInvalidLogSize
+	}
+	q, err := Generator(logSize + 1)
+	if err != nil {
+		return nil, err
+	}
+	return NewTwinCoset(q, logSize)
+}
+
+// NewTwinCoset returns the twin coset q·G ∪ q⁻¹·G of size 2^logSize, G being
+// the subgroup of order 2^(logSize-1). It returns an error if the two cosets
+// intersect, that is if q² ∈ G.
+func NewTwinCoset(q Point, logSize int) (*Domain, error) {
+	if logSize < 1 || logSize > MaxLogOrder {
+		return nil, ErrInvalidLogSize
+	}
+	halfCoset, err := NewCoset(q, logSize-1)
+	if err != nil {
+		return nil, err
+	}
+	d := &Domain{HalfCoset: halfCoset, LogSize: logSize}
+	d.cardinalityInv.SetUint64(uint64(d.Size())).Inverse(&d.cardinalityInv)
+
+	// the twiddles are all non zero if and only if the butterflies of the FFT
+	// are invertible, that is if D has N distinct points
+	d.twiddles = make([][]fr.Element, logSize)
+	points := halfCoset.Points()
+	d.twiddles[0] = make([]fr.Element, len(points))
+	for i := range points {
+		d.twiddles[0][i] = points[i].Y
+	}
+	coset := halfCoset
+	for k := 1; k < logSize; k++ {
+		d.twiddles[k] = make([]fr.Element, len(points)/2)
+		for i := range d.twiddles[k] {
+			d.twiddles[k][i] = points[i].X
+		}
+		coset = coset.Double()
+		for i := range points[:coset.Size()] {
+			points[i].Double(&points[i])
+		}
+		points = points[:coset.Size()]
+	}
+	d.twiddlesInv = make([][]fr.Element, logSize)
+	for k := range d.twiddles {
+		for i := range d.twiddles[k] {
+			if d.twiddles[k][i].IsZero() {
+				return nil, ErrNotTwinCoset
+			}
+		}
+		d.twiddlesInv[k] = fr.BatchInvert(d.twiddles[k])
+	}
+	return d, nil
+}
+
+// Size returns the number of points of the domain.
+func (d *Domain) Size() int {
+	return 1 << d.LogSize
+}
+
+// At returns the i-th point of the domain.
+func (d *Domain) At(i int) Point {
+	half := d.Size() / 2
+	if i < half {
+		return d.HalfCoset.At(i)
+	}
+	p := d.HalfCoset.At(i - half)
+	p.Conjugate(&p)
+	return p
+}
+
+// LineDomain returns the projection of the domain on the x-axis, of half its
+// size.
+func (d *Domain) LineDomain() LineDomain {
+	return LineDomain{Coset: d.HalfCoset}
+}
@@ -0,0 +1,140 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/consensys/gnark-crypto/field/mersenne31/extensions"
+)
+
+// FFT evaluates in place, on the points of the domain in natural order, the
+// polynomial whose coefficients in the circle basis are given in a.
+//
+// The j-th basis polynomial is the product of the mappings y, x, π(x), …,
+// πⁿ⁻²(x) selected by the bits of j, from the most significant to the least
+// significant one.
+func (d *Domain) FFT(a []fr.Element) {
+	if len(a) != d.Size() {
+		panic("the size of the input does not match the size of the domain")
+	}
+
+	// f(x) = g₀(π(x)) + x·g₁(π(x)) on the x-axis, from the smallest projection
+	for k := d.LogSize - 1; k >= 1; k-- {
+		butterflies(a, d.twiddles[k])
+	}
+
+	// f(x, y) = f₀(x) + y·f₁(x)
+	butterflies(a, d.twiddles[0])
+}
+
+// FFTInverse interpolates in place the evaluations on the points of the domain
+// in natural order, and returns the coefficients of the polynomial in the
+// circle basis, as defined in FFT.
+func (d *Domain) FFTInverse(a []fr.Element) {
+	if len(a) != d.Size() {
+		panic("the size of the input does not match the size of the domain")
+	}
+	butterfliesInverse(a, d.twiddlesInv[0])
+	for k := 1; k < d.LogSize; k++ {
+		butterfliesInverse(a, d.twiddlesInv[k])
+	}
+	for i := range a {
+		a[i].Mul(&a[i], &d.cardinalityInv)
+	}
+}
+
+// butterflies applies (c₀, c₁) ↦ (c₀ + t·c₁, c₀ - t·c₁) to the halves of the
+// blocks of size 2·len(twiddles) of a.
+func butterflies(a []fr.Element, twiddles []fr.Element) {
+	m := len(twiddles)
+	for start := 0; start < len(a); start += 2 * m {
+		block := a[start : start+2*m]
+		for i := range twiddles {
+			block[i+m].Mul(&block[i+m], &twiddles[i])
+			fr.Butterfly(&block[i], &block[i+m])
+		}
+	}
+}
+
+// butterfliesInverse applies (a, b) ↦ (a + b, (a - b)/t) to the halves of
+// the blocks of size 2·len(twiddlesInv) of a, the inverse of butterflies up to
+// a factor 2.
+func butterfliesInverse(a []fr.Element, twiddlesInv []fr.Element) {
+	m := len(twiddlesInv)
+	for start := 0; start < len(a); start += 2 * m {
+		block := a[start : start+2*m]
+		for i := range twiddlesInv {
+			fr.Butterfly(&block[i], &block[i+m])
+			block[i+m].Mul(&block[i+m], &twiddlesInv[i])
+		}
+	}
+}
+
+// Extend returns the coefficients, in the circle basis of size
+// len(coeffs)·2^logBlowup, of the polynomial of the given coefficients. The
+// basis polynomials of the smaller basis are those of the larger one whose
+// indices are multiple of 2^logBlowup.
+func Extend(coeffs []fr.Element, logBlowup int) []fr.Element {
+	res := make([]fr.Element, len(coeffs)<<logBlowup)
+	for j := range coeffs {
+		res[j<<logBlowup] = coeffs[j]
+	}
+	return res
+}
+
+// Evaluate returns the evaluation at p of the polynomial whose coefficients
+// in the circle basis are given.
+func Evaluate(coeffs []fr.Element, p *Point) fr.Element {
+	// mappings y, x, π(x), … of the bits of the indices, from the most
+	// significant to the least significant one
+	mappings := make([]fr.Element, 0, 32)
+	if len(coeffs) > 1 {
+		mappings = append(mappings, p.Y)
+		for x := p.X; len(coeffs)>>len(mappings) > 1; DoubleX(&x) {
+			mappings = append(mappings, x)
+		}
+	}
+
+	buf := make([]fr.Element, len(coeffs))
+	copy(buf, coeffs)
+	for k := len(mappings) - 1; k >= 0; k-- {
+		half := len(buf) / 2
+		for i := 0; i < half; i++ {
+			var t fr.Element
+			t.Mul(&buf[2*i+1], &mappings[k])
+			buf[i].Add(&buf[2*i], &t)
+		}
+		buf = buf[:half]
+	}
+	return buf[0]
+}
+
+// EvaluateExt returns the evaluation at the point (x, y) of the circle over
+// 𝔽p⁴ of the polynomial whose coefficients in the circle basis are given.
+func EvaluateExt(coeffs []fr.Element, x, y *extensions.E4) extensions.E4 {
+	var one extensions.E4
+	one.SetOne()
+	mappings := make([]extensions.E4, 0, 32)
+	if len(coeffs) > 1 {
+		mappings = append(mappings, *y)
+		for xi := *x; len(coeffs)>>len(mappings) > 1; xi.Square(&xi).Double(&xi).Sub(&xi, &one) {
+			mappings = append(mappings, xi)
+		}
+	}
+
+	buf := make([]extensions.E4, len(coeffs))
+	for i := range coeffs {
+		buf[i].B0.A0 = coeffs[i]
+	}
+	for k := len(mappings) - 1; k >= 0; k-- {
+		half := len(buf) / 2
+		for i := 0; i < half; i++ {
+			var t extensions.E4
+			t.Mul(&buf[2*i+1], &mappings[k])
+			buf[i].Add(&buf[2*i], &t)
+		}
+		buf = buf[:half]
+	}
+	return buf[0]
+}
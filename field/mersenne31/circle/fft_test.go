@@ -0,0 +1,238 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"fmt"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/consensys/gnark-crypto/field/mersenne31/extensions"
+)
+
+func randomPolynomial(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// testDomains returns the canonic domain of size 2^logSize and a twin coset of
+// the same size.
+func testDomains(t *testing.T, logSize int) []*Domain {
+	canonic, err := NewDomain(logSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, _ := Generator(MaxLogOrder)
+	var q Point
+	q.ScalarMul(&g, 1234567)
+	twin, err := NewTwinCoset(q, logSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []*Domain{canonic, twin}
+}
+
+func TestFFT(t *testing.T) {
+	for logSize := 1; logSize <= 8; logSize++ {
+		for _, d := range testDomains(t, logSize) {
+			coeffs := randomPolynomial(d.Size())
+			evals := make([]fr.Element, len(coeffs))
+			copy(evals, coeffs)
+			d.FFT(evals)
+			for i := range evals {
+				p := d.At(i)
+				if expected := Evaluate(coeffs, &p); !expected.Equal(&evals[i]) {
+					t.Fatalf("size 2^%d: wrong evaluation at point %d", logSize, i)
+				}
+			}
+
+			d.FFTInverse(evals)
+			for i := range evals {
+				if !evals[i].Equal(&coeffs[i]) {
+					t.Fatalf("size 2^%d: FFTInverse(FFT(p)) != p", logSize)
+				}
+			}
+		}
+	}
+}
+
+func TestDomainPoints(t *testing.T) {
+	const logSize = 5
+	for _, d := range testDomains(t, logSize) {
+		seen := make(map[Point]bool)
+		half := d.Size() / 2
+		for i := 0; i < d.Size(); i++ {
+			p := d.At(i)
+			if !p.IsOnCircle() || seen[p] {
+				t.Fatal("the points of the domain should be distinct points of the circle")
+			}
+			seen[p] = true
+			if i < half {
+				var conj Point
+				if conj.Conjugate(&p); !conj.Equal(&[]Point{d.At(i + half)}[0]) {
+					t.Fatal("the second half of the domain should be the conjugate of the first one")
+				}
+			}
+		}
+	}
+
+	// Q² ∈ G
+	q, _ := Generator(logSize - 1)
+	if _, err := NewTwinCoset(q, logSize); err != ErrNotTwinCoset {
+		t.Fatalf("expected ErrNotTwinCoset, got %v", err)
+	}
+	if _, err := NewDomain(MaxLogOrder); err != ErrInvalidLogSize {
+		t.Fatalf("expected ErrInvalidLogSize, got %v", err)
+	}
+}
+
+func TestExtend(t *testing.T) {
+	const logSize, logBlowup = 4, 2
+	small, _ := NewDomain(logSize)
+	large, _ := NewDomain(logSize + logBlowup)
+
+	coeffs := randomPolynomial(small.Size())
+	extended := Extend(coeffs, logBlowup)
+	large.FFT(extended)
+	for i := range extended {
+		p := large.At(i)
+		if expected := Evaluate(coeffs, &p); !expected.Equal(&extended[i]) {
+			t.Fatal("the extended polynomial should be the same")
+		}
+	}
+}
+
+func TestEvaluateExt(t *testing.T) {
+	coeffs := randomPolynomial(32)
+	g, _ := Generator(MaxLogOrder)
+	var p Point
+	p.ScalarMul(&g, 42)
+
+	var x, y extensions.E4
+	x.B0.A0, y.B0.A0 = p.X, p.Y
+	res := EvaluateExt(coeffs, &x, &y)
+	expected := Evaluate(coeffs, &p)
+	if !res.B0.A0.Equal(&expected) || !res.B0.A1.IsZero() || !res.B1.IsZero() {
+		t.Fatal("EvaluateExt should match Evaluate on base points")
+	}
+}
+
+// evaluateLine returns the evaluation at x of the polynomial whose
+// coefficients in the basis x, π(x), … are given.
+func evaluateLine(coeffs []extensions.E4, x fr.Element) extensions.E4 {
+	// the basis of the line is the circle basis without y
+	var res extensions.E4
+	for j := range coeffs {
+		term := coeffs[j]
+		xi := x
+		for bit := len(coeffs) / 2; bit > 0; bit >>= 1 {
+			if j&bit != 0 {
+				term.MulByElement(&term, &xi)
+			}
+			DoubleX(&xi)
+		}
+		res.Add(&res, &term)
+	}
+	return res
+}
+
+func TestFold(t *testing.T) {
+	const logSize = 6
+	d, _ := NewDomain(logSize)
+
+	// f with coefficients in 𝔽p⁴, evaluated coordinate-wise
+	coeffs := make([]extensions.E4, d.Size())
+	for i := range coeffs {
+		coeffs[i].SetRandom()
+	}
+	evals := make([]extensions.E4, d.Size())
+	coords := make([]fr.Element, d.Size())
+	for c := 0; c < 4; c++ {
+		for i := range coeffs {
+			coords[i] = *coordinate(&coeffs[i], c)
+		}
+		d.FFT(coords)
+		for i := range evals {
+			*coordinate(&evals[i], c) = coords[i]
+		}
+	}
+
+	var alpha extensions.E4
+	alpha.SetRandom()
+
+	// circle to line: f₀ + αf₁, the coefficients of f₁ being the second half
+	half := d.Size() / 2
+	folded := FoldCircleIntoLine(evals, &alpha, d)
+	lineCoeffs := make([]extensions.E4, half)
+	for j := range lineCoeffs {
+		lineCoeffs[j].Mul(&coeffs[j+half], &alpha).Add(&lineCoeffs[j], &coeffs[j])
+	}
+	line := d.LineDomain()
+	for i := range folded {
+		if expected := evaluateLine(lineCoeffs, line.At(i)); !expected.Equal(&folded[i]) {
+			t.Fatal("wrong circle folding")
+		}
+	}
+	p := d.At(3)
+	var yInv fr.Element
+	yInv.Inverse(&p.Y)
+	if res := FoldCirclePair(&evals[3], &evals[3+half], &alpha, &yInv); !res.Equal(&folded[3]) {
+		t.Fatal("FoldCirclePair should match FoldCircleIntoLine")
+	}
+
+	// line to line, until a constant
+	for len(folded) > 1 {
+		alpha.Square(&alpha)
+		half = len(folded) / 2
+		res := FoldLine(folded, &alpha, &line)
+		for j := 0; j < half; j++ {
+			var t extensions.E4
+			t.Mul(&lineCoeffs[j+half], &alpha)
+			lineCoeffs[j].Add(&lineCoeffs[j], &t)
+		}
+		lineCoeffs = lineCoeffs[:half]
+
+		x := line.At(0)
+		var xInv fr.Element
+		xInv.Inverse(&x)
+		if r := FoldLinePair(&folded[0], &folded[half], &alpha, &xInv); !r.Equal(&res[0]) {
+			t.Fatal("FoldLinePair should match FoldLine")
+		}
+
+		line = line.Double()
+		for i := range res {
+			if expected := evaluateLine(lineCoeffs, line.At(i)); !expected.Equal(&res[i]) {
+				t.Fatalf("wrong line folding of size %d", len(folded))
+			}
+		}
+		folded = res
+	}
+}
+
+func coordinate(x *extensions.E4, i int) *fr.Element {
+	return []*fr.Element{&x.B0.A0, &x.B0.A1, &x.B1.A0, &x.B1.A1}[i]
+}
+
+func BenchmarkFFT(b *testing.B) {
+	for _, logSize := range []int{16, 20} {
+		d, err := NewDomain(logSize)
+		if err != nil {
+			b.Fatal(err)
+		}
+		a := randomPolynomial(d.Size())
+		b.Run(fmt.Sprintf("size=2^%d", logSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d.FFT(a)
+			}
+		})
+		b.Run(fmt.Sprintf("inverse/size=2^%d", logSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d.FFTInverse(a)
+			}
+		})
+	}
+}
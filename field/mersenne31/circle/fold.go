@@ -0,0 +1,71 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/consensys/gnark-crypto/field/mersenne31/extensions"
+)
+
+// FoldCirclePair returns f₀(x) + α·f₁(x) where f = f₀(x) + y·f₁(x), from the
+// evaluations f(p) and f(p⁻¹) at p = (x, y) and its conjugate, yInv being
+// 1/y.
+func FoldCirclePair(f, fConj, alpha *extensions.E4, yInv *fr.Element) extensions.E4 {
+	return foldPair(f, fConj, alpha, yInv)
+}
+
+// FoldLinePair returns g₀(π(x)) + α·g₁(π(x)) where f = g₀(π(x)) + x·g₁(π(x)),
+// from the evaluations f(x) and f(-x), xInv being 1/x.
+func FoldLinePair(f, fNeg, alpha *extensions.E4, xInv *fr.Element) extensions.E4 {
+	return foldPair(f, fNeg, alpha, xInv)
+}
+
+// foldPair returns (a+b)/2 + α(a-b)/2t
+func foldPair(a, b, alpha *extensions.E4, tInv *fr.Element) extensions.E4 {
+	var sum, diff extensions.E4
+	sum.Add(a, b)
+	diff.Sub(a, b).MulByElement(&diff, tInv).Mul(&diff, alpha)
+	sum.Add(&sum, &diff)
+	sum.Halve()
+	return sum
+}
+
+// FoldCircleIntoLine folds by α the evaluations of f on the domain, in
+// natural order, into the evaluations of f₀ + α·f₁ on d.LineDomain(), where
+// f = f₀(x) + y·f₁(x). It is the first step of circle FRI.
+func FoldCircleIntoLine(evals []extensions.E4, alpha *extensions.E4, d *Domain) []extensions.E4 {
+	if len(evals) != d.Size() {
+		panic("the number of evaluations does not match the size of the domain")
+	}
+	half := len(evals) / 2
+	res := make([]extensions.E4, half)
+	for i := range res {
+		res[i] = foldPair(&evals[i], &evals[i+half], alpha, &d.twiddlesInv[0][i])
+	}
+	return res
+}
+
+// FoldLine folds by α the evaluations of f on the line domain, in natural
+// order, into the evaluations of g₀ + α·g₁ on l.Double(), where
+// f = g₀(π(x)) + x·g₁(π(x)). It is the folding step of circle FRI after the
+// first one.
+func FoldLine(evals []extensions.E4, alpha *extensions.E4, l *LineDomain) []extensions.E4 {
+	if len(evals) != l.Size() || len(evals) < 2 {
+		panic("the number of evaluations does not match the size of the domain")
+	}
+	half := len(evals) / 2
+	xInv := make([]fr.Element, half)
+	p := l.Coset.Initial
+	for i := range xInv {
+		xInv[i] = p.X
+		p.Add(&p, &l.Coset.Step)
+	}
+	xInv = fr.BatchInvert(xInv)
+
+	res := make([]extensions.E4, half)
+	for i := range res {
+		res[i] = foldPair(&evals[i], &evals[i+half], alpha, &xInv[i])
+	}
+	return res
+}
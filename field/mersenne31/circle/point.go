@@ -0,0 +1,120 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"errors"
+
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// MaxLogOrder is the 2-adicity of the circle group, of order p+1 = 2³¹.
+const MaxLogOrder = 31
+
+var ErrOrderTooLarge = errors.New("the circle group has no subgroup of this order")
+
+// Point is a point of the circle x²+y²=1, the group law being the complex
+// multiplication of x+iy.
+type Point struct {
+	X, Y fr.Element
+}
+
+// generator of the circle group, of order 2³¹
+var generator = Point{X: fr.NewElement(2), Y: fr.NewElement(1268011823)}
+
+// Identity returns the neutral element (1, 0) of the circle group.
+func Identity() Point {
+	var res Point
+	res.X.SetOne()
+	return res
+}
+
+// Generator returns a generator of the subgroup of order 2^logOrder.
+func Generator(logOrder int) (Point, error) {
+	if logOrder < 0 || logOrder > MaxLogOrder {
+		return Point{}, ErrOrderTooLarge
+	}
+	res := generator
+	for i := logOrder; i < MaxLogOrder; i++ {
+		res.Double(&res)
+	}
+	return res, nil
+}
+
+// Equal returns true if p equals q.
+func (p *Point) Equal(q *Point) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if x²+y²=1.
+func (p *Point) IsOnCircle() bool {
+	var x2, y2 fr.Element
+	x2.Square(&p.X)
+	y2.Square(&p.Y)
+	x2.Add(&x2, &y2)
+	return x2.IsOne()
+}
+
+// Add sets p to a·b and returns p.
+func (p *Point) Add(a, b *Point) *Point {
+	// (x₁+iy₁)(x₂+iy₂) with 3 multiplications
+	var t0, t1, t2 fr.Element
+	t0.Mul(&a.X, &b.X)
+	t1.Mul(&a.Y, &b.Y)
+	t2.Add(&a.X, &a.Y)
+	p.Y.Add(&b.X, &b.Y)
+	p.Y.Mul(&p.Y, &t2).Sub(&p.Y, &t0).Sub(&p.Y, &t1)
+	p.X.Sub(&t0, &t1)
+	return p
+}
+
+// Double sets p to a² = (2x²-1, 2xy) and returns p.
+func (p *Point) Double(a *Point) *Point {
+	var x fr.Element
+	x.Set(&a.X)
+	p.Y.Mul(&a.Y, &x).Double(&p.Y)
+	p.X.Set(&x)
+	DoubleX(&p.X)
+	return p
+}
+
+// DoubleX sets x to π(x) = 2x²-1, the x-coordinate of the doubling map.
+func DoubleX(x *fr.Element) {
+	var one fr.Element
+	one.SetOne()
+	x.Square(x).Double(x).Sub(x, &one)
+}
+
+// Conjugate sets p to a⁻¹ = (x, -y) and returns p.
+func (p *Point) Conjugate(a *Point) *Point {
+	p.X.Set(&a.X)
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// Antipode sets p to -a = (-x, -y), that is a·(-1, 0), and returns p.
+func (p *Point) Antipode(a *Point) *Point {
+	p.X.Neg(&a.X)
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// ScalarMul sets p to aᵏ and returns p.
+func (p *Point) ScalarMul(a *Point, k uint64) *Point {
+	res := Identity()
+	base := *a
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			res.Add(&res, &base)
+		}
+		base.Double(&base)
+	}
+	*p = res
+	return p
+}
+
+// String returns the coordinates of p.
+func (p *Point) String() string {
+	return "(" + p.X.String() + ", " + p.Y.String() + ")"
+}
@@ -0,0 +1,67 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"testing"
+)
+
+func TestGenerator(t *testing.T) {
+	identity := Identity()
+	for logOrder := 0; logOrder <= MaxLogOrder; logOrder++ {
+		g, err := Generator(logOrder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.IsOnCircle() {
+			t.Fatalf("generator of order 2^%d is not on the circle", logOrder)
+		}
+		var p Point
+		if p.ScalarMul(&g, 1<<logOrder); !p.Equal(&identity) {
+			t.Fatalf("generator of order 2^%d: wrong order", logOrder)
+		}
+		if logOrder > 0 {
+			if p.ScalarMul(&g, 1<<(logOrder-1)); p.Equal(&identity) {
+				t.Fatalf("generator of order 2^%d: wrong order", logOrder)
+			}
+		}
+	}
+	if _, err := Generator(MaxLogOrder + 1); err != ErrOrderTooLarge {
+		t.Fatal("expected ErrOrderTooLarge")
+	}
+}
+
+func TestGroupLaw(t *testing.T) {
+	g, _ := Generator(MaxLogOrder)
+	var a, b, c Point
+	a.ScalarMul(&g, 123456789)
+	b.ScalarMul(&g, 987654321)
+
+	// aᵏ·aˡ = aᵏ⁺ˡ
+	c.Add(&a, &b)
+	var expected Point
+	expected.ScalarMul(&g, 123456789+987654321)
+	if !c.Equal(&expected) || !c.IsOnCircle() {
+		t.Fatal("wrong addition")
+	}
+
+	// a·a = a²
+	c.Add(&a, &a)
+	if expected.Double(&a); !c.Equal(&expected) {
+		t.Fatal("wrong doubling")
+	}
+
+	// a·a⁻¹ = 1
+	c.Conjugate(&a).Add(&c, &a)
+	if expected = Identity(); !c.Equal(&expected) {
+		t.Fatal("wrong conjugate")
+	}
+
+	// -a = a·(-1, 0)
+	minusOne, _ := Generator(1)
+	c.Add(&a, &minusOne)
+	if expected.Antipode(&a); !c.Equal(&expected) {
+		t.Fatal("wrong antipode")
+	}
+}
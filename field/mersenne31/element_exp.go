@@ -0,0 +1,104 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mersenne31
+
+// expBySqrtExp is equivalent to z.Exp(x, 20000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	return  1 << 29
+	//
+	// Operations: 29 squares 0 multiplies
+
+	// Allocate Temporaries.
+	var ()
+
+	// var
+	// Step 29: z = x^0x20000000
+	z.Square(&x)
+	for s := 1; s < 29; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 3fffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10     = 2*1
+	//	_11     = 1 + _10
+	//	_110    = 2*_11
+	//	_111    = 1 + _110
+	//	_111000 = _111 << 3
+	//	_111111 = _111 + _111000
+	//	x12     = _111111 << 6 + _111111
+	//	x24     = x12 << 12 + x12
+	//	return    x24 << 6 + _111111
+	//
+	// Operations: 29 squares 6 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+	)
+
+	// var t0,t1 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, z)
+
+	// Step 3: z = x^0x6
+	z.Square(z)
+
+	// Step 4: z = x^0x7
+	z.Mul(&x, z)
+
+	// Step 7: t0 = x^0x38
+	t0.Square(z)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 8: z = x^0x3f
+	z.Mul(z, t0)
+
+	// Step 14: t0 = x^0xfc0
+	t0.Square(z)
+	for s := 1; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 15: t0 = x^0xfff
+	t0.Mul(z, t0)
+
+	// Step 27: t1 = x^0xfff000
+	t1.Square(t0)
+	for s := 1; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 28: t0 = x^0xffffff
+	t0.Mul(t0, t1)
+
+	// Step 34: t0 = x^0x3fffffc0
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 35: z = x^0x3fffffff
+	z.Mul(z, t0)
+
+	return z
+}
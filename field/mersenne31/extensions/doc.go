@@ -0,0 +1,6 @@
+// Package extensions implements the fields arithmetic of the 𝔽r² and 𝔽r⁴
+// extensions of the Mersenne31 field.
+//
+//	𝔽r²[i] = 𝔽r/i²+1
+//	𝔽r⁴[u] = 𝔽r²/u²-(2+i)
+package extensions
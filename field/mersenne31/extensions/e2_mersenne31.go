@@ -0,0 +1,78 @@
+package extensions
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// Mul sets z to the E2-product of x,y, returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	var a, b, c fr.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	z.A0.Sub(&b, &c)
+	return z
+}
+
+// Square sets z to the E2-product of x,x returns z
+func (z *E2) Square(x *E2) *E2 {
+	// (a+bi)² = (a+b)(a-b) + 2abi
+	var a, b, c fr.Element
+	a.Mul(&x.A0, &x.A1).Double(&a)
+	b.Add(&x.A0, &x.A1)
+	c.Sub(&x.A0, &x.A1)
+	z.A0.Mul(&b, &c)
+	z.A1 = a
+	return z
+}
+
+// MulByNonResidue multiplies a E2 by (2,1), the non-residue defining E4
+func (z *E2) MulByNonResidue(x *E2) *E2 {
+	// (a+bi)(2+i) = (2a-b) + (a+2b)i
+	var a, b fr.Element
+	a.Double(&x.A0).Sub(&a, &x.A1)
+	b.Double(&x.A1).Add(&b, &x.A0)
+	z.A0 = a
+	z.A1 = b
+	return z
+}
+
+// MulByNonResidueInv multiplies a E2 by (2,1)^{-1}
+func (z *E2) MulByNonResidueInv(x *E2) *E2 {
+	// (a+bi)/(2+i) = (a+bi)(2-i)/5 = ((2a+b) + (2b-a)i)/5
+	var a, b, fiveInv fr.Element
+	a.Double(&x.A0).Add(&a, &x.A1)
+	b.Double(&x.A1).Sub(&b, &x.A0)
+	// 1/5 mod r
+	fiveInv.SetUint64(858993459)
+	z.A0.Mul(&a, &fiveInv)
+	z.A1.Mul(&b, &fiveInv)
+	return z
+}
+
+// Inverse sets z to the E2-inverse of x, returns z
+func (z *E2) Inverse(x *E2) *E2 {
+	// Algorithm 8 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1 fr.Element
+	a := &x.A0 // creating the buffers a, b is faster than querying &x.A0, &x.A1 in the functions call below
+	b := &x.A1
+	t0.Square(a)
+	t1.Square(b)
+	t0.Add(&t0, &t1)
+	t1.Inverse(&t0)
+	z.A0.Mul(a, &t1)
+	z.A1.Mul(b, &t1).Neg(&z.A1)
+
+	return z
+}
+
+// norm sets x to the norm of z
+func (z *E2) norm(x *fr.Element) {
+	var tmp fr.Element
+	x.Square(&z.A1)
+	tmp.Square(&z.A0)
+	x.Add(x, &tmp)
+}
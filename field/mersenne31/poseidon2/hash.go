@@ -0,0 +1,49 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+	gnarkHash "github.com/consensys/gnark-crypto/hash"
+	"hash"
+	"sync"
+)
+
+// NewMerkleDamgardHasher returns a Poseidon2 hasher using the Merkle-Damgard
+// construction with the default parameters.
+func NewMerkleDamgardHasher() gnarkHash.StateStorer {
+	return gnarkHash.NewMerkleDamgardHasher(
+		&Permutation{GetDefaultParameters()}, make([]byte, fr.Bytes))
+}
+
+// GetDefaultParameters returns a set of parameters for the Poseidon2 permutation.
+// The default parameters are,
+//
+//  1. for compression:
+//     - width: 16
+//     - nbFullRounds: 8
+//     - nbPartialRounds: 14
+//
+//  2. for sponge:
+//     - width: 24
+//     - nbFullRounds: 8
+//     - nbPartialRounds: 22
+var GetDefaultParameters = sync.OnceValue(func() *Parameters {
+	return NewParameters(16, 8, 14)
+	// return NewParameters(24, 8, 22)
+})
+
+// shifts of the diagonal of the internal matrix, as in
+// https://github.com/Plonky3/Plonky3/blob/main/mersenne-31/src/poseidon2.rs
+var diag16Shifts = [15]uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 10, 12, 13, 14, 15, 16}
+var diag24Shifts = [23]uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22}
+
+func init() {
+
+	gnarkHash.RegisterHash(gnarkHash.POSEIDON2_MERSENNE31, func() hash.Hash {
+		return NewMerkleDamgardHasher()
+	})
+}
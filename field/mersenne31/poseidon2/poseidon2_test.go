@@ -0,0 +1,93 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package poseidon2
+
+import (
+	"math/big"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// diag returns the diagonal of the internal matrix, [-2, 2^s₀, 2^s₁, …]
+func diag(shifts []uint32) []fr.Element {
+	res := make([]fr.Element, len(shifts)+1)
+	res[0].SetInt64(-2)
+	two := fr.NewElement(2)
+	for i, s := range shifts {
+		res[i+1].Exp(two, big.NewInt(int64(s)))
+	}
+	return res
+}
+
+func testMatMulInternalInPlace(t *testing.T, width int, shifts []uint32) {
+	input := make([]fr.Element, width)
+	for i := range input {
+		input[i].SetRandom()
+	}
+	expected := make([]fr.Element, width)
+	copy(expected, input)
+
+	h := NewPermutation(width, 8, 14)
+	h.matMulInternalInPlace(expected)
+
+	var sum fr.Element
+	for i := range input {
+		sum.Add(&sum, &input[i])
+	}
+	d := diag(shifts)
+	for i := range input {
+		input[i].Mul(&input[i], &d[i]).
+			Add(&input[i], &sum)
+		if !input[i].Equal(&expected[i]) {
+			t.Fatal("mismatch error")
+		}
+	}
+}
+
+func TestMulMulInternalInPlaceWidth16(t *testing.T) {
+	testMatMulInternalInPlace(t, 16, diag16Shifts[:])
+}
+
+func TestMulMulInternalInPlaceWidth24(t *testing.T) {
+	testMatMulInternalInPlace(t, 24, diag24Shifts[:])
+}
+
+func TestSBox(t *testing.T) {
+	var input [16]fr.Element
+	input[3].SetRandom()
+	var expected fr.Element
+	expected.Exp(input[3], big.NewInt(d))
+
+	h := NewPermutation(16, 8, 14)
+	h.sBox(3, input[:])
+	if !input[3].Equal(&expected) {
+		t.Fatal("mismatch error")
+	}
+}
+
+// bench
+func BenchmarkPoseidon2Width16(b *testing.B) {
+	h := NewPermutation(16, 8, 14)
+	var tmp [16]fr.Element
+	for i := 0; i < 16; i++ {
+		tmp[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Permutation(tmp[:])
+	}
+}
+
+func BenchmarkPoseidon2Width24(b *testing.B) {
+	h := NewPermutation(24, 8, 22)
+	var tmp [24]fr.Element
+	for i := 0; i < 24; i++ {
+		tmp[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Permutation(tmp[:])
+	}
+}
@@ -63,6 +63,8 @@ const (
 	POSEIDON2_BABYBEAR
 	// POSEIDON2_GOLDILOCKS is the Poseidon2 hash function for the Goldilocks field.
 	POSEIDON2_GOLDILOCKS
+	// POSEIDON2_MERSENNE31 is the Poseidon2 hash function for the Mersenne31 field.
+	POSEIDON2_MERSENNE31
 
 	maxHash
 )
@@ -90,6 +92,7 @@ var digestSize = []uint8{
 	POSEIDON2_KOALABEAR:  4,
 	POSEIDON2_BABYBEAR:   4,
 	POSEIDON2_GOLDILOCKS: 8,
+	POSEIDON2_MERSENNE31: 4,
 }
 
 // New initializes the hash function. This is a convenience function which does
@@ -151,6 +154,8 @@ func (m Hash) String() string {
 		return "POSEIDON2_BABYBEAR"
 	case POSEIDON2_GOLDILOCKS:
 		return "POSEIDON2_GOLDILOCKS"
+	case POSEIDON2_MERSENNE31:
+		return "POSEIDON2_MERSENNE31"
 	default:
 		return "unknown hash function"
 	}
@@ -0,0 +1,52 @@
+package bls
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+type blsConfig struct {
+	config.Curve
+	// SuiteG1, SuiteG2 are the hash-to-curve suite identifiers used to build
+	// the ciphersuite domain separation tags.
+	SuiteG1, SuiteG2 string
+}
+
+// hash-to-curve suite identifiers per curve, see
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-suite-id-naming-conventions
+var suites = map[string][2]string{
+	"bn254":     {"BN254G1_XMD:SHA-256_SVDW_RO_", "BN254G2_XMD:SHA-256_SVDW_RO_"},
+	"bls12-381": {"BLS12381G1_XMD:SHA-256_SSWU_RO_", "BLS12381G2_XMD:SHA-256_SSWU_RO_"},
+	"bls12-377": {"BLS12377G1_XMD:SHA-256_SSWU_RO_", "BLS12377G2_XMD:SHA-256_SSWU_RO_"},
+	"bls24-315": {"BLS24315G1_XMD:SHA-256_SSWU_RO_", "BLS24315G2_XMD:SHA-256_SVDW_RO_"},
+	"bls24-317": {"BLS24317G1_XMD:SHA-256_SSWU_RO_", "BLS24317G2_XMD:SHA-256_SVDW_RO_"},
+	"bw6-761":   {"BW6761G1_XMD:SHA-256_SSWU_RO_", "BW6761G2_XMD:SHA-256_SSWU_RO_"},
+	"bw6-633":   {"BW6633G1_XMD:SHA-256_SSWU_RO_", "BW6633G2_XMD:SHA-256_SSWU_RO_"},
+}
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// bls signature scheme
+	conf.Package = "bls"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	s, ok := suites[conf.Name]
+	if !ok {
+		return fmt.Errorf("bls: no hash-to-curve suite for %s", conf.Name)
+	}
+	blsConf := blsConfig{Curve: conf, SuiteG1: s[0], SuiteG2: s[1]}
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bls.go"), Templates: []string{"bls.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bls_test.go"), Templates: []string{"bls.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate.go"), Templates: []string{"aggregate.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate_test.go"), Templates: []string{"aggregate.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+	}
+	return bgen.Generate(blsConf, conf.Package, "./bls/template", entries...)
+
+}
@@ -13,6 +13,7 @@ var BLS12_377 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    2,
 	},
 	G2: Point{
 		CoordType:        "fptower.E2",
@@ -22,6 +23,8 @@ var BLS12_377 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    1 << 20,
+		CofactorBoundDoc: "the cofactor has no prime factor below 2²², which was checked by trial division.",
 		Projective:       true,
 	},
 	// 2-isogeny
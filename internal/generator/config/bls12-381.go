@@ -13,6 +13,7 @@ var BLS12_381 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    3,
 	},
 	G2: Point{
 		CoordType:        "fptower.E2",
@@ -22,6 +23,7 @@ var BLS12_381 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    13,
 		Projective:       true,
 	},
 	// 11-isogeny
@@ -13,6 +13,7 @@ var BLS24_315 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    2,
 	},
 	G2: Point{
 		CoordType:        "fptower.E4",
@@ -21,6 +22,8 @@ var BLS24_315 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    1 << 20,
+		CofactorBoundDoc: "the smallest prime factor of the cofactor is 4006969 > 2²¹.",
 		Projective:       true,
 	},
 	// 2-isogeny
@@ -13,6 +13,7 @@ var BLS24_317 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    3,
 	},
 	G2: Point{
 		CoordType:        "fptower.E4",
@@ -21,6 +22,7 @@ var BLS24_317 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    2,
 		Projective:       true,
 	},
 	// 5-isogeny
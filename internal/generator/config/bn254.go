@@ -21,6 +21,7 @@ var BN254 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           defaultCRange(),
+		CofactorBound:    10069,
 		Projective:       true,
 	},
 	HashE1: &HashSuiteSvdw{
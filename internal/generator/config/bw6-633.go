@@ -13,6 +13,7 @@ var BW6_633 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           []int{4, 5, 8, 16},
+		CofactorBound:    3,
 	},
 	G2: Point{
 		CoordType:        "fp.Element",
@@ -21,6 +22,7 @@ var BW6_633 = Curve{
 		GLV:              true,
 		CofactorCleaning: true,
 		CRange:           []int{4, 5, 8, 16},
+		CofactorBound:    2,
 		Projective:       true,
 	},
 	// 7-isogeny
@@ -32,6 +32,10 @@ type TwistedEdwardsCurve struct {
 	Package string
 	EnumID  string
 
+	// FieldPackage is the package, in ecc/<Name>, of the field the curve is
+	// defined on. Defaults to "fr" for the companion curves.
+	FieldPackage string
+
 	A, D, Cofactor, Order, BaseX, BaseY string
 
 	// set if endomorphism
@@ -58,7 +62,10 @@ type Point struct {
 	GLV              bool     // scalar multiplication using GLV
 	CofactorCleaning bool     // flag telling if the Cofactor cleaning is available
 	CRange           []int    // multiexp bucket method: generate inner methods (with const arrays) for each c
+	CofactorBound    uint64   // lower bound on the prime factors of the cofactor (the smallest one, or a power of two below it); 0 if the cofactor is 1
+	CofactorBoundDoc string   // justification of CofactorBound when it is not the smallest prime factor of the cofactor
 	Projective       bool     // generate projective coordinates
+	AIsMinus3        bool     // the curve is Y²=X³-3X+b instead of Y²=X³+b
 	A                []string //A linear coefficient in Weierstrass form
 	B                []string //B constant term in Weierstrass form
 }
@@ -79,6 +86,9 @@ func addCurve(c *Curve) {
 }
 
 func addTwistedEdwardCurve(c *TwistedEdwardsCurve) {
+	if c.FieldPackage == "" {
+		c.FieldPackage = "fr"
+	}
 	TwistedEdwardsCurves = append(TwistedEdwardsCurves, *c)
 }
 
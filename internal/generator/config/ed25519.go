@@ -0,0 +1,33 @@
+package config
+
+// ED25519 describes the fields of Curve25519 in its twisted Edwards form
+// (edwards25519). The curve has no short Weierstrass model in gnark-crypto,
+// so it is not added to Curves: the points are generated from the twisted
+// Edwards templates, see TwistedEdwardsED25519.
+var ED25519 = Curve{
+	Name:         "ed25519",
+	CurvePackage: "ed25519",
+	EnumID:       "ED25519",
+	FrModulus:    "7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	FpModulus:    "57896044618658097711785492504343953926634992332820282019728792003956564819949",
+}
+
+// TwistedEdwardsED25519 is edwards25519, -x² + y² = 1 + d⋅x²⋅y² over GF(2²⁵⁵-19),
+// as defined in https://www.rfc-editor.org/rfc/rfc7748#section-4.1.
+var TwistedEdwardsED25519 = TwistedEdwardsCurve{
+	Name:         ED25519.Name,
+	Package:      "ed25519",
+	EnumID:       ED25519.EnumID,
+	FieldPackage: "fp",
+	A:            "-1",
+	D:            "37095705934669439343138083508754565189542113879843219016388785533085940283555",
+	Cofactor:     "8",
+	Order:        "7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	BaseX:        "15112221349535400772501151409588531511454012693041857206046113283949847762202",
+	BaseY:        "46316835694926478169428394003475163141307993866256225615783033603165251855960",
+}
+
+func init() {
+	ED25519.FpInfo = newFieldInfo(ED25519.FpModulus)
+	ED25519.FrInfo = newFieldInfo(ED25519.FrModulus)
+}
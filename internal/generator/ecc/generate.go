@@ -48,6 +48,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g1.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "subgroup_g1.go"), Templates: []string{"subgroup.go.tmpl"}},
+		{File: filepath.Join(baseDir, "subgroup_g1_test.go"), Templates: []string{"tests/subgroup.go.tmpl"}},
 	}
 	// if not secp256k1, generate the lagrange transform
 	if conf.Name != config.SECP256K1.Name || conf.Name != config.GRUMPKIN.Name {
@@ -170,8 +172,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
-	// No G2 for secp256k1 and grumpkin
-	if conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN) {
+	// No G2 for secp256k1, grumpkin and p256
+	if conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN) || conf.Equal(config.P256) {
 		return nil
 	}
 
@@ -190,6 +192,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "subgroup_g2.go"), Templates: []string{"subgroup.go.tmpl"}},
+		{File: filepath.Join(baseDir, "subgroup_g2_test.go"), Templates: []string{"tests/subgroup.go.tmpl"}},
 	}
 	g2 := pconf{conf, conf.G2}
 	if err := bgen.Generate(g2, packageName, "./ecc/template", entries...); err != nil {
@@ -14,6 +14,10 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(baseDir, "config_test.go"), Templates: []string{"config.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "merkle.go"), Templates: []string{"merkle.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 	}
 	return bgen.Generate(conf, conf.Package, "./fri/template/", entries...)
 
@@ -0,0 +1,22 @@
+package ipa
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// inner product argument commitment scheme
+	conf.Package = "ipa"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa.go"), Templates: []string{"ipa.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa_test.go"), Templates: []string{"ipa.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "accumulator.go"), Templates: []string{"accumulator.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./ipa/template/", entries...)
+
+}
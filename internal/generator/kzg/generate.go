@@ -17,6 +17,18 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
 		{File: filepath.Join(baseDir, "mpcsetup.go"), Templates: []string{"mpcsetup.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fk20.go"), Templates: []string{"fk20.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fk20_test.go"), Templates: []string{"fk20.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "import.go"), Templates: []string{"import.go.tmpl"}},
+		{File: filepath.Join(baseDir, "import_test.go"), Templates: []string{"import.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding.go"), Templates: []string{"hiding.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding_test.go"), Templates: []string{"hiding.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "stream.go"), Templates: []string{"stream.go.tmpl"}},
+		{File: filepath.Join(baseDir, "stream_test.go"), Templates: []string{"stream.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange.go"), Templates: []string{"lagrange.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange_test.go"), Templates: []string{"lagrange.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch_test.go"), Templates: []string{"batch.test.go.tmpl"}},
 	}
 	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	fieldConfig "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/bls"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon2"
@@ -24,16 +25,19 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
+	"github.com/consensys/gnark-crypto/internal/generator/ipa"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/shamir"
 	"github.com/consensys/gnark-crypto/internal/generator/shplonk"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/generator/zeromorph"
 )
 
 const (
@@ -77,7 +81,7 @@ func main() {
 			conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
 
 			frOpts := []generator.Option{generator.WithASM(asmConfig)}
-			if !(conf.Equal(config.STARK_CURVE) || conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN)) {
+			if !(conf.Equal(config.STARK_CURVE) || conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN) || conf.Equal(config.P256)) {
 				frOpts = append(frOpts, generator.WithFFT(fftConfig))
 			}
 			if conf.Equal(config.BLS12_377) {
@@ -89,6 +93,11 @@ func main() {
 			// generate ecdsa
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
+			if conf.Equal(config.STARK_CURVE) || conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN) {
+				// generate the inner product argument commitment scheme on curves without pairing
+				assertNoError(ipa.Generate(conf, filepath.Join(curveDir, "ipa"), bgen))
+			}
+
 			if conf.Equal(config.STARK_CURVE) {
 				return // TODO @yelhousni
 			}
@@ -96,7 +105,7 @@ func main() {
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			if conf.Equal(config.SECP256K1) {
+			if conf.Equal(config.SECP256K1) || conf.Equal(config.P256) {
 				return
 			}
 
@@ -148,6 +157,9 @@ func main() {
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
+			// generate shamir secret sharing on fr
+			assertNoError(shamir.Generate(conf, filepath.Join(curveDir, "fr", "shamir"), bgen))
+
 			// generate tower of extension
 			assertNoError(tower.Generate(conf, filepath.Join(curveDir, "internal", "fptower"), bgen))
 
@@ -157,12 +169,18 @@ func main() {
 			// generate fri on fr
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
+			// generate bls signatures
+			assertNoError(bls.Generate(conf, curveDir, bgen))
+
 			// generate mpc setup tools
 			assertNoError(mpcsetup.Generate(conf, filepath.Join(curveDir, "mpcsetup"), bgen))
 
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
 
+			// generate zeromorph on fr
+			assertNoError(zeromorph.Generate(conf, filepath.Join(curveDir, "zeromorph"), bgen))
+
 			// generate shplonk on fr
 			assertNoError(shplonk.Generate(conf, filepath.Join(curveDir, "shplonk"), bgen))
 
@@ -197,10 +215,36 @@ func main() {
 
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
+
+			if conf.Package == "bandersnatch" {
+				// generate the scalar field, used by the banderwagon group and the ipa commitment
+				frConf, err := fieldConfig.NewFieldConfig("fr", "Element", conf.Order, true)
+				assertNoError(err)
+				frAsmConfig := &fieldConfig.Assembly{BuildDir: asmDirBuildPath, IncludeDir: filepath.Join(baseDir, "..", "..", "field", "asm")}
+				assertNoError(generator.GenerateFF(frConf, filepath.Join(curveDir, "fr"), generator.WithASM(frAsmConfig)))
+			}
 		}(conf)
 
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// generate the fields of ed25519 and the twisted Edwards points
+		conf := config.ED25519
+		curveDir := filepath.Join(baseDir, "ecc", conf.Name)
+
+		fpConf, err := fieldConfig.NewFieldConfig("fp", "Element", conf.FpModulus, true)
+		assertNoError(err)
+		frConf, err := fieldConfig.NewFieldConfig("fr", "Element", conf.FrModulus, true)
+		assertNoError(err)
+		assertNoError(generator.GenerateFF(fpConf, filepath.Join(curveDir, "fp"), generator.WithASM(asmConfig)))
+		assertNoError(generator.GenerateFF(frConf, filepath.Join(curveDir, "fr"), generator.WithASM(asmConfig)))
+
+		assertNoError(edwards.Generate(config.TwistedEdwardsED25519, curveDir, bgen))
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
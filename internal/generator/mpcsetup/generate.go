@@ -12,5 +12,24 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "mpcsetup.go"), Templates: []string{"mpcsetup.go.tmpl"}},
 		{File: filepath.Join(baseDir, "mpcsetup_test.go"), Templates: []string{"tests/mpcsetup.go.tmpl"}},
 	}
-	return bgen.Generate(conf, "mpcsetup", "./mpcsetup/template", entries...)
+	if err := bgen.Generate(conf, "mpcsetup", "./mpcsetup/template", entries...); err != nil {
+		return err
+	}
+
+	// phase 1 ceremony
+	powersOfTauDir := filepath.Join(baseDir, "powersoftau")
+	entries = []bavard.Entry{
+		{File: filepath.Join(powersOfTauDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(powersOfTauDir, "phase1.go"), Templates: []string{"phase1.go.tmpl"}},
+		{File: filepath.Join(powersOfTauDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(powersOfTauDir, "powersoftau_test.go"), Templates: []string{"tests/powersoftau.go.tmpl"}},
+	}
+	// snarkjs only supports these curves
+	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(powersOfTauDir, "ptau.go"), Templates: []string{"ptau.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(powersOfTauDir, "ptau_test.go"), Templates: []string{"tests/ptau.go.tmpl"}},
+		)
+	}
+	return bgen.Generate(conf, "powersoftau", "./mpcsetup/powersoftau/template", entries...)
 }
@@ -0,0 +1,22 @@
+package shamir
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// shamir secret sharing and verifiable secret sharing
+	conf.Package = "shamir"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "shamir.go"), Templates: []string{"shamir.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vss.go"), Templates: []string{"vss.go.tmpl"}},
+		{File: filepath.Join(baseDir, "shamir_test.go"), Templates: []string{"shamir.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "example_test.go"), Templates: []string{"example_test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./shamir/template/", entries...)
+
+}
@@ -0,0 +1,42 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/bls"
+	bls_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/bls"
+	bls_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/bls"
+	bls_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/bls"
+	bls_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/bls"
+	bls_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/bls"
+	bls_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/bls"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// New takes a source of randomness and returns a new key pair.
+// Keys use the MinPkPop ciphersuite (public keys in G1, signatures in G2,
+// proof-of-possession scheme) of the curve.
+func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
+	switch ss {
+	case ecc.BN254:
+		return bls_bn254.GenerateKey(r)
+	case ecc.BLS12_381:
+		return bls_bls12381.GenerateKey(r)
+	case ecc.BLS12_377:
+		return bls_bls12377.GenerateKey(r)
+	case ecc.BW6_761:
+		return bls_bw6761.GenerateKey(r)
+	case ecc.BLS24_315:
+		return bls_bls24315.GenerateKey(r)
+	case ecc.BLS24_317:
+		return bls_bls24317.GenerateKey(r)
+	case ecc.BW6_633:
+		return bls_bw6633.GenerateKey(r)
+	default:
+		panic("not implemented")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	curves := []ecc.ID{ecc.BN254, ecc.BLS12_381, ecc.BLS12_377, ecc.BW6_761, ecc.BLS24_315, ecc.BLS24_317, ecc.BW6_633}
+	msg := []byte("testing BLS signatures")
+	for _, curve := range curves {
+		t.Run(curve.String(), func(t *testing.T) {
+			t.Parallel()
+			signer, err := New(curve, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := signer.Sign(msg, sha256.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			ok, err := signer.Public().Verify(sig, msg, sha256.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("signature should verify")
+			}
+			ok, _ = signer.Public().Verify(sig, []byte("wrong message"), sha256.New())
+			if ok {
+				t.Fatal("signature of another message should not verify")
+			}
+		})
+	}
+}
+
+func TestNewNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	curves := []ecc.ID{ecc.UNKNOWN, ecc.STARK_CURVE, ecc.SECP256K1, ecc.GRUMPKIN, ecc.ED25519, ecc.P256}
+	for _, curve := range curves {
+		func() {
+			defer func() {
+				if r := recover(); r != "not implemented" {
+					t.Fatalf("curve %d: expected a \"not implemented\" panic, got %v", curve, r)
+				}
+			}()
+			_, _ = New(curve, rand.Reader)
+		}()
+	}
+}
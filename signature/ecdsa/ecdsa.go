@@ -14,6 +14,7 @@ import (
 	ecdsa_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/ecdsa"
 	ecdsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/ecdsa"
 	ecdsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/ecdsa"
+	ecdsa_p256 "github.com/consensys/gnark-crypto/ecc/p256/ecdsa"
 	ecdsa_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
 	ecdsa_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/ecdsa"
 	"github.com/consensys/gnark-crypto/signature"
@@ -40,6 +41,8 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_secp256k1.GenerateKey(r)
 	case ecc.STARK_CURVE:
 		return ecdsa_starkcurve.GenerateKey(r)
+	case ecc.P256:
+		return ecdsa_p256.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
@@ -14,6 +14,7 @@ import (
 	eddsa_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
 	eddsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards/eddsa"
 	eddsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards/eddsa"
+	eddsa_ed25519 "github.com/consensys/gnark-crypto/ecc/ed25519/eddsa"
 	"github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -37,6 +38,8 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		return eddsa_bls24317.GenerateKey(r)
 	case twistededwards.BW6_633:
 		return eddsa_bw6633.GenerateKey(r)
+	case twistededwards.ED25519:
+		return eddsa_ed25519.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
@@ -76,6 +76,48 @@ func ReadSlice[S ~[]E, E any](r io.Reader, maxElements ...int) (s S, read int, e
 	return toReturn, read, nil
 }
 
+// SliceReaderAt gives random access to the elements of a slice written by
+// WriteSlice, without reading the whole slice in memory.
+type SliceReaderAt[E any] struct {
+	r      io.ReaderAt
+	offset int64 // offset of the first element
+	length int
+}
+
+// NewSliceReaderAt returns a SliceReaderAt on the slice written by WriteSlice at
+// the given offset of r. r can be a file, or a memory mapping of the file.
+func NewSliceReaderAt[E any](r io.ReaderAt, offset int64) (*SliceReaderAt[E], error) {
+	var buf [8]byte
+	if _, err := r.ReadAt(buf[:], offset); err != nil {
+		return nil, err
+	}
+	return &SliceReaderAt[E]{
+		r:      r,
+		offset: offset + 8,
+		length: int(binary.LittleEndian.Uint64(buf[:])),
+	}, nil
+}
+
+// Len returns the number of elements of the slice.
+func (s *SliceReaderAt[E]) Len() int {
+	return s.length
+}
+
+// ReadAt reads len(dst) elements of the slice, starting at index start.
+func (s *SliceReaderAt[E]) ReadAt(dst []E, start int) error {
+	if start < 0 || start+len(dst) > s.length {
+		return errors.New("out of bounds read")
+	}
+	if len(dst) == 0 {
+		return nil
+	}
+	var e E
+	size := int(unsafe.Sizeof(e))
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&dst[0])), size*len(dst))
+	_, err := s.r.ReadAt(data, s.offset+int64(size*start))
+	return err
+}
+
 const marker uint64 = 0xdeadbeef
 
 // WriteMarker writes the raw memory representation of a fixed marker to the writer.
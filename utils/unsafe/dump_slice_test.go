@@ -26,6 +26,27 @@ func TestPointDump(t *testing.T) {
 	assert.Equal(samplePoints, readPoints)
 }
 
+func TestSliceReaderAt(t *testing.T) {
+	assert := require.New(t)
+	samplePoints := make([]bn254.G2Affine, 10)
+	fillBenchBasesG2(samplePoints)
+
+	var buf bytes.Buffer
+	buf.WriteString("header")
+	err := unsafe.WriteSlice(&buf, samplePoints)
+	assert.NoError(err)
+
+	s, err := unsafe.NewSliceReaderAt[bn254.G2Affine](bytes.NewReader(buf.Bytes()), 6)
+	assert.NoError(err)
+	assert.Equal(len(samplePoints), s.Len())
+
+	readPoints := make([]bn254.G2Affine, 4)
+	assert.NoError(s.ReadAt(readPoints, 3))
+	assert.Equal(samplePoints[3:7], readPoints)
+
+	assert.Error(s.ReadAt(readPoints, 7))
+}
+
 func TestMarker(t *testing.T) {
 	assert := require.New(t)
 	var buf bytes.Buffer